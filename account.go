@@ -23,13 +23,13 @@ type accountBase struct {
 
 // Account represents a Vultr account
 type Account struct {
-	Balance           float32  `json:"balance"`
-	PendingCharges    float32  `json:"pending_charges"`
-	LastPaymentDate   string   `json:"last_payment_date"`
-	LastPaymentAmount float32  `json:"last_payment_amount"`
-	Name              string   `json:"name"`
-	Email             string   `json:"email"`
-	ACL               []string `json:"acls"`
+	Balance           float32   `json:"balance"`
+	PendingCharges    float32   `json:"pending_charges"`
+	LastPaymentDate   Timestamp `json:"last_payment_date"`
+	LastPaymentAmount float32   `json:"last_payment_amount"`
+	Name              string    `json:"name"`
+	Email             string    `json:"email"`
+	ACL               []string  `json:"acls"`
 }
 
 type accountBandwidthBase struct {
@@ -45,18 +45,18 @@ type AccountBandwidth struct {
 
 // AccountBandwidthPeriod represents a Vultr account bandwidth period
 type AccountBandwidthPeriod struct {
-	TimestampStart            string  `json:"timestamp_start"`
-	TimestampEnd              string  `json:"timestamp_end"`
-	GBIn                      int     `json:"gb_in"`
-	GBOut                     int     `json:"gb_out"`
-	TotalInstanceHours        int     `json:"total_instance_hours"`
-	TotalInstanceCount        int     `json:"total_instance_count"`
-	InstanceBandwidthCredits  int     `json:"instance_bandwidth_credits"`
-	FreeBandwidthCredits      int     `json:"free_bandwidth_credits"`
-	PurchasedBandwidthCredits int     `json:"purchased_bandwidth_credits"`
-	Overage                   float32 `json:"overage"`
-	OverageUnitCost           float32 `json:"overage_unit_cost"`
-	OverageCost               float32 `json:"overage_cost"`
+	TimestampStart            Timestamp `json:"timestamp_start"`
+	TimestampEnd              Timestamp `json:"timestamp_end"`
+	GBIn                      int       `json:"gb_in"`
+	GBOut                     int       `json:"gb_out"`
+	TotalInstanceHours        int       `json:"total_instance_hours"`
+	TotalInstanceCount        int       `json:"total_instance_count"`
+	InstanceBandwidthCredits  int       `json:"instance_bandwidth_credits"`
+	FreeBandwidthCredits      int       `json:"free_bandwidth_credits"`
+	PurchasedBandwidthCredits int       `json:"purchased_bandwidth_credits"`
+	Overage                   float32   `json:"overage"`
+	OverageUnitCost           float32   `json:"overage_unit_cost"`
+	OverageCost               float32   `json:"overage_cost"`
 }
 
 // Get Vultr account info
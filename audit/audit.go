@@ -0,0 +1,378 @@
+// Package audit analyzes the account's API logs for security reviews.
+//
+// An Analyzer reads the logs of a time range and reports the calls made by
+// each user, destructive calls such as deleting instances and databases,
+// calls from source IPs a user hasn't used before, spikes of failed
+// authentication and which services each user's API key is used for.
+//
+//	a := audit.New(audit.WithBaseline(30 * 24 * time.Hour))
+//
+//	report, err := a.Analyze(ctx, client.Logs, start, end)
+//	if err != nil {
+//		...
+//	}
+//	report.WriteCSV(os.Stdout)
+//
+// Source IPs are new when they weren't seen for the user during the baseline
+// before the range, and aren't one of the known IPs. Without a baseline or
+// known IPs every source IP is reported.
+package audit
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	defaultFailedAuthThreshold = 5
+	defaultFailedAuthWindow    = 5 * time.Minute
+)
+
+// defaultDestructiveServices are the services whose DELETE calls are reported
+// as destructive by default
+var defaultDestructiveServices = []string{"instances", "bare-metals", "databases", "kubernetes", "blocks", "snapshots"}
+
+// Analyzer aggregates logs into a Report
+type Analyzer struct {
+	baseline            time.Duration
+	knownIPs            map[string]bool
+	destructive         map[string]bool
+	failedAuthThreshold int
+	failedAuthWindow    time.Duration
+}
+
+// Option configures an Analyzer
+type Option func(*Analyzer)
+
+// WithBaseline reads the logs of the period before the range to learn the
+// source IPs each user already uses, which aren't reported as new
+func WithBaseline(d time.Duration) Option {
+	return func(a *Analyzer) {
+		a.baseline = d
+	}
+}
+
+// WithKnownIPs sets source IPs that are never reported as new, such as the
+// addresses of offices and CI runners
+func WithKnownIPs(ips ...string) Option {
+	return func(a *Analyzer) {
+		for _, ip := range ips {
+			a.knownIPs[ip] = true
+		}
+	}
+}
+
+// WithDestructiveServices replaces the services whose DELETE calls are
+// reported as destructive. Services are the first part of the API path,
+// e.g. "instances" for /v2/instances/{id}. The default is instances,
+// bare-metals, databases, kubernetes, blocks and snapshots.
+func WithDestructiveServices(services ...string) Option {
+	return func(a *Analyzer) {
+		a.destructive = map[string]bool{}
+		for _, service := range services {
+			a.destructive[service] = true
+		}
+	}
+}
+
+// WithFailedAuthSpike reports a spike when a user and source IP fail to
+// authenticate at least threshold times within window. The default is 5
+// failures within 5 minutes. A threshold below 1 or a window that isn't
+// positive keeps its default.
+func WithFailedAuthSpike(threshold int, window time.Duration) Option {
+	return func(a *Analyzer) {
+		if threshold >= 1 {
+			a.failedAuthThreshold = threshold
+		}
+		if window > 0 {
+			a.failedAuthWindow = window
+		}
+	}
+}
+
+// New returns an Analyzer configured by opts
+func New(opts ...Option) *Analyzer {
+	a := &Analyzer{
+		knownIPs:            map[string]bool{},
+		destructive:         map[string]bool{},
+		failedAuthThreshold: defaultFailedAuthThreshold,
+		failedAuthWindow:    defaultFailedAuthWindow,
+	}
+	for _, service := range defaultDestructiveServices {
+		a.destructive[service] = true
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Analyze reads the logs from the start of the baseline to end and reports
+// on those between start and end
+func (a *Analyzer) Analyze(ctx context.Context, logs govultr.LogsService, start, end time.Time) (*Report, error) {
+	options := govultr.LogsOptions{StartTime: start.Add(-a.baseline), EndTime: end}
+
+	return a.AnalyzeLogs(logs.Stream(ctx, options), start, end)
+}
+
+// AnalyzeLogs reports on the logs between start and end, for instance ones
+// read back from an export. Earlier logs are only used as the baseline and
+// later ones are ignored. Logs whose timestamp can't be parsed are counted
+// as part of the range.
+func (a *Analyzer) AnalyzeLogs(logs iter.Seq2[govultr.Log, error], start, end time.Time) (*Report, error) {
+	agg := newAggregate(a, start, end)
+	for log, err := range logs {
+		if err != nil {
+			return nil, err
+		}
+		agg.add(&log)
+	}
+
+	return agg.report(), nil
+}
+
+// aggregate collects the logs of a report as they are read
+type aggregate struct {
+	analyzer *Analyzer
+	start    time.Time
+	end      time.Time
+
+	// Source IPs seen per user ID, during the baseline and since
+	seenIPs map[string]map[string]bool
+
+	users       map[string]*UserActivity
+	destructive []Call
+	newIPs      map[string]*SourceIP
+	failedAuth  map[[2]string][]time.Time
+	failedUsers map[[2]string]User
+	keys        map[[2]string]*KeyUsage
+	total       int
+}
+
+func newAggregate(a *Analyzer, start, end time.Time) *aggregate {
+	return &aggregate{
+		analyzer:    a,
+		start:       start,
+		end:         end,
+		seenIPs:     map[string]map[string]bool{},
+		users:       map[string]*UserActivity{},
+		newIPs:      map[string]*SourceIP{},
+		failedAuth:  map[[2]string][]time.Time{},
+		failedUsers: map[[2]string]User{},
+		keys:        map[[2]string]*KeyUsage{},
+	}
+}
+
+// add counts a log towards the report, or the baseline when it's from
+// before the range
+func (g *aggregate) add(log *govultr.Log) {
+	md := &log.Metadata
+	user := User{ID: md.UserID, Name: md.UserName}
+	ts := log.Timestamp.Time()
+
+	if !ts.IsZero() && ts.Before(g.start) {
+		g.seeIP(user.ID, md.IPAddress)
+		return
+	}
+	if !ts.IsZero() && ts.After(g.end) {
+		return
+	}
+
+	g.total++
+	service := Service(md.RequestPath)
+	call := Call{Time: ts, User: user, IPAddress: md.IPAddress, Method: md.Method, Path: md.RequestPath, Status: md.HTTPStatusCode}
+
+	g.addActivity(&call, service)
+	g.addSourceIP(&call)
+
+	if md.Method == http.MethodDelete && g.analyzer.destructive[service] {
+		g.destructive = append(g.destructive, call)
+	}
+
+	if md.HTTPStatusCode == http.StatusUnauthorized || md.HTTPStatusCode == http.StatusForbidden {
+		key := [2]string{user.ID, md.IPAddress}
+		g.failedAuth[key] = append(g.failedAuth[key], ts)
+		g.failedUsers[key] = user
+		return
+	}
+
+	g.addKeyUsage(&call, service)
+}
+
+// addActivity counts a call towards its user's activity
+func (g *aggregate) addActivity(call *Call, service string) {
+	activity := g.users[call.User.ID]
+	if activity == nil {
+		activity = &UserActivity{User: call.User, Actions: map[string]int{}}
+		g.users[call.User.ID] = activity
+	}
+
+	activity.Calls++
+	activity.Actions[call.Method+" "+service]++
+	if call.Status >= http.StatusBadRequest {
+		activity.Failed++
+	}
+	if !call.Time.IsZero() && (activity.First.IsZero() || call.Time.Before(activity.First)) {
+		activity.First = call.Time
+	}
+	if call.Time.After(activity.Last) {
+		activity.Last = call.Time
+	}
+}
+
+// addKeyUsage counts an authenticated call towards the usage of its user's
+// API key
+func (g *aggregate) addKeyUsage(call *Call, service string) {
+	key := [2]string{call.User.ID, service}
+	usage := g.keys[key]
+	if usage == nil {
+		usage = &KeyUsage{User: call.User, Service: service}
+		g.keys[key] = usage
+	}
+
+	usage.Calls++
+	if call.Time.After(usage.LastUsed) {
+		usage.LastUsed = call.Time
+	}
+}
+
+// addSourceIP records the source IP of a call when it is new for the user
+func (g *aggregate) addSourceIP(call *Call) {
+	if call.IPAddress == "" || g.analyzer.knownIPs[call.IPAddress] {
+		return
+	}
+
+	key := call.User.ID + " " + call.IPAddress
+	if ip, ok := g.newIPs[key]; ok {
+		ip.Calls++
+		if call.Time.Before(ip.FirstSeen) {
+			ip.FirstSeen = call.Time
+		}
+		return
+	}
+
+	if g.seeIP(call.User.ID, call.IPAddress) {
+		g.newIPs[key] = &SourceIP{User: call.User, IPAddress: call.IPAddress, FirstSeen: call.Time, Calls: 1}
+	}
+}
+
+// seeIP records that the user made calls from ip and reports whether it
+// hadn't before
+func (g *aggregate) seeIP(userID, ip string) bool {
+	ips := g.seenIPs[userID]
+	if ips == nil {
+		ips = map[string]bool{}
+		g.seenIPs[userID] = ips
+	}
+
+	if ips[ip] {
+		return false
+	}
+
+	ips[ip] = true
+	return true
+}
+
+// report sorts what was collected into a Report
+func (g *aggregate) report() *Report {
+	r := &Report{Start: g.start, End: g.end, Logs: g.total, Destructive: g.destructive}
+
+	for _, activity := range g.users {
+		r.Users = append(r.Users, *activity)
+	}
+	slices.SortFunc(r.Users, func(a, b UserActivity) int {
+		if a.Calls != b.Calls {
+			return b.Calls - a.Calls
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	slices.SortStableFunc(r.Destructive, func(a, b Call) int { return a.Time.Compare(b.Time) })
+
+	for _, ip := range g.newIPs {
+		r.NewSourceIPs = append(r.NewSourceIPs, *ip)
+	}
+	slices.SortFunc(r.NewSourceIPs, func(a, b SourceIP) int {
+		if c := a.FirstSeen.Compare(b.FirstSeen); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID+a.IPAddress, b.ID+b.IPAddress)
+	})
+
+	for key, times := range g.failedAuth {
+		r.FailedAuthSpikes = append(r.FailedAuthSpikes, g.spikes(g.failedUsers[key], key[1], times)...)
+	}
+	slices.SortFunc(r.FailedAuthSpikes, func(a, b FailedAuthSpike) int {
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID+a.IPAddress, b.ID+b.IPAddress)
+	})
+
+	for _, usage := range g.keys {
+		r.KeyUsage = append(r.KeyUsage, *usage)
+	}
+	slices.SortFunc(r.KeyUsage, func(a, b KeyUsage) int {
+		if c := strings.Compare(a.ID, b.ID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Service, b.Service)
+	})
+
+	return r
+}
+
+// spikes finds the periods in which at least the threshold of failures fall
+// within the window of each other. Overlapping periods are merged.
+func (g *aggregate) spikes(user User, ip string, times []time.Time) []FailedAuthSpike {
+	threshold, window := g.analyzer.failedAuthThreshold, g.analyzer.failedAuthWindow
+	slices.SortFunc(times, time.Time.Compare)
+
+	var spikes []FailedAuthSpike
+	for first, last := 0, 0; last < len(times); last++ {
+		for times[last].Sub(times[first]) > window {
+			first++
+		}
+
+		if last-first+1 < threshold {
+			continue
+		}
+
+		if n := len(spikes); n > 0 && !times[first].After(spikes[n-1].End) {
+			spikes[n-1].End = times[last]
+			continue
+		}
+		spikes = append(spikes, FailedAuthSpike{User: user, IPAddress: ip, Start: times[first], End: times[last]})
+	}
+
+	// Count every failure of each spike, including those that led up to it
+	for i := range spikes {
+		for _, t := range times {
+			if !t.Before(spikes[i].Start) && !t.After(spikes[i].End) {
+				spikes[i].Failures++
+			}
+		}
+	}
+
+	return spikes
+}
+
+// Service returns the service of an API path, the part after the version,
+// e.g. "instances" for /v2/instances/{id}/start
+func Service(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 1 && len(parts[0]) > 1 && parts[0][0] == 'v' && strings.Trim(parts[0][1:], "0123456789") == "" {
+		parts = parts[1:]
+	}
+
+	return parts[0]
+}
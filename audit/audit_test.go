@@ -0,0 +1,262 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/govultrmock"
+)
+
+var start = time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC)
+
+func entry(offset time.Duration, user, ip, method, path string, status int) govultr.Log {
+	return govultr.Log{
+		Timestamp: govultr.NewTimestamp(start.Add(offset)),
+		Metadata: govultr.LogMetadata{
+			UserID:         user + "-id",
+			UserName:       user,
+			IPAddress:      ip,
+			Method:         method,
+			RequestPath:    path,
+			HTTPStatusCode: status,
+		},
+	}
+}
+
+func testLogs() []govultr.Log {
+	logs := []govultr.Log{
+		// Baseline
+		entry(-time.Hour, "alice", "192.0.2.1", "GET", "/v2/instances", 200),
+
+		entry(time.Minute, "alice", "192.0.2.1", "GET", "/v2/instances", 200),
+		entry(2*time.Minute, "alice", "192.0.2.1", "POST", "/v2/instances", 202),
+		entry(3*time.Minute, "alice", "203.0.113.9", "DELETE", "/v2/instances/abc", 204),
+		entry(4*time.Minute, "alice", "203.0.113.9", "DELETE", "/v2/domains/example.com/records/1", 204),
+		entry(5*time.Minute, "bob", "198.51.100.7", "DELETE", "/v2/databases/db1?force=true", 204),
+		entry(6*time.Minute, "bob", "10.0.0.1", "GET", "/v2/account", 200),
+
+		// After the range
+		entry(48*time.Hour, "alice", "192.0.2.99", "DELETE", "/v2/instances/def", 204),
+	}
+
+	// A spike of failures from one IP and a lone failure from another
+	for i := range 6 {
+		logs = append(logs, entry(10*time.Minute+time.Duration(i)*30*time.Second, "bob", "198.51.100.66", "GET", "/v2/instances", 401))
+	}
+	logs = append(logs, entry(time.Hour, "bob", "198.51.100.7", "GET", "/v2/instances", 403))
+
+	return logs
+}
+
+func seq(logs []govultr.Log) iter.Seq2[govultr.Log, error] {
+	return func(yield func(govultr.Log, error) bool) {
+		for _, log := range logs {
+			if !yield(log, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestAnalyzer_AnalyzeLogs(t *testing.T) {
+	a := New(WithKnownIPs("10.0.0.1"))
+
+	report, err := a.AnalyzeLogs(seq(testLogs()), start, start.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("AnalyzeLogs returned %+v", err)
+	}
+
+	if report.Logs != 13 {
+		t.Errorf("AnalyzeLogs counted %d logs, expected 13", report.Logs)
+	}
+
+	if len(report.Users) != 2 || report.Users[0].Name != "bob" || report.Users[0].Calls != 9 || report.Users[0].Failed != 7 {
+		t.Fatalf("AnalyzeLogs returned users %+v, expected bob first with 9 calls", report.Users)
+	}
+	alice := report.Users[1]
+	if alice.Calls != 4 || alice.Actions["DELETE instances"] != 1 || alice.Actions["GET instances"] != 1 {
+		t.Errorf("AnalyzeLogs returned %+v for alice", alice)
+	}
+	if !alice.First.Equal(start.Add(time.Minute)) || !alice.Last.Equal(start.Add(4*time.Minute)) {
+		t.Errorf("AnalyzeLogs returned alice's first and last call %v, %v", alice.First, alice.Last)
+	}
+
+	var destructive []string
+	for _, call := range report.Destructive {
+		destructive = append(destructive, call.Name+" "+call.Path)
+	}
+	if expected := []string{"alice /v2/instances/abc", "bob /v2/databases/db1?force=true"}; !slices.Equal(destructive, expected) {
+		t.Errorf("AnalyzeLogs returned destructive calls %v, expected %v", destructive, expected)
+	}
+
+	var ips []string
+	for _, ip := range report.NewSourceIPs {
+		ips = append(ips, ip.Name+" "+ip.IPAddress)
+	}
+	if expected := []string{"alice 203.0.113.9", "bob 198.51.100.7", "bob 198.51.100.66"}; !slices.Equal(ips, expected) {
+		t.Errorf("AnalyzeLogs returned new source IPs %v, expected %v", ips, expected)
+	}
+	if report.NewSourceIPs[0].Calls != 2 {
+		t.Errorf("AnalyzeLogs counted %d calls from alice's new IP, expected 2", report.NewSourceIPs[0].Calls)
+	}
+
+	expectedSpike := FailedAuthSpike{
+		User:      User{ID: "bob-id", Name: "bob"},
+		IPAddress: "198.51.100.66",
+		Start:     start.Add(10 * time.Minute),
+		End:       start.Add(12*time.Minute + 30*time.Second),
+		Failures:  6,
+	}
+	if len(report.FailedAuthSpikes) != 1 || report.FailedAuthSpikes[0] != expectedSpike {
+		t.Errorf("AnalyzeLogs returned spikes %+v, expected %+v", report.FailedAuthSpikes, expectedSpike)
+	}
+
+	var usage []string
+	for _, k := range report.KeyUsage {
+		usage = append(usage, k.Name+" "+k.Service)
+	}
+	if expected := []string{"alice domains", "alice instances", "bob account", "bob databases"}; !slices.Equal(usage, expected) {
+		t.Errorf("AnalyzeLogs returned key usage %v, expected %v", usage, expected)
+	}
+}
+
+func TestAnalyzer_Options(t *testing.T) {
+	a := New(WithDestructiveServices("domains"), WithFailedAuthSpike(2, time.Hour))
+
+	report, err := a.AnalyzeLogs(seq(testLogs()), start, start.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("AnalyzeLogs returned %+v", err)
+	}
+
+	if len(report.Destructive) != 1 || report.Destructive[0].Path != "/v2/domains/example.com/records/1" {
+		t.Errorf("AnalyzeLogs returned destructive calls %+v, expected the record delete", report.Destructive)
+	}
+
+	if len(report.FailedAuthSpikes) != 1 || report.FailedAuthSpikes[0].Failures != 6 {
+		t.Errorf("AnalyzeLogs returned spikes %+v, expected one for the 401s only", report.FailedAuthSpikes)
+	}
+
+	for _, opt := range []Option{WithFailedAuthSpike(0, time.Hour), WithFailedAuthSpike(2, 0), WithFailedAuthSpike(-1, -time.Hour)} {
+		a := New(opt)
+		if a.failedAuthThreshold < 1 || a.failedAuthWindow <= 0 {
+			t.Errorf("WithFailedAuthSpike set a threshold of %d and a window of %v", a.failedAuthThreshold, a.failedAuthWindow)
+		}
+
+		logs := []govultr.Log{entry(0, "mallory", "203.0.113.9", "GET", "/v2/account", 401), entry(time.Second, "mallory", "203.0.113.9", "GET", "/v2/account", 401)}
+		if _, err := a.AnalyzeLogs(seq(logs), start, start.Add(time.Hour)); err != nil {
+			t.Errorf("AnalyzeLogs returned %+v", err)
+		}
+	}
+}
+
+func TestAnalyzer_Analyze(t *testing.T) {
+	mock := govultrmock.NewMockClient()
+
+	var options govultr.LogsOptions
+	mock.Logs.StreamFunc = func(ctx context.Context, o govultr.LogsOptions) iter.Seq2[govultr.Log, error] {
+		options = o
+		return seq(testLogs())
+	}
+
+	end := start.Add(24 * time.Hour)
+	report, err := New(WithBaseline(7*24*time.Hour)).Analyze(context.Background(), mock.Logs, start, end)
+	if err != nil {
+		t.Fatalf("Analyze returned %+v", err)
+	}
+
+	if !options.StartTime.Equal(start.Add(-7*24*time.Hour)) || !options.EndTime.Equal(end) {
+		t.Errorf("Analyze streamed %+v, expected the baseline and range", options)
+	}
+
+	// 192.0.2.1 was used by alice during the baseline
+	for _, ip := range report.NewSourceIPs {
+		if ip.IPAddress == "192.0.2.1" {
+			t.Errorf("Analyze returned %+v as a new source IP", ip)
+		}
+	}
+
+	failing := errors.New("stream failed")
+	mock.Logs.StreamFunc = func(ctx context.Context, o govultr.LogsOptions) iter.Seq2[govultr.Log, error] {
+		return func(yield func(govultr.Log, error) bool) { yield(govultr.Log{}, failing) }
+	}
+	if _, err := New().Analyze(context.Background(), mock.Logs, start, end); !errors.Is(err, failing) {
+		t.Errorf("Analyze returned %+v, expected %+v", err, failing)
+	}
+}
+
+func TestReport_Export(t *testing.T) {
+	report, err := New().AnalyzeLogs(seq(testLogs()), start, start.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON returned %+v", err)
+	}
+	decoded := &Report{}
+	if err := json.Unmarshal(buf.Bytes(), decoded); err != nil || decoded.Logs != report.Logs || len(decoded.Users) != 2 {
+		t.Errorf("WriteJSON wrote %s, %+v", buf.String(), err)
+	}
+	if !strings.Contains(buf.String(), `"user_id": "alice-id"`) {
+		t.Errorf("WriteJSON wrote %s, expected flattened users", buf.String())
+	}
+
+	buf.Reset()
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV returned %+v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(rows[0], csvHeader) {
+		t.Errorf("WriteCSV wrote header %v", rows[0])
+	}
+
+	findings := map[string]int{}
+	for _, row := range rows[1:] {
+		findings[row[0]]++
+	}
+	expected := map[string]int{"user": 2, "action": 7, "destructive": 2, "new_source_ip": 4, "failed_auth_spike": 1, "key_usage": 4}
+	for finding, n := range expected {
+		if findings[finding] != n {
+			t.Errorf("WriteCSV wrote %d %s rows, expected %d", findings[finding], finding, n)
+		}
+	}
+
+	spike := []string{"failed_auth_spike", "2025-08-26T00:10:00Z", "bob-id", "bob", "198.51.100.66", "", "", "", "", "6"}
+	if !slices.ContainsFunc(rows, func(row []string) bool { return slices.Equal(row, spike) }) {
+		t.Errorf("WriteCSV wrote %v, expected the row %v", rows, spike)
+	}
+}
+
+func TestService(t *testing.T) {
+	tests := map[string]string{
+		"/v2/instances":                "instances",
+		"/v2/instances/abc/start":      "instances",
+		"/v2/databases/db1?force=true": "databases",
+		"/v1/account":                  "account",
+		"account":                      "account",
+		"/v2":                          "v2",
+		"/v2/bare-metals/abc/ipv4":     "bare-metals",
+		"/vpcs/abc":                    "vpcs",
+		"":                             "",
+	}
+
+	for path, expected := range tests {
+		if got := Service(path); got != expected {
+			t.Errorf("Service(%q) returned %q, expected %q", path, got, expected)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Report is the outcome of analyzing the logs of a time range
+type Report struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Number of logs in the range
+	Logs int `json:"logs"`
+
+	// Calls of each user, most active first
+	Users []UserActivity `json:"users"`
+
+	// DELETE calls on destructive services, oldest first
+	Destructive []Call `json:"destructive"`
+
+	// Source IPs each user hadn't used before, in the order they appeared
+	NewSourceIPs []SourceIP `json:"new_source_ips"`
+
+	// Periods of repeated failed authentication, oldest first
+	FailedAuthSpikes []FailedAuthSpike `json:"failed_auth_spikes"`
+
+	// Calls each user's API key made per service, by user and service
+	KeyUsage []KeyUsage `json:"key_usage"`
+}
+
+// User identifies who made a call
+type User struct {
+	ID   string `json:"user_id"`
+	Name string `json:"username"`
+}
+
+// UserActivity counts the calls of a user
+type UserActivity struct {
+	User
+	Calls int `json:"calls"`
+
+	// Calls that failed with a 4xx or 5xx status
+	Failed int `json:"failed"`
+
+	// Calls per method and service, e.g. "DELETE instances"
+	Actions map[string]int `json:"actions"`
+
+	First time.Time `json:"first"`
+	Last  time.Time `json:"last"`
+}
+
+// Call is a single logged API call
+type Call struct {
+	Time time.Time `json:"time"`
+	User
+	IPAddress string `json:"ip_address"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status"`
+}
+
+// SourceIP is an IP a user made calls from
+type SourceIP struct {
+	User
+	IPAddress string    `json:"ip_address"`
+	FirstSeen time.Time `json:"first_seen"`
+	Calls     int       `json:"calls"`
+}
+
+// FailedAuthSpike is a period in which a user and source IP repeatedly
+// failed to authenticate, with a 401 or 403 status
+type FailedAuthSpike struct {
+	User
+	IPAddress string    `json:"ip_address"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Failures  int       `json:"failures"`
+}
+
+// KeyUsage counts the successfully authenticated calls a user's API key made
+// to a service
+type KeyUsage struct {
+	User
+	Service  string    `json:"service"`
+	Calls    int       `json:"calls"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// WriteJSON writes the report to w as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+// csvHeader are the columns of WriteCSV
+var csvHeader = []string{"finding", "time", "user_id", "username", "ip_address", "method", "path", "service", "status", "count"}
+
+// WriteCSV writes the findings of the report to w as CSV, one row per user,
+// user action, destructive call, new source IP, failed authentication spike
+// and API key usage. The finding column tells them apart and columns that
+// don't apply to a finding are empty.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	rows := [][]string{csvHeader}
+	row := func(finding string, t time.Time, user User, ip, method, path, service string, status, count int) {
+		rows = append(rows, []string{
+			finding, csvTime(t), user.ID, user.Name, ip, method, path, service, csvInt(status), csvInt(count),
+		})
+	}
+
+	for _, u := range r.Users {
+		row("user", u.Last, u.User, "", "", "", "", 0, u.Calls)
+		for _, action := range slices.Sorted(maps.Keys(u.Actions)) {
+			method, service, _ := strings.Cut(action, " ")
+			row("action", time.Time{}, u.User, "", method, "", service, 0, u.Actions[action])
+		}
+	}
+	for _, c := range r.Destructive {
+		row("destructive", c.Time, c.User, c.IPAddress, c.Method, c.Path, Service(c.Path), c.Status, 1)
+	}
+	for _, ip := range r.NewSourceIPs {
+		row("new_source_ip", ip.FirstSeen, ip.User, ip.IPAddress, "", "", "", 0, ip.Calls)
+	}
+	for _, s := range r.FailedAuthSpikes {
+		row("failed_auth_spike", s.Start, s.User, s.IPAddress, "", "", "", 0, s.Failures)
+	}
+	for _, k := range r.KeyUsage {
+		row("key_usage", k.LastUsed, k.User, "", "", "", k.Service, 0, k.Calls)
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+func csvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func csvInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,176 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// EnvAPIKey is the environment variable NewClientFromEnv reads the API key from
+	EnvAPIKey = "VULTR_API_KEY"
+
+	// EnvAPIURL is the environment variable NewClientFromEnv reads the base URL from
+	EnvAPIURL = "VULTR_API_URL"
+
+	// OIDC tokens are refreshed this long before they expire
+	oidcTokenExpiryLeeway = 30 * time.Second
+
+	oidcGrantRefreshToken = "refresh_token"
+)
+
+// TokenSource supplies the bearer token sent with every request. Token is
+// called once per attempt, so a TokenSource can rotate or refresh the token
+// while the client is in use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc adapts an ordinary function to a TokenSource
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx)
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// APIKeyTokenSource is a TokenSource for a Vultr API key. The key can be
+// replaced at any time with Rotate.
+type APIKeyTokenSource struct {
+	mu  sync.RWMutex
+	key string
+}
+
+// NewAPIKeyTokenSource returns a TokenSource for the API key
+func NewAPIKeyTokenSource(apiKey string) *APIKeyTokenSource {
+	return &APIKeyTokenSource{key: apiKey}
+}
+
+// Token returns the current API key
+func (s *APIKeyTokenSource) Token(_ context.Context) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.key, nil
+}
+
+// Rotate replaces the API key used by every request sent from now on
+func (s *APIKeyTokenSource) Rotate(apiKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.key = apiKey
+}
+
+// OIDCTokenSource is a TokenSource that uses OIDC access tokens created with
+// OIDCService.CreateOIDCToken. The first token is created from the request
+// passed to NewOIDCTokenSource, after that the refresh token is used to get a
+// new access token shortly before the current one expires.
+type OIDCTokenSource struct {
+	mu      sync.Mutex
+	service OIDCService
+	req     OIDCTokenReq
+	token   *OIDCToken
+	expiry  time.Time
+}
+
+// NewOIDCTokenSource returns a TokenSource for OIDC access tokens. The service
+// can belong to the client the token source is attached to, token requests
+// are sent without a bearer token.
+func NewOIDCTokenSource(service OIDCService, tokenReq *OIDCTokenReq) *OIDCTokenSource {
+	return &OIDCTokenSource{service: service, req: *tokenReq}
+}
+
+// Token returns the current access token, creating or refreshing it if needed
+func (s *OIDCTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && (s.expiry.IsZero() || time.Until(s.expiry) > oidcTokenExpiryLeeway) {
+		return s.token.AccessToken, nil
+	}
+
+	req := s.req
+	if s.token != nil && s.token.RefreshToken != "" {
+		req = OIDCTokenReq{
+			GrantType:    oidcGrantRefreshToken,
+			ClientID:     s.req.ClientID,
+			ClientSecret: s.req.ClientSecret,
+			RefreshToken: s.token.RefreshToken,
+		}
+	}
+
+	token, _, err := s.service.CreateOIDCToken(context.WithValue(ctx, skipAuthKey{}, true), &req)
+	if err != nil {
+		return "", err
+	}
+
+	if token == nil || token.AccessToken == "" {
+		return "", errors.New("oidc token response did not contain an access token")
+	}
+
+	s.token = token
+	s.expiry = time.Time{}
+	if seconds, err := strconv.Atoi(token.ExpiresSeconds); err == nil && seconds > 0 {
+		s.expiry = time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	return token.AccessToken, nil
+}
+
+type skipAuthKey struct{}
+
+// NewClientWithAPIKey returns a Vultr API Client that authenticates with the
+// API key. Options are applied after the API key.
+func NewClientWithAPIKey(apiKey string, opts ...ClientOption) (*Client, error) {
+	return NewClientWithOptions(append([]ClientOption{WithAPIKey(apiKey)}, opts...)...)
+}
+
+// NewClientFromEnv returns a Vultr API Client that authenticates with the API
+// key in VULTR_API_KEY. VULTR_API_URL overrides the base URL when set. Without
+// an API key only public operations will work. Options are applied after the
+// environment, so they take precedence.
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	var envOpts []ClientOption
+
+	if apiKey := os.Getenv(EnvAPIKey); apiKey != "" {
+		envOpts = append(envOpts, WithAPIKey(apiKey))
+	}
+
+	if baseURL := os.Getenv(EnvAPIURL); baseURL != "" {
+		envOpts = append(envOpts, WithBaseURL(baseURL))
+	}
+
+	return NewClientWithOptions(append(envOpts, opts...)...)
+}
+
+// SetTokenSource sets where the bearer token for every request comes from.
+// Passing nil stops the client from setting the Authorization header.
+func (c *Client) SetTokenSource(ts TokenSource) {
+	c.tokenSource = ts
+}
+
+// AuthMiddleware sets the Authorization header to a bearer token from the
+// client's TokenSource. It does nothing when no TokenSource is set.
+func (c *Client) AuthMiddleware(next Handler) Handler {
+	return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		ts := c.tokenSource
+		if ts == nil || req.Context().Value(skipAuthKey{}) != nil {
+			return next.RoundTrip(req)
+		}
+
+		token, err := ts.Token(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		return next.RoundTrip(req)
+	})
+}
@@ -0,0 +1,135 @@
+package govultr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewClientWithAPIKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var authorization string
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		authorization = request.Header.Get("Authorization")
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	c, err := NewClientWithAPIKey("first-key", WithBaseURL(client.BaseURL.String()))
+	if err != nil {
+		t.Fatalf("NewClientWithAPIKey returned %+v", err)
+	}
+
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if authorization != "Bearer first-key" {
+		t.Errorf("Authorization = %q, expected %q", authorization, "Bearer first-key")
+	}
+
+	ts := NewAPIKeyTokenSource("second-key")
+	c.SetTokenSource(ts)
+	ts.Rotate("rotated-key")
+
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if authorization != "Bearer rotated-key" {
+		t.Errorf("Authorization = %q, expected %q", authorization, "Bearer rotated-key")
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var authorization string
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		authorization = request.Header.Get("Authorization")
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvAPIURL, server.URL)
+
+	c, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("NewClientFromEnv returned %+v", err)
+	}
+
+	if c.BaseURL.String() != server.URL {
+		t.Errorf("NewClientFromEnv BaseURL = %v, expected %v", c.BaseURL, server.URL)
+	}
+
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if authorization != "Bearer env-key" {
+		t.Errorf("Authorization = %q, expected %q", authorization, "Bearer env-key")
+	}
+
+	t.Setenv(EnvAPIURL, ":")
+	if _, err := NewClientFromEnv(); err == nil {
+		t.Error("NewClientFromEnv expected an invalid URL to fail")
+	}
+}
+
+func TestOIDCTokenSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var tokenReqs []OIDCTokenReq
+	mux.HandleFunc("/v2/oidc/issuer/token", func(writer http.ResponseWriter, request *http.Request) {
+		if auth := request.Header.Get("Authorization"); auth != "" {
+			t.Errorf("token request sent with Authorization %q", auth)
+		}
+
+		var req OIDCTokenReq
+		_ = json.NewDecoder(request.Body).Decode(&req)
+		tokenReqs = append(tokenReqs, req)
+
+		// The first token expires within the refresh leeway so the next call refreshes it
+		expires := 10
+		if len(tokenReqs) > 1 {
+			expires = 3600
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"token":{"access_token":"access-%d","expires_in":"%d","refresh_token":"refresh-%d"}}`,
+			len(tokenReqs), expires, len(tokenReqs))
+	})
+
+	var authorizations []string
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		authorizations = append(authorizations, request.Header.Get("Authorization"))
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	client.SetTokenSource(NewOIDCTokenSource(client.OIDC, &OIDCTokenReq{
+		GrantType:    "authorization_code",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Code:         "code",
+	}))
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Account.Get(ctx); err != nil {
+			t.Fatalf("Account.Get returned %+v", err)
+		}
+	}
+
+	expected := []string{"Bearer access-1", "Bearer access-2", "Bearer access-2"}
+	for i := range expected {
+		if authorizations[i] != expected[i] {
+			t.Errorf("Authorization %d = %q, expected %q", i, authorizations[i], expected[i])
+		}
+	}
+
+	if len(tokenReqs) != 2 || tokenReqs[1].GrantType != "refresh_token" || tokenReqs[1].RefreshToken != "refresh-1" {
+		t.Errorf("token requests = %+v, expected the code exchange then a refresh", tokenReqs)
+	}
+}
@@ -22,11 +22,11 @@ type BackupServiceHandler struct {
 
 // Backup represents a Vultr backup
 type Backup struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	Description string `json:"description"`
-	Size        int    `json:"size"`
-	Status      string `json:"status"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	Description string    `json:"description"`
+	Size        int       `json:"size"`
+	Status      string    `json:"status"`
 }
 
 type backupsBase struct {
@@ -40,15 +40,6 @@ type BareMetalServerService interface {
 	ListVPCInfo(ctx context.Context, serverID string) ([]VPCInfo, *http.Response, error)
 	AttachVPC(ctx context.Context, serverID, vpcID string) error
 	DetachVPC(ctx context.Context, serverID, vpcID string) error
-
-	// Deprecated: VPC2 is no longer supported
-	ListVPC2Info(ctx context.Context, serverID string) ([]VPC2Info, *http.Response, error)
-
-	// Deprecated: VPC2 is no longer supported
-	AttachVPC2(ctx context.Context, serverID string, vpc2Req *AttachVPC2Req) error
-
-	// Deprecated: VPC2 is no longer supported
-	DetachVPC2(ctx context.Context, serverID, vpcID string) error
 }
 
 // BareMetalServerServiceHandler handles interaction with the Bare Metal methods for the Vultr API
@@ -58,31 +49,31 @@ type BareMetalServerServiceHandler struct {
 
 // BareMetalServer represents a Bare Metal server on Vultr
 type BareMetalServer struct {
-	ID              string   `json:"id"`
-	Os              string   `json:"os"`
-	RAM             string   `json:"ram"`
-	Disk            string   `json:"disk"`
-	MainIP          string   `json:"main_ip"`
-	CPUCount        int      `json:"cpu_count"`
-	Region          string   `json:"region"`
-	DefaultPassword string   `json:"default_password"`
-	DateCreated     string   `json:"date_created"`
-	Status          string   `json:"status"`
-	NetmaskV4       string   `json:"netmask_v4"`
-	GatewayV4       string   `json:"gateway_v4"`
-	Plan            string   `json:"plan"`
-	V6Network       string   `json:"v6_network"`
-	V6MainIP        string   `json:"v6_main_ip"`
-	V6NetworkSize   int      `json:"v6_network_size"`
-	MacAddress      int      `json:"mac_address"`
-	Label           string   `json:"label"`
-	OsID            int      `json:"os_id"`
-	AppID           int      `json:"app_id"`
-	ImageID         string   `json:"image_id"`
-	SnapshotID      string   `json:"snapshot_id"`
-	Features        []string `json:"features"`
-	Tags            []string `json:"tags"`
-	UserScheme      string   `json:"user_scheme"`
+	ID              string    `json:"id"`
+	Os              string    `json:"os"`
+	RAM             string    `json:"ram"`
+	Disk            string    `json:"disk"`
+	MainIP          string    `json:"main_ip"`
+	CPUCount        int       `json:"cpu_count"`
+	Region          string    `json:"region"`
+	DefaultPassword string    `json:"default_password"`
+	DateCreated     Timestamp `json:"date_created"`
+	Status          string    `json:"status"`
+	NetmaskV4       string    `json:"netmask_v4"`
+	GatewayV4       string    `json:"gateway_v4"`
+	Plan            string    `json:"plan"`
+	V6Network       string    `json:"v6_network"`
+	V6MainIP        string    `json:"v6_main_ip"`
+	V6NetworkSize   int       `json:"v6_network_size"`
+	MacAddress      int       `json:"mac_address"`
+	Label           string    `json:"label"`
+	OsID            int       `json:"os_id"`
+	AppID           int       `json:"app_id"`
+	ImageID         string    `json:"image_id"`
+	SnapshotID      string    `json:"snapshot_id"`
+	Features        []string  `json:"features"`
+	Tags            []string  `json:"tags"`
+	UserScheme      string    `json:"user_scheme"`
 }
 
 // BareMetalCreate represents the optional parameters that can be set when creating a Bare Metal server
@@ -107,15 +98,6 @@ type BareMetalCreate struct {
 	Tags            []string          `json:"tags,omitempty"`
 	UserScheme      string            `json:"user_scheme,omitempty"`
 	AppVariables    map[string]string `json:"app_variables,omitempty"`
-
-	// Deprecated: VPC2 is no longer supported
-	AttachVPC2 []string `json:"attach_vpc2,omitempty"`
-
-	// Deprecated: VPC2 is no longer supported
-	DetachVPC2 []string `json:"detach_vpc2,omitempty"`
-
-	// Deprecated: VPC2 is no longer supported
-	EnableVPC2 *bool `json:"enable_vpc2,omitempty"`
 }
 
 // BareMetalUpdate represents the optional parameters that can be set when updating a Bare Metal server
@@ -130,15 +112,6 @@ type BareMetalUpdate struct {
 	MdiskMode    string   `json:"mdisk_mode,omitempty"`
 	Tags         []string `json:"tags,omitempty"`
 	UserScheme   string   `json:"user_scheme,omitempty"`
-
-	// Deprecated: VPC2 is no longer supported
-	AttachVPC2 []string `json:"attach_vpc2,omitempty"`
-
-	// Deprecated: VPC2 is no longer supported
-	DetachVPC2 []string `json:"detach_vpc2,omitempty"`
-
-	// Deprecated: VPC2 is no longer supported
-	EnableVPC2 *bool `json:"enable_vpc2,omitempty"`
 }
 
 type bareMetalsBase struct {
@@ -511,53 +484,3 @@ func (b *BareMetalServerServiceHandler) DetachVPC(ctx context.Context, serverID,
 	_, err = b.client.DoWithContext(ctx, req, nil)
 	return err
 }
-
-// ListVPC2Info currently attached to a Bare Metal server.
-//
-// Deprecated: VPC2 is no longer supported
-func (b *BareMetalServerServiceHandler) ListVPC2Info(ctx context.Context, serverID string) ([]VPC2Info, *http.Response, error) {
-	uri := fmt.Sprintf("%s/%s/vpc2", bmPath, serverID)
-	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	vpcs := new(vpc2InfoBase)
-	resp, err := b.client.DoWithContext(ctx, req, vpcs)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return vpcs.VPCs, resp, nil
-}
-
-// AttachVPC2 to a Bare Metal server.
-//
-// Deprecated: VPC2 is no longer supported
-func (b *BareMetalServerServiceHandler) AttachVPC2(ctx context.Context, serverID string, vpc2Req *AttachVPC2Req) error {
-	uri := fmt.Sprintf("%s/%s/vpc2/attach", bmPath, serverID)
-
-	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, vpc2Req)
-	if err != nil {
-		return err
-	}
-
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
-}
-
-// DetachVPC2 from a Bare Metal server.
-//
-// Deprecated: VPC2 is no longer supported
-func (b *BareMetalServerServiceHandler) DetachVPC2(ctx context.Context, serverID, vpcID string) error {
-	uri := fmt.Sprintf("%s/%s/vpc2/detach", bmPath, serverID)
-
-	body := bareMetalVPCReq{VPCID: vpcID}
-	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, body)
-	if err != nil {
-		return err
-	}
-
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
-}
@@ -0,0 +1,195 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultBatchConcurrency is used when neither the options nor the client's
+// rate limiter set how many items a batch processes at once
+const defaultBatchConcurrency = 10
+
+// ErrBatchSkipped is the error of the batch items that weren't started
+// because an earlier item failed and StopOnError is set
+var ErrBatchSkipped = errors.New("batch item skipped after an earlier failure")
+
+// BatchOptions configures Batch
+type BatchOptions struct {
+	// Items processed at once. Defaults to the burst of the client's rate
+	// limiter when it has one, so workers don't just queue on the limiter,
+	// and to 10 otherwise.
+	Concurrency int
+
+	// Stop starting new items once one fails. Items already running finish.
+	StopOnError bool
+}
+
+// BatchResult is the outcome of one item of a batch
+type BatchResult[T any] struct {
+	Index int
+	Item  T
+	Err   error
+}
+
+// BatchReport holds the outcome of every item of a batch, in the order of
+// the items
+type BatchReport[T any] struct {
+	Results []BatchResult[T]
+}
+
+// Succeeded returns the items that were processed without an error
+func (r *BatchReport[T]) Succeeded() []T {
+	var items []T
+	for _, result := range r.Results {
+		if result.Err == nil {
+			items = append(items, result.Item)
+		}
+	}
+
+	return items
+}
+
+// Failed returns the results of the items that failed or were skipped
+func (r *BatchReport[T]) Failed() []BatchResult[T] {
+	var failed []BatchResult[T]
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err returns a *BatchError when any item failed, nil otherwise
+func (r *BatchReport[T]) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("item %d (%v): %w", result.Index, result.Item, result.Err))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &BatchError{Failed: len(errs), Total: len(r.Results), Errs: errs}
+}
+
+// BatchError reports the items of a batch that failed. It unwraps to the
+// error of every failed item, so errors.Is and errors.As look at all of them.
+type BatchError struct {
+	Failed int
+	Total  int
+	Errs   []error
+}
+
+// Error returns the number of failed items and the first failure
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("%d of %d batch items failed, first: %s", e.Failed, e.Total, e.Errs[0])
+}
+
+// Unwrap returns the errors of the failed items
+func (e *BatchError) Unwrap() []error {
+	return e.Errs
+}
+
+// Batch calls fn for every item with bounded concurrency and reports the
+// outcome of each. A failing item doesn't stop the others unless
+// StopOnError is set. Items that haven't started when ctx is done fail with
+// its error. client is only used for its rate limiter and may be nil; every
+// request fn makes with the client goes through the limiter as usual.
+//
+// Batch is a function rather than a Client method because methods can't
+// have type parameters.
+//
+//	report := govultr.Batch(ctx, client, ids, func(ctx context.Context, id string) error {
+//		return client.Instance.Reboot(ctx, id)
+//	}, &govultr.BatchOptions{Concurrency: 5})
+func Batch[T any](ctx context.Context, client *Client, items []T, fn func(ctx context.Context, item T) error, opts *BatchOptions) *BatchReport[T] { //nolint:lll
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+
+	report := &BatchReport[T]{Results: make([]BatchResult[T], len(items))}
+	if len(items) == 0 {
+		return report
+	}
+
+	jobs := make(chan int)
+	var stopped atomic.Bool
+	var wg sync.WaitGroup
+
+	for range min(batchConcurrency(client, opts), len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				result := BatchResult[T]{Index: i, Item: items[i]}
+
+				switch {
+				case stopped.Load():
+					result.Err = ErrBatchSkipped
+				case ctx.Err() != nil:
+					result.Err = ctx.Err()
+				default:
+					result.Err = fn(ctx, items[i])
+				}
+
+				if result.Err != nil && opts.StopOnError {
+					stopped.Store(true)
+				}
+
+				report.Results[i] = result
+			}
+		}()
+	}
+
+	for i := range items {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return report
+}
+
+// batchConcurrency returns how many items a batch processes at once
+func batchConcurrency(client *Client, opts *BatchOptions) int {
+	if opts.Concurrency > 0 {
+		return opts.Concurrency
+	}
+
+	if client != nil && client.rateLimiter != nil {
+		return client.rateLimiter.Stats().Burst
+	}
+
+	return defaultBatchConcurrency
+}
+
+// DeleteInstances deletes the instances with bounded concurrency, see Batch
+func (c *Client) DeleteInstances(ctx context.Context, instanceIDs []string, opts *BatchOptions) *BatchReport[string] {
+	return Batch(ctx, c, instanceIDs, c.Instance.Delete, opts)
+}
+
+// DeleteBlockStorages deletes the block storage volumes with bounded concurrency, see Batch
+func (c *Client) DeleteBlockStorages(ctx context.Context, blockIDs []string, opts *BatchOptions) *BatchReport[string] {
+	return Batch(ctx, c, blockIDs, c.BlockStorage.Delete, opts)
+}
+
+// DeleteSnapshots deletes the snapshots with bounded concurrency, see Batch
+func (c *Client) DeleteSnapshots(ctx context.Context, snapshotIDs []string, opts *BatchOptions) *BatchReport[string] {
+	return Batch(ctx, c, snapshotIDs, c.Snapshot.Delete, opts)
+}
+
+// DeleteDomainRecords deletes the records of a domain with bounded concurrency, see Batch
+func (c *Client) DeleteDomainRecords(ctx context.Context, domain string, recordIDs []string, opts *BatchOptions) *BatchReport[string] {
+	return Batch(ctx, c, recordIDs, func(ctx context.Context, id string) error {
+		return c.DomainRecord.Delete(ctx, domain, id)
+	}, opts)
+}
@@ -0,0 +1,169 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatch(t *testing.T) {
+	var running, peak atomic.Int32
+	fn := func(ctx context.Context, item int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if item%4 == 0 {
+			return fmt.Errorf("item %d failed", item)
+		}
+		return nil
+	}
+
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	report := Batch(ctx, nil, items, fn, &BatchOptions{Concurrency: 3})
+
+	if peak.Load() > 3 {
+		t.Errorf("Batch ran %d items at once, expected at most 3", peak.Load())
+	}
+
+	if len(report.Results) != len(items) {
+		t.Fatalf("Batch returned %d results, expected %d", len(report.Results), len(items))
+	}
+	for i, result := range report.Results {
+		if result.Index != i || result.Item != items[i] {
+			t.Errorf("Batch result %d is %+v, expected item %d", i, result, items[i])
+		}
+	}
+
+	expected := []int{1, 2, 3, 5, 6, 7, 9, 10}
+	if succeeded := report.Succeeded(); !reflect.DeepEqual(succeeded, expected) {
+		t.Errorf("BatchReport.Succeeded returned %+v, expected %+v", succeeded, expected)
+	}
+
+	err := report.Err()
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.Failed != 2 || batchErr.Total != 10 {
+		t.Errorf("BatchReport.Err returned %+v, expected 2 of 10 failures", err)
+	}
+}
+
+func TestBatch_StopOnError(t *testing.T) {
+	errFailed := errors.New("failed")
+	var calls atomic.Int32
+	fn := func(ctx context.Context, item int) error {
+		calls.Add(1)
+		if item == 0 {
+			return errFailed
+		}
+		return nil
+	}
+
+	report := Batch(ctx, nil, []int{0, 1, 2, 3, 4}, fn, &BatchOptions{Concurrency: 1, StopOnError: true})
+
+	if calls.Load() != 1 {
+		t.Errorf("Batch called fn %d times, expected 1", calls.Load())
+	}
+
+	err := report.Err()
+	if !errors.Is(err, errFailed) || !errors.Is(err, ErrBatchSkipped) || len(report.Failed()) != 5 {
+		t.Errorf("BatchReport.Err returned %+v, expected one failure and four skipped items", err)
+	}
+}
+
+func TestBatch_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	report := Batch(ctx, nil, []string{"a", "b"}, func(ctx context.Context, item string) error {
+		t.Errorf("Batch called fn with %s after the context was canceled", item)
+		return nil
+	}, nil)
+
+	if err := report.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("BatchReport.Err returned %+v, expected %+v", err, context.Canceled)
+	}
+}
+
+func TestBatch_RateLimiterConcurrency(t *testing.T) {
+	c, err := NewClientWithOptions(WithRateLimiter(NewRateLimiter(100, 4)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := batchConcurrency(c, &BatchOptions{}); n != 4 {
+		t.Errorf("batchConcurrency returned %d, expected the limiter burst of 4", n)
+	}
+	if n := batchConcurrency(c, &BatchOptions{Concurrency: 2}); n != 2 {
+		t.Errorf("batchConcurrency returned %d, expected 2", n)
+	}
+	if n := batchConcurrency(nil, &BatchOptions{}); n != defaultBatchConcurrency {
+		t.Errorf("batchConcurrency returned %d, expected %d", n, defaultBatchConcurrency)
+	}
+}
+
+func TestClient_DeleteInstances(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var deleted []string
+	mux.HandleFunc("/v2/instances/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Request method = %v, expected %v", r.Method, http.MethodDelete)
+		}
+
+		id := r.URL.Path[len("/v2/instances/"):]
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Instance not found","status":404}`)
+			return
+		}
+
+		mu.Lock()
+		deleted = append(deleted, id)
+		mu.Unlock()
+	})
+
+	report := client.DeleteInstances(ctx, []string{"a", "missing", "b"}, nil)
+
+	if succeeded := report.Succeeded(); !reflect.DeepEqual(succeeded, []string{"a", "b"}) {
+		t.Errorf("DeleteInstances succeeded for %+v, expected %+v", succeeded, []string{"a", "b"})
+	}
+	if failed := report.Failed(); len(failed) != 1 || failed[0].Item != "missing" || !IsNotFound(failed[0].Err) {
+		t.Errorf("DeleteInstances failed for %+v, expected missing to be not found", failed)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("DeleteInstances deleted %+v, expected 2 instances", deleted)
+	}
+}
+
+func TestClient_DeleteDomainRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls atomic.Int32
+	mux.HandleFunc("/v2/domains/example.com/records/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Request method = %v, expected %v", r.Method, http.MethodDelete)
+		}
+		calls.Add(1)
+	})
+
+	report := client.DeleteDomainRecords(ctx, "example.com", []string{"1", "2", "3"}, &BatchOptions{Concurrency: 2})
+	if err := report.Err(); err != nil || calls.Load() != 3 {
+		t.Errorf("DeleteDomainRecords returned %+v after %d calls, expected 3 deletes", err, calls.Load())
+	}
+}
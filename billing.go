@@ -25,33 +25,33 @@ type BillingServiceHandler struct {
 
 // History represents a billing history item on an account
 type History struct {
-	ID          int     `json:"id"`
-	Date        string  `json:"date"`
-	Type        string  `json:"type"`
-	Description string  `json:"description"`
-	Amount      float32 `json:"amount"`
-	Balance     float32 `json:"balance"`
+	ID          int       `json:"id"`
+	Date        Timestamp `json:"date"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Amount      float32   `json:"amount"`
+	Balance     float32   `json:"balance"`
 }
 
 // Invoice represents an invoice on an account
 type Invoice struct {
-	ID          int     `json:"id"`
-	Date        string  `json:"date"`
-	Description string  `json:"description"`
-	Amount      float32 `json:"amount"`
-	Balance     float32 `json:"balance"`
+	ID          int       `json:"id"`
+	Date        Timestamp `json:"date"`
+	Description string    `json:"description"`
+	Amount      float32   `json:"amount"`
+	Balance     float32   `json:"balance"`
 }
 
 // InvoiceItem represents an item on an accounts invoice
 type InvoiceItem struct {
-	Description string  `json:"description"`
-	Product     string  `json:"product"`
-	StartDate   string  `json:"start_date"`
-	EndDate     string  `json:"end_date"`
-	Units       int     `json:"units"`
-	UnitType    string  `json:"unit_type"`
-	UnitPrice   float32 `json:"unit_price"`
-	Total       float32 `json:"total"`
+	Description string    `json:"description"`
+	Product     string    `json:"product"`
+	StartDate   Timestamp `json:"start_date"`
+	EndDate     Timestamp `json:"end_date"`
+	Units       int       `json:"units"`
+	UnitType    string    `json:"unit_type"`
+	UnitPrice   float32   `json:"unit_price"`
+	Total       float32   `json:"total"`
 }
 
 type billingHistoryBase struct {
@@ -0,0 +1,205 @@
+// Package billing exports the account's invoices and pending charges for
+// finance.
+//
+// An Exporter fetches the invoices of a date range with all of their items
+// and flattens them into records, which can be grouped by product, unit type,
+// resource or tag and written as CSV, JSON or FOCUS cost records.
+//
+//	export, err := billing.New(billing.WithPendingCharges()).Fetch(ctx, client.Billing, start, end)
+//	if err != nil {
+//		...
+//	}
+//
+//	resources, err := billing.ListResources(ctx, client)
+//	if err != nil {
+//		...
+//	}
+//	export.Join(resources)
+//
+//	export.WriteFOCUS(os.Stdout)
+//
+// Invoice items don't reference the resource they bill, only describe it.
+// Join matches records to resources by the ID or label in their description,
+// so resources with unique labels join best.
+package billing
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Exporter fetches billing data
+type Exporter struct {
+	pending bool
+}
+
+// Option configures an Exporter
+type Option func(*Exporter)
+
+// WithPendingCharges adds the pending charges of the current month to the
+// records, whatever the date range
+func WithPendingCharges() Option {
+	return func(x *Exporter) {
+		x.pending = true
+	}
+}
+
+// New returns an Exporter configured by opts
+func New(opts ...Option) *Exporter {
+	x := &Exporter{}
+	for _, opt := range opts {
+		opt(x)
+	}
+
+	return x
+}
+
+// Export is the billing data of a date range
+type Export struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Invoices dated in the range with their items
+	Invoices []Invoice `json:"invoices"`
+
+	// Items of the invoices, then the pending charges
+	Records []Record `json:"records"`
+}
+
+// Invoice is an invoice with its items
+type Invoice struct {
+	govultr.Invoice
+	Items []govultr.InvoiceItem `json:"items"`
+}
+
+// Record is an invoice item or pending charge
+type Record struct {
+	// Invoice of the item, 0 for pending charges
+	InvoiceID   int       `json:"invoice_id"`
+	InvoiceDate time.Time `json:"invoice_date"`
+	Pending     bool      `json:"pending"`
+
+	Description string    `json:"description"`
+	Product     string    `json:"product"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Units       int       `json:"units"`
+	UnitType    string    `json:"unit_type"`
+	UnitPrice   float64   `json:"unit_price"`
+	Total       float64   `json:"total"`
+
+	// Resource the item bills, set by Join
+	Resource *Resource `json:"resource,omitempty"`
+}
+
+// Fetch returns the invoices dated from start up to end with all of their
+// items. A zero end means there's no end.
+func (x *Exporter) Fetch(ctx context.Context, billing govultr.BillingService, start, end time.Time) (*Export, error) {
+	invoices, err := govultr.CollectAll(ctx, nil, billing.ListInvoices)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &Export{Start: start, End: end}
+	for i := range invoices {
+		date := invoices[i].Date.Time()
+		if date.Before(start) || (!end.IsZero() && !date.Before(end)) {
+			continue
+		}
+
+		id := invoices[i].ID
+		items, err := govultr.CollectAll(ctx, nil, func(ctx context.Context, o *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *http.Response, error) { //nolint:lll
+			return billing.ListInvoiceItems(ctx, id, o)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		export.Invoices = append(export.Invoices, Invoice{Invoice: invoices[i], Items: items})
+		for j := range items {
+			export.Records = append(export.Records, newRecord(&items[j], id, date))
+		}
+	}
+
+	if x.pending {
+		items, _, err := billing.ListPendingCharges(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range items {
+			r := newRecord(&items[j], 0, time.Time{})
+			r.Pending = true
+			export.Records = append(export.Records, r)
+		}
+	}
+
+	return export, nil
+}
+
+func newRecord(item *govultr.InvoiceItem, invoiceID int, invoiceDate time.Time) Record {
+	return Record{
+		InvoiceID:   invoiceID,
+		InvoiceDate: invoiceDate,
+		Description: item.Description,
+		Product:     item.Product,
+		Start:       item.StartDate.Time(),
+		End:         item.EndDate.Time(),
+		Units:       item.Units,
+		UnitType:    item.UnitType,
+		UnitPrice:   Amount(item.UnitPrice),
+		Total:       Amount(item.Total),
+	}
+}
+
+// Amount converts an amount of the API to float64 without picking up the
+// float32 rounding error, so 0.1 stays 0.1
+func Amount(f float32) float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'f', -1, 32), 64)
+	return v
+}
+
+// Join sets the resource of each record to the one Match finds for its
+// description
+func (e *Export) Join(resources []Resource) {
+	for i := range e.Records {
+		e.Records[i].Resource = Match(e.Records[i].Description, resources)
+	}
+}
+
+// Match returns the resource whose ID, or else label, the description of an
+// invoice item contains. The longest label wins and nil is returned when
+// several resources match by the same label.
+func Match(description string, resources []Resource) *Resource {
+	var best *Resource
+	ambiguous := false
+
+	for i := range resources {
+		r := &resources[i]
+		if r.ID != "" && strings.Contains(description, r.ID) {
+			return r
+		}
+
+		if r.Label == "" || !strings.Contains(description, r.Label) {
+			continue
+		}
+
+		switch {
+		case best == nil || len(r.Label) > len(best.Label):
+			best, ambiguous = r, false
+		case len(r.Label) == len(best.Label):
+			ambiguous = true
+		}
+	}
+
+	if ambiguous {
+		return nil
+	}
+
+	return best
+}
@@ -0,0 +1,308 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/govultrmock"
+)
+
+var (
+	start = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	end   = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func item(description, product string, units int, unitPrice, total float32) govultr.InvoiceItem {
+	return govultr.InvoiceItem{
+		Description: description,
+		Product:     product,
+		StartDate:   "2025-07-01T00:00:00+00:00",
+		EndDate:     "2025-08-01T00:00:00+00:00",
+		Units:       units,
+		UnitType:    "hours",
+		UnitPrice:   unitPrice,
+		Total:       total,
+	}
+}
+
+func testMock() *govultrmock.MockClient {
+	mock := govultrmock.NewMockClient()
+
+	mock.Billing.ListInvoicesFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Invoice, *govultr.Meta, *http.Response, error) {
+		if options.Cursor == "" {
+			return []govultr.Invoice{
+				{ID: 3, Date: "2025-09-01T00:00:00+00:00", Amount: 1},
+				{ID: 2, Date: "2025-08-01T00:00:00+00:00", Amount: 12.1},
+			}, &govultr.Meta{Links: &govultr.Links{Next: "next"}}, nil, nil
+		}
+		return []govultr.Invoice{{ID: 1, Date: "2025-07-01T00:00:00+00:00", Amount: 5}}, nil, nil, nil
+	}
+	mock.Billing.ListInvoiceItemsFunc = func(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *http.Response, error) {
+		switch {
+		case invoiceID == 2 && options.Cursor == "":
+			return []govultr.InvoiceItem{item("Cloud Compute web-1 (vc2-1c-1gb)", "Vultr Cloud Compute", 672, 0.007, 5)},
+				&govultr.Meta{Links: &govultr.Links{Next: "next"}}, nil, nil
+		case invoiceID == 2:
+			return []govultr.InvoiceItem{
+				item("Block Storage data", "Vultr Block Storage", 672, 0.0015, 1),
+				item("Managed Database db-abc123", "Vultr Managed Database", 100, 0.061, 6.1),
+			}, nil, nil, nil
+		case invoiceID == 1:
+			return []govultr.InvoiceItem{item("Cloud Compute web-1 (vc2-1c-1gb)", "Vultr Cloud Compute", 672, 0.007, 5)}, nil, nil, nil
+		}
+		return nil, nil, nil, errors.New("unexpected invoice")
+	}
+	mock.Billing.ListPendingChargesFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error) {
+		return []govultr.InvoiceItem{item("Cloud Compute web-10", "Vultr Cloud Compute", 10, 0.007, 0.07)}, nil, nil
+	}
+
+	return mock
+}
+
+func testResources() []Resource {
+	return []Resource{
+		{Kind: KindInstance, ID: "inst-1", Label: "web-1", Tags: []string{"team:web", "env:prod"}},
+		{Kind: KindInstance, ID: "inst-10", Label: "web-10", Tags: []string{"team:web"}},
+		{Kind: KindInstance, ID: "inst-2", Label: "web", Tags: []string{"team:other"}},
+		{Kind: KindBlockStorage, ID: "blk-1", Label: "data"},
+		{Kind: KindDatabase, ID: "db-abc123", Label: "Managed", Tags: []string{"team:data"}},
+	}
+}
+
+func TestExporter_Fetch(t *testing.T) {
+	mock := testMock()
+
+	export, err := New().Fetch(context.Background(), mock.Billing, start, end)
+	if err != nil {
+		t.Fatalf("Fetch returned %+v", err)
+	}
+
+	var ids []int
+	for _, invoice := range export.Invoices {
+		ids = append(ids, invoice.ID)
+	}
+	if !slices.Equal(ids, []int{2, 1}) {
+		t.Errorf("Fetch returned invoices %v, expected those dated in the range", ids)
+	}
+
+	if len(export.Records) != 4 || export.Records[0].InvoiceID != 2 || export.Records[3].InvoiceID != 1 {
+		t.Fatalf("Fetch returned records %+v, expected every page of items", export.Records)
+	}
+	if r := export.Records[2]; r.UnitPrice != 0.061 || r.Total != 6.1 || !r.Start.Equal(start) || !r.InvoiceDate.Equal(end.AddDate(0, -1, 0)) {
+		t.Errorf("Fetch returned %+v", r)
+	}
+
+	if n := len(mock.Billing.CallsTo("ListPendingCharges")); n != 0 {
+		t.Errorf("Fetch listed pending charges %d times without the option", n)
+	}
+
+	export, err = New(WithPendingCharges()).Fetch(context.Background(), mock.Billing, start, end)
+	if err != nil {
+		t.Fatalf("Fetch returned %+v", err)
+	}
+	if last := export.Records[len(export.Records)-1]; !last.Pending || last.InvoiceID != 0 || last.Total != 0.07 {
+		t.Errorf("Fetch returned %+v, expected the pending charge last", last)
+	}
+
+	failing := errors.New("items failed")
+	mock.Billing.ListInvoiceItemsFunc = func(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *http.Response, error) {
+		return nil, nil, nil, failing
+	}
+	if _, err := New().Fetch(context.Background(), mock.Billing, start, end); !errors.Is(err, failing) {
+		t.Errorf("Fetch returned %+v, expected %+v", err, failing)
+	}
+}
+
+func TestExport_Join(t *testing.T) {
+	export, err := New(WithPendingCharges()).Fetch(context.Background(), testMock().Billing, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	export.Join(testResources())
+
+	var joined []string
+	for _, r := range export.Records {
+		if r.Resource == nil {
+			joined = append(joined, "")
+			continue
+		}
+		joined = append(joined, r.Resource.ID)
+	}
+
+	// The longest label wins and an ID beats a label
+	expected := []string{"inst-1", "blk-1", "db-abc123", "inst-1", "inst-10"}
+	if !slices.Equal(joined, expected) {
+		t.Errorf("Join joined %v, expected %v", joined, expected)
+	}
+
+	export.Join([]Resource{{ID: "inst-a", Label: "web-1"}, {ID: "inst-b", Label: "web-1"}})
+	if r := export.Records[0].Resource; r != nil {
+		t.Errorf("Join joined %+v, expected no resource for an ambiguous label", r)
+	}
+}
+
+func TestExport_Group(t *testing.T) {
+	export, err := New(WithPendingCharges()).Fetch(context.Background(), testMock().Billing, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	export.Join(testResources())
+
+	expected := []Group{
+		{Key: "Vultr Cloud Compute", Records: 3, Units: 1354, Total: 10.07},
+		{Key: "Vultr Managed Database", Records: 1, Units: 100, Total: 6.1},
+		{Key: "Vultr Block Storage", Records: 1, Units: 672, Total: 1},
+	}
+	if groups := export.Group(ByProduct); !slices.Equal(groups, expected) {
+		t.Errorf("Group(ByProduct) returned %+v, expected %+v", groups, expected)
+	}
+
+	if groups := export.Group(ByUnitType); len(groups) != 1 || groups[0].Total != 17.17 {
+		t.Errorf("Group(ByUnitType) returned %+v", groups)
+	}
+
+	if groups := export.Group(ByResource); len(groups) != 4 || groups[0].Key != "instance web-1" || groups[0].Total != 10 {
+		t.Errorf("Group(ByResource) returned %+v", groups)
+	}
+
+	expected = []Group{
+		{Key: "team:web", Records: 3, Units: 1354, Total: 10.07},
+		{Key: "env:prod", Records: 2, Units: 1344, Total: 10},
+		{Key: "team:data", Records: 1, Units: 100, Total: 6.1},
+		{Key: "", Records: 1, Units: 672, Total: 1},
+	}
+	if groups := export.Group(ByTag); !slices.Equal(groups, expected) {
+		t.Errorf("Group(ByTag) returned %+v, expected %+v", groups, expected)
+	}
+}
+
+func TestExport_Write(t *testing.T) {
+	export, err := New().Fetch(context.Background(), testMock().Billing, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	export.Join(testResources())
+
+	var buf bytes.Buffer
+	if err := export.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON returned %+v", err)
+	}
+	decoded := &Export{}
+	if err := json.Unmarshal(buf.Bytes(), decoded); err != nil || len(decoded.Records) != 4 || decoded.Records[0].Resource.ID != "inst-1" {
+		t.Errorf("WriteJSON wrote %s, %+v", buf.String(), err)
+	}
+
+	buf.Reset()
+	if err := export.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV returned %+v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRow := []string{
+		"2", "2025-08-01T00:00:00Z", "false", "Cloud Compute web-1 (vc2-1c-1gb)", "Vultr Cloud Compute", "2025-07-01T00:00:00Z",
+		"2025-08-01T00:00:00Z", "672", "hours", "0.007", "5", "instance", "inst-1", "web-1", "team:web;env:prod",
+	}
+	if len(rows) != 5 || !slices.Equal(rows[0], csvHeader) || !slices.Equal(rows[1], expectedRow) {
+		t.Errorf("WriteCSV wrote %v", rows)
+	}
+
+	buf.Reset()
+	if err := export.WriteFOCUS(&buf); err != nil {
+		t.Fatalf("WriteFOCUS returned %+v", err)
+	}
+	rows, err = csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 5 || !slices.Equal(rows[0], focusHeader) {
+		t.Fatalf("WriteFOCUS wrote %v", rows)
+	}
+
+	record := map[string]string{}
+	for i, column := range rows[1] {
+		record[focusHeader[i]] = column
+	}
+	expected := map[string]string{
+		"BilledCost": "5", "BillingPeriodStart": "2025-07-01T00:00:00Z", "BillingPeriodEnd": "2025-08-01T00:00:00Z",
+		"ListCost": "4.704", "InvoiceId": "2", "ResourceId": "inst-1", "ResourceType": "instance",
+		"ServiceName": "Vultr Cloud Compute", "Tags": `{"env:prod":"","team:web":""}`,
+	}
+	for column, value := range expected {
+		if record[column] != value {
+			t.Errorf("WriteFOCUS wrote %s %q, expected %q", column, record[column], value)
+		}
+	}
+}
+
+func TestListResources(t *testing.T) {
+	mock := govultrmock.NewMockClient()
+	mock.Instance.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *http.Response, error) {
+		return []govultr.Instance{{ID: "inst-1", Label: "web-1", Plan: "vc2-1c-1gb", Tags: []string{"team:web"}}}, nil, nil, nil
+	}
+	mock.BareMetalServer.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *http.Response, error) {
+		return []govultr.BareMetalServer{{ID: "bm-1", Label: "metal"}}, nil, nil, nil
+	}
+	mock.BlockStorage.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *http.Response, error) {
+		return []govultr.BlockStorage{{ID: "blk-1", Label: "data", BlockType: govultr.BlockTypeHighPerf, SizeGB: 100}}, nil, nil, nil
+	}
+	mock.Database.ListFunc = func(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *http.Response, error) {
+		return []govultr.Database{{ID: "db-1", Label: "db", Tag: "team:data"}}, nil, nil, nil
+	}
+	mock.LoadBalancer.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *http.Response, error) {
+		return []govultr.LoadBalancer{{ID: "lb-1", Label: "lb"}}, nil, nil, nil
+	}
+	mock.VirtualFileSystemStorage.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.VirtualFileSystemStorage, *govultr.Meta, *http.Response, error) {
+		return []govultr.VirtualFileSystemStorage{{ID: "vfs-1", Label: "files", Tags: []string{"team:web"}}}, nil, nil, nil
+	}
+	mock.Kubernetes.ListClustersFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *http.Response, error) {
+		return []govultr.Cluster{{ID: "vke-1", Label: "k8s", Region: "ewr", NodePools: []govultr.NodePool{{ID: "np-1", Label: "pool", NodeQuantity: 3, Tag: "team:k8s"}}}}, nil, nil, nil
+	}
+	mock.ObjectStorage.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *http.Response, error) {
+		return []govultr.ObjectStorage{{ID: "obj-1", Label: "bucket"}}, nil, nil, nil
+	}
+
+	resources, err := ListResources(context.Background(), mock.Client)
+	if err != nil {
+		t.Fatalf("ListResources returned %+v", err)
+	}
+
+	var kinds []string
+	for _, r := range resources {
+		kinds = append(kinds, r.Kind+" "+r.ID)
+	}
+	expected := []string{
+		"instance inst-1", "bare_metal bm-1", "block_storage blk-1", "database db-1", "load_balancer lb-1",
+		"file_system vfs-1", "kubernetes vke-1", "node_pool np-1", "object_storage obj-1",
+	}
+	if !slices.Equal(kinds, expected) {
+		t.Errorf("ListResources returned %v, expected %v", kinds, expected)
+	}
+
+	if db := resources[3]; !slices.Equal(db.Tags, []string{"team:data"}) {
+		t.Errorf("ListResources returned %+v, expected the database's tag", db)
+	}
+	if pool := resources[7]; pool.Region != "ewr" || pool.Quantity != 3 || !slices.Equal(pool.Tags, []string{"team:k8s"}) {
+		t.Errorf("ListResources returned %+v, expected the cluster's region and the pool's nodes and tag", pool)
+	}
+	if blk := resources[2]; blk.Plan != "high_perf" || blk.Quantity != 100 || blk.Tags != nil {
+		t.Errorf("ListResources returned %+v", blk)
+	}
+	if inst := resources[0]; inst.Quantity != 1 {
+		t.Errorf("ListResources returned %+v, expected a quantity of 1", inst)
+	}
+
+	mock.LoadBalancer.ListFunc = nil
+	if _, err := ListResources(context.Background(), mock.Client); err == nil {
+		t.Error("ListResources returned no error when a service failed")
+	}
+}
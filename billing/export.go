@@ -0,0 +1,192 @@
+package billing
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Values of the FOCUS columns that are the same for every record
+const (
+	focusProvider = "Vultr"
+	focusCurrency = "USD"
+	focusUsage    = "Usage"
+)
+
+// precision rounds list costs computed from unit prices, which are fractions
+// of a cent, to the millionth
+const precision = 1e6
+
+// WriteJSON writes the export to w as indented JSON
+func (e *Export) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(e)
+}
+
+// csvHeader are the columns of WriteCSV
+var csvHeader = []string{
+	"invoice_id", "invoice_date", "pending", "description", "product", "start", "end", "units", "unit_type", "unit_price", "total",
+	"resource_kind", "resource_id", "resource_label", "tags",
+}
+
+// WriteCSV writes the records to w as CSV. Tags are separated by semicolons.
+func (e *Export) WriteCSV(w io.Writer) error {
+	rows := [][]string{csvHeader}
+	for i := range e.Records {
+		r := &e.Records[i]
+
+		var kind, id, label, tags string
+		if r.Resource != nil {
+			kind, id, label, tags = r.Resource.Kind, r.Resource.ID, r.Resource.Label, strings.Join(r.Resource.Tags, ";")
+		}
+
+		invoice := ""
+		if r.InvoiceID != 0 {
+			invoice = strconv.Itoa(r.InvoiceID)
+		}
+
+		rows = append(rows, []string{
+			invoice, csvTime(r.InvoiceDate), strconv.FormatBool(r.Pending), r.Description, r.Product, csvTime(r.Start), csvTime(r.End),
+			strconv.Itoa(r.Units), r.UnitType, csvFloat(r.UnitPrice), csvFloat(r.Total), kind, id, label, tags,
+		})
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// FOCUSRecord is a cost record in the FinOps Open Cost and Usage
+// Specification format, with its column names
+type FOCUSRecord struct {
+	BilledCost         float64           `json:"BilledCost"`
+	BillingCurrency    string            `json:"BillingCurrency"`
+	BillingPeriodStart time.Time         `json:"BillingPeriodStart"`
+	BillingPeriodEnd   time.Time         `json:"BillingPeriodEnd"`
+	ChargeCategory     string            `json:"ChargeCategory"`
+	ChargeDescription  string            `json:"ChargeDescription"`
+	ChargePeriodStart  time.Time         `json:"ChargePeriodStart"`
+	ChargePeriodEnd    time.Time         `json:"ChargePeriodEnd"`
+	ConsumedQuantity   int               `json:"ConsumedQuantity"`
+	ConsumedUnit       string            `json:"ConsumedUnit"`
+	EffectiveCost      float64           `json:"EffectiveCost"`
+	InvoiceID          string            `json:"InvoiceId"`
+	InvoiceIssuerName  string            `json:"InvoiceIssuerName"`
+	ListCost           float64           `json:"ListCost"`
+	ListUnitPrice      float64           `json:"ListUnitPrice"`
+	PricingQuantity    int               `json:"PricingQuantity"`
+	PricingUnit        string            `json:"PricingUnit"`
+	ProviderName       string            `json:"ProviderName"`
+	PublisherName      string            `json:"PublisherName"`
+	RegionID           string            `json:"RegionId"`
+	ResourceID         string            `json:"ResourceId"`
+	ResourceName       string            `json:"ResourceName"`
+	ResourceType       string            `json:"ResourceType"`
+	ServiceName        string            `json:"ServiceName"`
+	Tags               map[string]string `json:"Tags"`
+}
+
+// focusHeader are the columns of WriteFOCUS
+var focusHeader = []string{
+	"BilledCost", "BillingCurrency", "BillingPeriodStart", "BillingPeriodEnd", "ChargeCategory", "ChargeDescription",
+	"ChargePeriodStart", "ChargePeriodEnd", "ConsumedQuantity", "ConsumedUnit", "EffectiveCost", "InvoiceId", "InvoiceIssuerName",
+	"ListCost", "ListUnitPrice", "PricingQuantity", "PricingUnit", "ProviderName", "PublisherName", "RegionId", "ResourceId",
+	"ResourceName", "ResourceType", "ServiceName", "Tags",
+}
+
+// FOCUS returns the records as FOCUS cost records. The billing period is the
+// calendar month the charge started in. Vultr tags have no value, so they are
+// keys with empty values.
+func (e *Export) FOCUS() []FOCUSRecord {
+	records := make([]FOCUSRecord, 0, len(e.Records))
+	for i := range e.Records {
+		r := &e.Records[i]
+
+		start := r.Start.UTC()
+		period := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		f := FOCUSRecord{
+			BilledCost:         r.Total,
+			BillingCurrency:    focusCurrency,
+			BillingPeriodStart: period,
+			BillingPeriodEnd:   period.AddDate(0, 1, 0),
+			ChargeCategory:     focusUsage,
+			ChargeDescription:  r.Description,
+			ChargePeriodStart:  r.Start,
+			ChargePeriodEnd:    r.End,
+			ConsumedQuantity:   r.Units,
+			ConsumedUnit:       r.UnitType,
+			EffectiveCost:      r.Total,
+			InvoiceIssuerName:  focusProvider,
+			ListCost:           math.Round(r.UnitPrice*float64(r.Units)*precision) / precision,
+			ListUnitPrice:      r.UnitPrice,
+			PricingQuantity:    r.Units,
+			PricingUnit:        r.UnitType,
+			ProviderName:       focusProvider,
+			PublisherName:      focusProvider,
+			ServiceName:        r.Product,
+			Tags:               map[string]string{},
+		}
+		if r.InvoiceID != 0 {
+			f.InvoiceID = strconv.Itoa(r.InvoiceID)
+		}
+		if res := r.Resource; res != nil {
+			f.RegionID, f.ResourceID, f.ResourceName, f.ResourceType = res.Region, res.ID, res.Label, res.Kind
+			for _, tag := range res.Tags {
+				f.Tags[tag] = ""
+			}
+		}
+
+		records = append(records, f)
+	}
+
+	return records
+}
+
+// WriteFOCUS writes the FOCUS cost records to w as CSV, with the tags as a
+// JSON object
+func (e *Export) WriteFOCUS(w io.Writer) error {
+	rows := [][]string{focusHeader}
+	for _, f := range e.FOCUS() {
+		tags, err := json.Marshal(f.Tags)
+		if err != nil {
+			return err
+		}
+
+		rows = append(rows, []string{
+			csvFloat(f.BilledCost), f.BillingCurrency, csvTime(f.BillingPeriodStart), csvTime(f.BillingPeriodEnd), f.ChargeCategory,
+			f.ChargeDescription, csvTime(f.ChargePeriodStart), csvTime(f.ChargePeriodEnd), strconv.Itoa(f.ConsumedQuantity),
+			f.ConsumedUnit, csvFloat(f.EffectiveCost), f.InvoiceID, f.InvoiceIssuerName, csvFloat(f.ListCost), csvFloat(f.ListUnitPrice),
+			strconv.Itoa(f.PricingQuantity), f.PricingUnit, f.ProviderName, f.PublisherName, f.RegionID, f.ResourceID, f.ResourceName,
+			f.ResourceType, f.ServiceName, string(tags),
+		})
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+func csvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func csvFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,87 @@
+package billing
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+// cents rounds group totals to the cent
+const cents = 100
+
+// Group is the total of the records sharing a key
+type Group struct {
+	Key     string  `json:"key"`
+	Records int     `json:"records"`
+	Units   int     `json:"units"`
+	Total   float64 `json:"total"`
+}
+
+// GroupBy returns the keys a record is grouped under
+type GroupBy func(*Record) []string
+
+// ByProduct groups records by product
+func ByProduct(r *Record) []string {
+	return []string{r.Product}
+}
+
+// ByUnitType groups records by unit type, e.g. hours
+func ByUnitType(r *Record) []string {
+	return []string{r.UnitType}
+}
+
+// ByResource groups records by the label of their resource, or their
+// description when they aren't joined to one
+func ByResource(r *Record) []string {
+	if r.Resource != nil {
+		return []string{r.Resource.Kind + " " + r.Resource.Label}
+	}
+
+	return []string{r.Description}
+}
+
+// ByTag groups records by the tags of their resource. Records of resources
+// with several tags count towards each of them, and those without tags or a
+// resource are grouped under "".
+func ByTag(r *Record) []string {
+	if r.Resource == nil || len(r.Resource.Tags) == 0 {
+		return []string{""}
+	}
+
+	return r.Resource.Tags
+}
+
+// Group totals the records by key, highest total first
+func (e *Export) Group(by GroupBy) []Group {
+	groups := map[string]*Group{}
+	for i := range e.Records {
+		for _, key := range by(&e.Records[i]) {
+			g := groups[key]
+			if g == nil {
+				g = &Group{Key: key}
+				groups[key] = g
+			}
+
+			g.Records++
+			g.Units += e.Records[i].Units
+			g.Total += e.Records[i].Total
+		}
+	}
+
+	result := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		g.Total = math.Round(g.Total*cents) / cents
+		result = append(result, *g)
+	}
+	slices.SortFunc(result, func(a, b Group) int {
+		if a.Total != b.Total {
+			if a.Total > b.Total {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return result
+}
@@ -0,0 +1,152 @@
+package billing
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Kinds of Resource
+const (
+	KindInstance      = "instance"
+	KindBareMetal     = "bare_metal"
+	KindBlockStorage  = "block_storage"
+	KindDatabase      = "database"
+	KindLoadBalancer  = "load_balancer"
+	KindFileSystem    = "file_system"
+	KindKubernetes    = "kubernetes"
+	KindNodePool      = "node_pool"
+	KindObjectStorage = "object_storage"
+)
+
+// Resource is a billable resource of the account
+type Resource struct {
+	Kind   string   `json:"kind"`
+	ID     string   `json:"id"`
+	Label  string   `json:"label"`
+	Region string   `json:"region,omitempty"`
+	Plan   string   `json:"plan,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+
+	// Units the resource is billed by: the nodes of a node pool and the GB
+	// of block and file system storage, 1 for the rest
+	Quantity int `json:"quantity"`
+}
+
+// ListResources lists the billable resources of the account, with their
+// tags where the service has them
+func ListResources(ctx context.Context, client *govultr.Client) ([]Resource, error) {
+	var resources []Resource
+
+	instances, err := govultr.CollectAll(ctx, nil, client.Instance.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range instances {
+		v := &instances[i]
+		resources = append(resources, Resource{
+			Kind: KindInstance, ID: v.ID, Label: v.Label, Region: v.Region, Plan: v.Plan, Tags: v.Tags, Quantity: 1,
+		})
+	}
+
+	servers, err := govultr.CollectAll(ctx, nil, client.BareMetalServer.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range servers {
+		v := &servers[i]
+		resources = append(resources, Resource{
+			Kind: KindBareMetal, ID: v.ID, Label: v.Label, Region: v.Region, Plan: v.Plan, Tags: v.Tags, Quantity: 1,
+		})
+	}
+
+	blocks, err := govultr.CollectAll(ctx, nil, client.BlockStorage.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range blocks {
+		v := &blocks[i]
+		resources = append(resources, Resource{
+			Kind: KindBlockStorage, ID: v.ID, Label: v.Label, Region: v.Region, Plan: string(v.BlockType), Quantity: v.SizeGB,
+		})
+	}
+
+	databases, _, _, err := client.Database.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range databases {
+		v := &databases[i]
+		resources = append(resources, Resource{
+			Kind: KindDatabase, ID: v.ID, Label: v.Label, Region: v.Region, Plan: v.Plan, Tags: tags(v.Tag), Quantity: 1,
+		})
+	}
+
+	more, err := listMore(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(resources, more...), nil
+}
+
+// listMore lists the load balancers, file systems, Kubernetes clusters and
+// object storage of the account
+func listMore(ctx context.Context, client *govultr.Client) ([]Resource, error) {
+	var resources []Resource
+
+	lbs, err := govultr.CollectAll(ctx, nil, client.LoadBalancer.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range lbs {
+		v := &lbs[i]
+		resources = append(resources, Resource{Kind: KindLoadBalancer, ID: v.ID, Label: v.Label, Region: v.Region, Quantity: 1})
+	}
+
+	vfs, err := govultr.CollectAll(ctx, nil, client.VirtualFileSystemStorage.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vfs {
+		v := &vfs[i]
+		resources = append(resources, Resource{
+			Kind: KindFileSystem, ID: v.ID, Label: v.Label, Region: v.Region, Plan: v.DiskType, Tags: v.Tags, Quantity: v.StorageSize.SizeGB,
+		})
+	}
+
+	clusters, err := govultr.CollectAll(ctx, nil, client.Kubernetes.ListClusters)
+	if err != nil {
+		return nil, err
+	}
+	for i := range clusters {
+		v := &clusters[i]
+		resources = append(resources, Resource{Kind: KindKubernetes, ID: v.ID, Label: v.Label, Region: v.Region, Quantity: 1})
+		for j := range v.NodePools {
+			p := &v.NodePools[j]
+			resources = append(resources, Resource{
+				Kind: KindNodePool, ID: p.ID, Label: p.Label, Region: v.Region, Plan: p.Plan, Tags: tags(p.Tag), Quantity: p.NodeQuantity,
+			})
+		}
+	}
+
+	objects, err := govultr.CollectAll(ctx, nil, client.ObjectStorage.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range objects {
+		v := &objects[i]
+		resources = append(resources, Resource{Kind: KindObjectStorage, ID: v.ID, Label: v.Label, Region: v.Region, Quantity: 1})
+	}
+
+	return resources, nil
+}
+
+// tags returns the single tag of services that have one as tags
+func tags(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+
+	return []string{tag}
+}
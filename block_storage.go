@@ -8,6 +8,8 @@ import (
 	"github.com/google/go-querystring/query"
 )
 
+const blockStoragePath = "/v2/blocks"
+
 // BlockStorageService is the interface to interact with Block-Storage endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/block
 type BlockStorageService interface {
@@ -19,6 +21,12 @@ type BlockStorageService interface {
 
 	Attach(ctx context.Context, blockID string, attach *BlockStorageAttach) error
 	Detach(ctx context.Context, blockID string, detach *BlockStorageDetach) error
+
+	ListSnapshots(ctx context.Context, options *ListOptions) ([]BlockStorageSnapshot, *Meta, *http.Response, error)
+	GetSnapshot(ctx context.Context, snapshotID string) (*BlockStorageSnapshot, *http.Response, error)
+	CreateSnapshot(ctx context.Context, snapshotReq *BlockStorageSnapshotReq) (*BlockStorageSnapshot, *http.Response, error)
+	UpdateSnapshot(ctx context.Context, snapshotID string, snapshotReq *BlockStorageSnapshotReq) error
+	DeleteSnapshot(ctx context.Context, snapshotID string) error
 }
 
 // BlockStorageServiceHandler handles interaction with the block-storage methods for the Vultr API
@@ -28,33 +36,33 @@ type BlockStorageServiceHandler struct {
 
 // BlockStorage represents Vultr Block-Storage
 type BlockStorage struct {
-	ID                      string  `json:"id"`
-	DateCreated             string  `json:"date_created"`
-	Cost                    float32 `json:"cost"`
-	PendingCharges          float32 `json:"pending_charges"`
-	Status                  string  `json:"status"`
-	SizeGB                  int     `json:"size_gb"`
-	Region                  string  `json:"region"`
-	AttachedToInstance      string  `json:"attached_to_instance"`
-	AttachedToInstanceIP    string  `json:"attached_to_instance_ip"`
-	AttachedToInstanceLabel string  `json:"attached_to_instance_label"`
-	Label                   string  `json:"label"`
-	MountID                 string  `json:"mount_id"`
-	BlockType               string  `json:"block_type"`
-	OSID                    int     `json:"os_id"`
-	SnapshotID              string  `json:"snapshot_id"`
-	Bootable                bool    `json:"bootable"`
+	ID                      string    `json:"id"`
+	DateCreated             Timestamp `json:"date_created"`
+	Cost                    float32   `json:"cost"`
+	PendingCharges          float32   `json:"pending_charges"`
+	Status                  string    `json:"status"`
+	SizeGB                  int       `json:"size_gb"`
+	Region                  string    `json:"region"`
+	AttachedToInstance      string    `json:"attached_to_instance"`
+	AttachedToInstanceIP    string    `json:"attached_to_instance_ip"`
+	AttachedToInstanceLabel string    `json:"attached_to_instance_label"`
+	Label                   string    `json:"label"`
+	MountID                 string    `json:"mount_id"`
+	BlockType               BlockType `json:"block_type"`
+	OSID                    int       `json:"os_id"`
+	SnapshotID              string    `json:"snapshot_id"`
+	Bootable                bool      `json:"bootable"`
 }
 
 // BlockStorageCreate struct is used for creating Block Storage.
 type BlockStorageCreate struct {
-	Region     string `json:"region"`
-	SizeGB     int    `json:"size_gb"`
-	Label      string `json:"label,omitempty"`
-	BlockType  string `json:"block_type,omitempty"`
-	SnapshotID string `json:"snapshot_id,omitempty"`
-	OSID       int    `json:"os_id,omitempty"`
-	Bootable   *bool  `json:"bootable,omitempty"`
+	Region     string    `json:"region"`
+	SizeGB     int       `json:"size_gb"`
+	Label      string    `json:"label,omitempty"`
+	BlockType  BlockType `json:"block_type,omitempty"`
+	SnapshotID string    `json:"snapshot_id,omitempty"`
+	OSID       int       `json:"os_id,omitempty"`
+	Bootable   *bool     `json:"bootable,omitempty"`
 }
 
 // BlockStorageUpdate struct is used to update Block Storage.
@@ -83,11 +91,34 @@ type blockStorageBase struct {
 	Block *BlockStorage `json:"block"`
 }
 
+// BlockStorageSnapshotReq represents the create and update request body for a
+// block storage snapshot
+type BlockStorageSnapshotReq struct {
+	BlockID     string `json:"block_id,omitempty"`
+	Description string `json:"description"`
+}
+
+// BlockStorageSnapshot represents the details for a block storage snapshot
+type BlockStorageSnapshot struct {
+	ID               string    `json:"id"`
+	Description      string    `json:"description"`
+	BlockID          string    `json:"block_id"`
+	State            string    `json:"state"`
+	DateCreated      Timestamp `json:"added_at"`
+	DateUpdated      Timestamp `json:"updated_at"`
+	InvoiceNextDate  Timestamp `json:"next_invoice_date"`
+	InvoiceNextPrice string    `json:"next_invoice_price"`
+	Size             int       `json:"size"`
+}
+
+type blockStorageSnapshotsBase struct {
+	Snapshots []BlockStorageSnapshot `json:"snapshots"`
+	Meta      *Meta                  `json:"meta"`
+}
+
 // Create builds out a block storage
 func (b *BlockStorageServiceHandler) Create(ctx context.Context, blockReq *BlockStorageCreate) (*BlockStorage, *http.Response, error) {
-	uri := "/v2/blocks"
-
-	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, blockReq)
+	req, err := b.client.NewRequest(ctx, http.MethodPost, blockStoragePath, blockReq)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,7 +134,7 @@ func (b *BlockStorageServiceHandler) Create(ctx context.Context, blockReq *Block
 
 // Get returns a single block storage instance based ony our blockID you provide from your Vultr Account
 func (b *BlockStorageServiceHandler) Get(ctx context.Context, blockID string) (*BlockStorage, *http.Response, error) {
-	uri := fmt.Sprintf("/v2/blocks/%s", blockID)
+	uri := fmt.Sprintf("%s/%s", blockStoragePath, blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -121,7 +152,7 @@ func (b *BlockStorageServiceHandler) Get(ctx context.Context, blockID string) (*
 
 // Update a block storage subscription.
 func (b *BlockStorageServiceHandler) Update(ctx context.Context, blockID string, blockReq *BlockStorageUpdate) error {
-	uri := fmt.Sprintf("/v2/blocks/%s", blockID)
+	uri := fmt.Sprintf("%s/%s", blockStoragePath, blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPatch, uri, blockReq)
 	if err != nil {
@@ -133,7 +164,7 @@ func (b *BlockStorageServiceHandler) Update(ctx context.Context, blockID string,
 
 // Delete a block storage subscription from your Vultr account
 func (b *BlockStorageServiceHandler) Delete(ctx context.Context, blockID string) error {
-	uri := fmt.Sprintf("/v2/blocks/%s", blockID)
+	uri := fmt.Sprintf("%s/%s", blockStoragePath, blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
@@ -145,9 +176,7 @@ func (b *BlockStorageServiceHandler) Delete(ctx context.Context, blockID string)
 
 // List returns a list of all block storage instances on your Vultr Account
 func (b *BlockStorageServiceHandler) List(ctx context.Context, options *ListOptions) ([]BlockStorage, *Meta, *http.Response, error) { //nolint:dupl,lll
-	uri := "/v2/blocks"
-
-	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
+	req, err := b.client.NewRequest(ctx, http.MethodGet, blockStoragePath, nil)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -171,7 +200,7 @@ func (b *BlockStorageServiceHandler) List(ctx context.Context, options *ListOpti
 // Attach will link a given block storage to a given Vultr instance
 // If Live is set to true the block storage will be attached without reloading the instance
 func (b *BlockStorageServiceHandler) Attach(ctx context.Context, blockID string, attach *BlockStorageAttach) error {
-	uri := fmt.Sprintf("/v2/blocks/%s/attach", blockID)
+	uri := fmt.Sprintf("%s/%s/attach", blockStoragePath, blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, attach)
 	if err != nil {
@@ -184,12 +213,108 @@ func (b *BlockStorageServiceHandler) Attach(ctx context.Context, blockID string,
 // Detach will de-link a given block storage to the Vultr instance it is attached to
 // If Live is set to true the block storage will be detached without reloading the instance
 func (b *BlockStorageServiceHandler) Detach(ctx context.Context, blockID string, detach *BlockStorageDetach) error {
-	uri := fmt.Sprintf("/v2/blocks/%s/detach", blockID)
+	uri := fmt.Sprintf("%s/%s/detach", blockStoragePath, blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, detach)
 	if err != nil {
 		return err
 	}
+
 	_, err = b.client.DoWithContext(ctx, req, nil)
 	return err
 }
+
+// ListSnapshots returns a list of all block storage snapshots
+func (b *BlockStorageServiceHandler) ListSnapshots(ctx context.Context, options *ListOptions) ([]BlockStorageSnapshot, *Meta, *http.Response, error) { //nolint:dupl,lll
+	uri := fmt.Sprintf("%s/snapshots", blockStoragePath)
+
+	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	newValues, err := query.Values(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req.URL.RawQuery = newValues.Encode()
+
+	snaps := new(blockStorageSnapshotsBase)
+	resp, err := b.client.DoWithContext(ctx, req, snaps)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return snaps.Snapshots, snaps.Meta, resp, nil
+}
+
+// GetSnapshot returns the specified block storage snapshot
+func (b *BlockStorageServiceHandler) GetSnapshot(ctx context.Context, snapshotID string) (*BlockStorageSnapshot, *http.Response, error) {
+	uri := fmt.Sprintf("%s/snapshots/%s", blockStoragePath, snapshotID)
+
+	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snap := new(BlockStorageSnapshot)
+	resp, err := b.client.DoWithContext(ctx, req, snap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snap, resp, nil
+}
+
+// CreateSnapshot creates a snapshot for the specified block storage
+func (b *BlockStorageServiceHandler) CreateSnapshot(ctx context.Context, snapshotReq *BlockStorageSnapshotReq) (*BlockStorageSnapshot, *http.Response, error) { //nolint:lll
+	uri := fmt.Sprintf("%s/snapshots", blockStoragePath)
+
+	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, snapshotReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snap := new(BlockStorageSnapshot)
+	resp, err := b.client.DoWithContext(ctx, req, snap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snap, resp, nil
+}
+
+// UpdateSnapshot updates a specified block storage snapshot
+func (b *BlockStorageServiceHandler) UpdateSnapshot(ctx context.Context, snapshotID string, snapshotReq *BlockStorageSnapshotReq) error {
+	uri := fmt.Sprintf("%s/snapshots/%s", blockStoragePath, snapshotID)
+
+	req, err := b.client.NewRequest(ctx, http.MethodPut, uri, snapshotReq)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.client.DoWithContext(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSnapshot deletes a specified block storage snapshot
+func (b *BlockStorageServiceHandler) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	uri := fmt.Sprintf("%s/snapshots/%s", blockStoragePath, snapshotID)
+
+	req, err := b.client.NewRequest(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.client.DoWithContext(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
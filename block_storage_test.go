@@ -1,7 +1,6 @@
 package govultr
 
 import (
-	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -11,10 +10,28 @@ func TestBlockStorageServiceHandler_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"block":{"id":"123456","cost":10,"pending_charges":2.5,"status":"active","size_gb":100,"region":"ewr","attached_to_instance":"","attached_to_instance_ip":"","attached_to_instance_label":"","date_created":"01-01-1960","label":"mylabel", "mount_id": "ewr-123abc", "block_type": "test", "os_id": 0, "snapshot_id": "", "bootable": false}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/blocks", testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"block": {
+		"id": "123456",
+		"cost": 10,
+		"pending_charges": 2.5,
+		"status": "active",
+		"size_gb": 100,
+		"region": "ewr",
+		"attached_to_instance": "",
+		"attached_to_instance_ip": "",
+		"attached_to_instance_label": "",
+		"date_created": "2020-10-10T01:56:20+00:00",
+		"label": "mylabel", 
+		"mount_id": "ewr-123abc", 
+		"block_type": "test", 
+		"os_id": 0, 
+		"snapshot_id": "", 
+		"bootable": false
+	}
+}`))
+
 	blockReq := &BlockStorageCreate{
 		Region:    "ewr",
 		SizeGB:    100,
@@ -33,7 +50,7 @@ func TestBlockStorageServiceHandler_Create(t *testing.T) {
 		Status:                  "active",
 		SizeGB:                  100,
 		Region:                  "ewr",
-		DateCreated:             "01-01-1960",
+		DateCreated:             "2020-10-10T01:56:20+00:00",
 		AttachedToInstance:      "",
 		AttachedToInstanceIP:    "",
 		AttachedToInstanceLabel: "",
@@ -54,10 +71,27 @@ func TestBlockStorageServiceHandler_Get(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks/123456", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"block":{"id":"123456","cost":10,"pending_charges":2.5,"status":"active","size_gb":100,"region":"ewr","attached_to_instance":"","attached_to_instance_ip":"","attached_to_instance_label":"","date_created":"01-01-1960","label":"mylabel", "mount_id": "ewr-123abc", "block_type": "test", "os_id": 0, "snapshot_id": "", "bootable": false}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/blocks/123456", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"block": {
+		"id": "123456",
+		"cost": 10,
+		"pending_charges": 2.5,
+		"status": "active",
+		"size_gb": 100,
+		"region": "ewr",
+		"attached_to_instance": "",
+		"attached_to_instance_ip": "",
+		"attached_to_instance_label": "",
+		"date_created": "2020-10-10T01:56:20+00:00",
+		"label": "mylabel",
+		"mount_id": "ewr-123abc",
+		"block_type": "test",
+		"os_id": 0,
+		"snapshot_id": "",
+		"bootable": false
+	}
+}`))
 
 	blockStorage, _, err := client.BlockStorage.Get(ctx, "123456")
 	if err != nil {
@@ -71,7 +105,7 @@ func TestBlockStorageServiceHandler_Get(t *testing.T) {
 		Status:                  "active",
 		SizeGB:                  100,
 		Region:                  "ewr",
-		DateCreated:             "01-01-1960",
+		DateCreated:             "2020-10-10T01:56:20+00:00",
 		AttachedToInstance:      "",
 		AttachedToInstanceIP:    "",
 		AttachedToInstanceLabel: "",
@@ -92,9 +126,7 @@ func TestBlockStorageServiceHandler_Update(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks/123456", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/blocks/123456", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	blockUpdate := &BlockStorageUpdate{
 		Label: "unit-test-label-setter",
@@ -109,9 +141,7 @@ func TestBlockStorageServiceHandler_Delete(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks/123456", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/blocks/123456", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	err := client.BlockStorage.Delete(ctx, "123456")
 	if err != nil {
@@ -123,10 +153,36 @@ func TestBlockStorageServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"blocks":[{"id":"123456","cost":10,"pending_charges":2.5,"status":"active","size_gb":100,"region":"ewr","attached_to_instance":"","attached_to_instance_ip":"","attached_to_instance_label":"","date_created":"01-01-1960","label":"mylabel", "mount_id": "ewr-123abc", "block_type": "test", "os_id": 0, "snapshot_id": "", "bootable": false}],"meta":{"total":1,"links":{"next":"thisismycusror","prev":""}}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/blocks", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"blocks": [
+		{
+			"id": "123456",
+			"cost": 10,
+			"pending_charges": 2.5,
+			"status": "active",
+			"size_gb": 100,
+			"region": "ewr",
+			"attached_to_instance": "",
+			"attached_to_instance_ip": "",
+			"attached_to_instance_label": "",
+			"date_created": "2020-10-10T01:56:20+00:00", 
+			"label": "mylabel", 
+			"mount_id": "ewr-123abc", 
+			"block_type": "test", 
+			"os_id": 0, 
+			"snapshot_id": "", 
+			"bootable": false
+		}
+	],
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "thisismycusror",
+			"prev": ""
+		}
+	}
+}`))
 
 	blockStorage, meta, _, err := client.BlockStorage.List(ctx, nil)
 	if err != nil {
@@ -141,7 +197,7 @@ func TestBlockStorageServiceHandler_List(t *testing.T) {
 			Status:                  "active",
 			SizeGB:                  100,
 			Region:                  "ewr",
-			DateCreated:             "01-01-1960",
+			DateCreated:             "2020-10-10T01:56:20+00:00",
 			AttachedToInstance:      "",
 			AttachedToInstanceIP:    "",
 			AttachedToInstanceLabel: "",
@@ -175,9 +231,7 @@ func TestBlockStorageServiceHandler_Attach(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks/12345/attach", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/blocks/12345/attach", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	attach := &BlockStorageAttach{
 		InstanceID: "1234",
@@ -193,12 +247,205 @@ func TestBlockStorageServiceHandler_Detach(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/blocks/123456/detach", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/blocks/123456/detach", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
+
 	detach := &BlockStorageDetach{Live: BoolToBoolPtr(true)}
 	err := client.BlockStorage.Detach(ctx, "123456", detach)
 	if err != nil {
 		t.Errorf("BlockStorage.Detach returned %+v, expected %+v", err, nil)
 	}
 }
+
+func TestBlockStorageServiceHandler_ListSnapshots(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/blocks/snapshots", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"snapshots": [
+		{
+			"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+			"block_id": "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+			"description": "block storage snapshot",
+			"state": "COMPLETE",
+			"added_at": "2026-07-17 16:46:05",
+			"updated_at": "2026-07-17 16:46:09",
+			"size": 10737418240,
+			"next_invoice_date": "",
+			"next_invoice_price": ""
+		},
+		{
+			"id": "96aef714-ce33-46a7-88e1-2eb1e8e0646e",
+			"description": "another snapshot test",
+			"block_id": "f004aea7-aec2-47fe-9ad7-7ebd830f225e",
+			"state": "COMPLETE",
+			"added_at": "2026-07-17 16:46:05",
+			"updated_at": "2026-07-17 16:46:09",
+			"next_invoice_date": "",
+			"next_invoice_price": "",
+			"size": 107374182400
+		}
+	],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+		}
+	}
+}`))
+
+	snapshots, meta, _, err := client.BlockStorage.ListSnapshots(ctx, nil)
+	if err != nil {
+		t.Errorf("BlockStorage.ListSnapshots returned error: %v", err)
+	}
+
+	expected := []BlockStorageSnapshot{
+		{
+			ID:               "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+			BlockID:          "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+			Description:      "block storage snapshot",
+			State:            "COMPLETE",
+			DateCreated:      "2026-07-17 16:46:05",
+			DateUpdated:      "2026-07-17 16:46:09",
+			InvoiceNextDate:  "",
+			InvoiceNextPrice: "",
+			Size:             10737418240,
+		},
+		{
+			ID:               "96aef714-ce33-46a7-88e1-2eb1e8e0646e",
+			Description:      "another snapshot test",
+			BlockID:          "f004aea7-aec2-47fe-9ad7-7ebd830f225e",
+			State:            "COMPLETE",
+			DateCreated:      "2026-07-17 16:46:05",
+			DateUpdated:      "2026-07-17 16:46:09",
+			InvoiceNextDate:  "",
+			InvoiceNextPrice: "",
+			Size:             107374182400,
+		},
+	}
+
+	if !reflect.DeepEqual(snapshots, expected) {
+		t.Errorf("BlockStorage.ListSnapshots returned %+v, expected %+v", snapshots, expected)
+	}
+
+	expectedMeta := &Meta{
+		Total: 2,
+		Links: &Links{
+			Next: "",
+			Prev: "",
+		},
+	}
+
+	if !reflect.DeepEqual(meta, expectedMeta) {
+		t.Errorf("BlockStorage.ListSnapshots meta returned %+v, expected %+v", meta, expectedMeta)
+	}
+}
+
+func TestBlockStorageServiceHandler_GetSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/blocks/snapshots/cb676a46-66fd-4dfb-b839-443f2e6c0b60", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+	"block_id": "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+	"description": "block storage snapshot",
+	"state": "COMPLETE",
+	"added_at": "2026-07-17 16:46:05",
+	"updated_at": "2026-07-17 16:46:09",
+	"size": 10737418240,
+	"next_invoice_date": "",
+	"next_invoice_price": ""
+}`))
+
+	snapshot, _, err := client.BlockStorage.GetSnapshot(ctx, "cb676a46-66fd-4dfb-b839-443f2e6c0b60")
+	if err != nil {
+		t.Errorf("BlockStorage.GetSnapshot returned error: %v", err)
+	}
+
+	expected := &BlockStorageSnapshot{
+		ID:               "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+		BlockID:          "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+		Description:      "block storage snapshot",
+		State:            "COMPLETE",
+		DateCreated:      "2026-07-17 16:46:05",
+		DateUpdated:      "2026-07-17 16:46:09",
+		InvoiceNextDate:  "",
+		InvoiceNextPrice: "",
+		Size:             10737418240,
+	}
+
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("BlockStorage.GetSnapshot returned %+v, expected %+v", snapshot, expected)
+	}
+}
+
+func TestBlockStorageServiceHandler_CreateSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/blocks/snapshots", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+	"block_id": "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+	"description": "block storage snapshot",
+	"state": "COMPLETE",
+	"added_at": "2026-07-17 16:46:05",
+	"updated_at": "2026-07-17 16:46:09",
+	"size": 10737418240,
+	"next_invoice_date": "",
+	"next_invoice_price": ""
+}`))
+
+	snapshotReq := &BlockStorageSnapshotReq{
+		BlockID:     "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+		Description: "block storage snapshot",
+	}
+	snapshot, _, err := client.BlockStorage.CreateSnapshot(ctx, snapshotReq)
+	if err != nil {
+		t.Errorf("BlockStorage.CreateSnapshot returned error: %v", err)
+	}
+
+	expected := &BlockStorageSnapshot{
+		ID:               "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+		BlockID:          "ay2c5b1a-66fd-4dfb-b839-443f2e6c0b60",
+		Description:      "block storage snapshot",
+		State:            "COMPLETE",
+		DateCreated:      "2026-07-17 16:46:05",
+		DateUpdated:      "2026-07-17 16:46:09",
+		InvoiceNextDate:  "",
+		InvoiceNextPrice: "",
+		Size:             10737418240,
+	}
+
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("BlockStorage.CreateSnapshot returned %+v, expected %+v", snapshot, expected)
+	}
+}
+
+func TestBlockStorageServiceHandler_UpdateSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/blocks/snapshots/cb676a46-66fd-4dfb-b839-443f2e6c0b60", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
+
+	snapshotReq := &BlockStorageSnapshotReq{
+		Description: "block storage snapshot updated",
+	}
+
+	if err := client.BlockStorage.UpdateSnapshot(ctx, "cb676a46-66fd-4dfb-b839-443f2e6c0b60", snapshotReq); err != nil {
+		t.Errorf("BlockStorage.UpdateSnapshot returned %+v, expected %+v", err, nil)
+	}
+}
+
+func TestBlockStorageServiceHandler_DeleteSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/blocks/snapshots/cb676a46-66fd-4dfb-b839-443f2e6c0b60", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
+
+	if err := client.BlockStorage.DeleteSnapshot(ctx, "cb676a46-66fd-4dfb-b839-443f2e6c0b60"); err != nil {
+		t.Errorf("BlockStorage.DeleteSnapshot returned %+v, expected %+v", err, nil)
+	}
+}
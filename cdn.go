@@ -37,27 +37,27 @@ type CDNService interface {
 
 // CDNZone represents the CDN push/pull zone data
 type CDNZone struct {
-	ID            string   `json:"id"`
-	DateCreated   string   `json:"date_created"`
-	Status        string   `json:"status"`
-	Label         string   `json:"label"`
-	OriginScheme  string   `json:"origin_scheme"`
-	OriginDomain  string   `json:"origin_domain"`
-	VanityDomain  string   `json:"vanity_domain"`
-	SSLCert       string   `json:"ssl_cert"`
-	SSLCertKey    string   `json:"ssl_cert_key"`
-	CDNURL        string   `json:"cdn_url"`
-	CacheSize     int      `json:"cache_size"`
-	Requests      int      `json:"requests"`
-	BytesIn       int      `json:"in_bytes"`
-	BytesOut      int      `json:"out_bytes"`
-	PacketsPerSec int      `json:"packets_per_sec"`
-	DatePurged    string   `json:"last_purge"`
-	CORS          bool     `json:"cors"`
-	GZIP          bool     `json:"gzip"`
-	BlockAI       bool     `json:"block_ai"`
-	BlockBadBots  bool     `json:"block_bad_bots"`
-	Regions       []string `json:"regions"`
+	ID            string    `json:"id"`
+	DateCreated   Timestamp `json:"date_created"`
+	Status        string    `json:"status"`
+	Label         string    `json:"label"`
+	OriginScheme  string    `json:"origin_scheme"`
+	OriginDomain  string    `json:"origin_domain"`
+	VanityDomain  string    `json:"vanity_domain"`
+	SSLCert       string    `json:"ssl_cert"`
+	SSLCertKey    string    `json:"ssl_cert_key"`
+	CDNURL        string    `json:"cdn_url"`
+	CacheSize     int       `json:"cache_size"`
+	Requests      int       `json:"requests"`
+	BytesIn       int       `json:"in_bytes"`
+	BytesOut      int       `json:"out_bytes"`
+	PacketsPerSec int       `json:"packets_per_sec"`
+	DatePurged    Timestamp `json:"last_purge"`
+	CORS          bool      `json:"cors"`
+	GZIP          bool      `json:"gzip"`
+	BlockAI       bool      `json:"block_ai"`
+	BlockBadBots  bool      `json:"block_bad_bots"`
+	Regions       []string  `json:"regions"`
 }
 
 // CDNZoneReq is the data used to create a push/pull zone
@@ -84,9 +84,9 @@ type CDNZoneFileData struct {
 
 // CDNZoneFile is the data for a push zone file
 type CDNZoneFile struct {
-	Name         string `json:"name"`
-	Size         int    `json:"size"`
-	DateModified string `json:"last_modified"`
+	Name         string    `json:"name"`
+	Size         int       `json:"size"`
+	DateModified Timestamp `json:"last_modified"`
 }
 
 // CDNZoneEndpointReq is the data used to create a push zone upload endpoint
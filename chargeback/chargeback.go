@@ -0,0 +1,107 @@
+// Package chargeback reports what the account's resources cost per tag.
+//
+// Inventory walks every service whose resources can be tagged: instances,
+// bare metal servers, file system storage, managed databases and Kubernetes
+// node pools. Each resource is priced at the list price of its plan. A report
+// groups the inventory by tag and attributes the month's pending charges to
+// the resources they bill.
+//
+//	report, err := chargeback.Build(ctx, client)
+//	if err != nil {
+//		...
+//	}
+//	report.WriteCSV(os.Stdout)
+//
+// Resources with several tags count towards each of them, so the totals of
+// the tags can add up to more than the account's. Untagged resources are
+// grouped under the empty tag.
+package chargeback
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/billing"
+	"github.com/vultr/govultr/v3/cost"
+)
+
+// Resource is a taggable resource with its cost
+type Resource struct {
+	billing.Resource
+
+	// Monthly cost at list price. Resources on plans without a known price
+	// aren't priced and cost 0.
+	Monthly float64 `json:"monthly"`
+	Priced  bool    `json:"priced"`
+
+	// Pending charges of the month attributed to the resource
+	Pending float64 `json:"pending"`
+}
+
+// Build takes the inventory, reads the pending charges and reports on them
+func Build(ctx context.Context, client *govultr.Client) (*Report, error) {
+	resources, err := Inventory(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	charges, _, err := client.Billing.ListPendingCharges(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReport(resources, charges), nil
+}
+
+// Inventory lists the resources of every taggable service with
+// billing.ListResources, priced at their plans. File systems have no plan
+// and are priced per GB at cost.DefaultPrices.
+func Inventory(ctx context.Context, client *govultr.Client) ([]Resource, error) {
+	listed, err := billing.ListResources(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := cost.NewCatalog(client)
+	lookups := map[string]func(context.Context, string) (float64, error){
+		billing.KindInstance:  catalog.PlanPrice,
+		billing.KindBareMetal: catalog.BareMetalPrice,
+		billing.KindDatabase:  catalog.DatabasePrice,
+		billing.KindNodePool:  catalog.PlanPrice,
+		billing.KindFileSystem: func(context.Context, string) (float64, error) {
+			return cost.DefaultPrices().VirtualFileSystemStorageGB, nil
+		},
+	}
+
+	var resources []Resource
+	for i := range listed {
+		lookup, ok := lookups[listed[i].Kind]
+		if !ok {
+			continue
+		}
+
+		r := Resource{Resource: listed[i]}
+		if err := r.price(ctx, lookup); err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// price sets the monthly cost of the resource from the price of its plan.
+// Unknown plans, such as retired ones, leave it unpriced.
+func (r *Resource) price(ctx context.Context, lookup func(context.Context, string) (float64, error)) error {
+	price, err := lookup(ctx, r.Plan)
+	if errors.Is(err, cost.ErrUnknownPlan) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r.Monthly, r.Priced = price*float64(r.Quantity), true
+	return nil
+}
@@ -0,0 +1,204 @@
+package chargeback
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/billing"
+	"github.com/vultr/govultr/v3/govultrmock"
+)
+
+func testMock() *govultrmock.MockClient {
+	mock := govultrmock.NewMockClient()
+
+	mock.Instance.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *http.Response, error) {
+		return []govultr.Instance{
+			{ID: "inst-1", Label: "web-1", Plan: "vc2-1c-1gb", Tags: []string{"team:web", "env:prod"}},
+			{ID: "inst-2", Label: "web-2", Plan: "vc2-1c-1gb", Tags: []string{"team:web"}},
+			{ID: "inst-3", Label: "legacy", Plan: "retired-plan"},
+		}, nil, nil, nil
+	}
+	mock.BareMetalServer.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *http.Response, error) {
+		return []govultr.BareMetalServer{{ID: "bm-1", Label: "metal", Plan: "vbm-4c-32gb", Tags: []string{"team:data"}}}, nil, nil, nil
+	}
+	mock.Database.ListFunc = func(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *http.Response, error) {
+		return []govultr.Database{{ID: "db-1", Label: "pg", Plan: "vultr-dbaas-startup-cc-1-55-2", Tag: "team:data"}}, nil, nil, nil
+	}
+	mock.VirtualFileSystemStorage.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.VirtualFileSystemStorage, *govultr.Meta, *http.Response, error) {
+		return []govultr.VirtualFileSystemStorage{{
+			ID: "vfs-1", Label: "files", Tags: []string{"team:web"},
+			StorageSize: govultr.VirtualFileSystemStorageSize{SizeGB: 40},
+		}}, nil, nil, nil
+	}
+	mock.BlockStorage.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *http.Response, error) {
+		return []govultr.BlockStorage{{ID: "blk-1", Label: "data", SizeGB: 50}}, nil, nil, nil
+	}
+	mock.LoadBalancer.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *http.Response, error) {
+		return nil, nil, nil, nil
+	}
+	mock.ObjectStorage.ListFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *http.Response, error) {
+		return nil, nil, nil, nil
+	}
+	mock.Kubernetes.ListClustersFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *http.Response, error) {
+		return []govultr.Cluster{{
+			ID: "vke-1", Label: "k8s", Region: "ewr",
+			NodePools: []govultr.NodePool{{ID: "np-1", Label: "workers", Plan: "vc2-2c-4gb", NodeQuantity: 3, Tag: "team:web"}},
+		}}, nil, nil, nil
+	}
+
+	mock.Plan.ListFunc = func(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error) {
+		return []govultr.Plan{{ID: "vc2-1c-1gb", MonthlyCost: 5}, {ID: "vc2-2c-4gb", MonthlyCost: 20}}, nil, nil, nil
+	}
+	mock.Plan.ListBareMetalFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *http.Response, error) {
+		return []govultr.BareMetalPlan{{ID: "vbm-4c-32gb", MonthlyCost: 120}}, nil, nil, nil
+	}
+	mock.Database.ListPlansFunc = func(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *http.Response, error) {
+		return []govultr.DatabasePlan{{ID: "vultr-dbaas-startup-cc-1-55-2", MonthlyCost: 30}}, nil, nil, nil
+	}
+
+	mock.Billing.ListPendingChargesFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error) {
+		return []govultr.InvoiceItem{
+			{Description: "Cloud Compute web-1 (vc2-1c-1gb)", Total: 2.5},
+			{Description: "Managed Database db-1", Total: 10},
+			{Description: "Block Storage data", Total: 1.25},
+		}, nil, nil
+	}
+
+	return mock
+}
+
+func TestInventory(t *testing.T) {
+	mock := testMock()
+
+	resources, err := Inventory(context.Background(), mock.Client)
+	if err != nil {
+		t.Fatalf("Inventory returned %+v", err)
+	}
+
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.Kind+" "+r.ID)
+	}
+	expected := []string{
+		"instance inst-1", "instance inst-2", "instance inst-3", "bare_metal bm-1", "database db-1", "file_system vfs-1", "node_pool np-1",
+	}
+	if !slices.Equal(got, expected) {
+		t.Fatalf("Inventory returned %v, expected %v", got, expected)
+	}
+
+	monthly := map[string]float64{"inst-1": 5, "inst-2": 5, "inst-3": 0, "bm-1": 120, "db-1": 30, "vfs-1": 4, "np-1": 60}
+	for _, r := range resources {
+		if r.Monthly != monthly[r.ID] || r.Priced != (r.ID != "inst-3") {
+			t.Errorf("Inventory returned %+v, expected %.2f a month", r, monthly[r.ID])
+		}
+	}
+
+	if pool := resources[6]; pool.Quantity != 3 || pool.Region != "ewr" || !slices.Equal(pool.Tags, []string{"team:web"}) {
+		t.Errorf("Inventory returned %+v for the node pool", pool)
+	}
+
+	if n := len(mock.Plan.CallsTo("List")); n != 1 {
+		t.Errorf("Inventory listed plans %d times, expected once", n)
+	}
+
+	failing := errors.New("plans failed")
+	mock.Plan.ListFunc = func(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error) {
+		return nil, nil, nil, failing
+	}
+	if _, err := Inventory(context.Background(), mock.Client); !errors.Is(err, failing) {
+		t.Errorf("Inventory returned %+v, expected %+v", err, failing)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	report, err := Build(context.Background(), testMock().Client)
+	if err != nil {
+		t.Fatalf("Build returned %+v", err)
+	}
+
+	if report.Pending != 13.75 || report.Unattributed != 1.25 {
+		t.Errorf("Build returned %.2f pending and %.2f unattributed, expected 13.75 and 1.25", report.Pending, report.Unattributed)
+	}
+
+	expected := []TagCost{
+		{Tag: "team:data", Resources: 2, Monthly: 150, Pending: 10},
+		{Tag: "team:web", Resources: 4, Monthly: 74, Pending: 2.5},
+		{Tag: "env:prod", Resources: 1, Monthly: 5, Pending: 2.5},
+		{Tag: "", Resources: 1, Unpriced: 1},
+	}
+	if !slices.Equal(report.Tags, expected) {
+		t.Errorf("Build returned %+v, expected %+v", report.Tags, expected)
+	}
+
+	mock := testMock()
+	failing := errors.New("billing failed")
+	mock.Billing.ListPendingChargesFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error) {
+		return nil, nil, failing
+	}
+	if _, err := Build(context.Background(), mock.Client); !errors.Is(err, failing) {
+		t.Errorf("Build returned %+v, expected %+v", err, failing)
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	resources := []Resource{
+		{Resource: billing.Resource{Kind: billing.KindInstance, ID: "inst-1", Label: "web-1"}, Monthly: 5, Priced: true},
+	}
+	report := NewReport(resources, []govultr.InvoiceItem{{Description: "Cloud Compute web-1", Total: 0.1}, {Description: "web-1", Total: 0.2}})
+
+	if report.Resources[0].Pending != 0.3 || resources[0].Pending != 0 {
+		t.Errorf("NewReport returned %+v, expected the charges on a copy of the resource", report.Resources[0])
+	}
+	if len(report.Tags) != 1 || report.Tags[0].Tag != "" || report.Tags[0].Pending != 0.3 {
+		t.Errorf("NewReport returned %+v, expected the untagged group", report.Tags)
+	}
+
+	report = NewReport(resources, []govultr.InvoiceItem{{Description: "Cloud Compute web-1", Total: 0.015}})
+	if report.Pending != 0.02 || report.Resources[0].Pending != 0.02 {
+		t.Errorf("NewReport returned %.3f pending, expected 0.015 to round to 0.02 without the float32 error", report.Pending)
+	}
+}
+
+func TestReport_Write(t *testing.T) {
+	resources := []Resource{
+		{Resource: billing.Resource{Kind: billing.KindInstance, ID: "inst-1", Label: "web-1", Tags: []string{"team:web"}}, Monthly: 5, Priced: true},
+		{Resource: billing.Resource{Kind: billing.KindDatabase, ID: "db-1", Label: "pg", Tags: []string{"team:data"}}, Monthly: 30, Priced: true},
+		{Resource: billing.Resource{Kind: billing.KindInstance, ID: "inst-3", Label: "legacy"}},
+	}
+	report := NewReport(resources, []govultr.InvoiceItem{{Description: "Cloud Compute web-1", Total: 2.5}})
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON returned %+v", err)
+	}
+	decoded := &Report{}
+	if err := json.Unmarshal(buf.Bytes(), decoded); err != nil || len(decoded.Tags) != 3 || decoded.Resources[0].ID != "inst-1" {
+		t.Errorf("WriteJSON wrote %s, %+v", buf.String(), err)
+	}
+
+	buf.Reset()
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV returned %+v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]string{
+		csvHeader,
+		{"team:data", "1", "0", "30.00", "0.00"},
+		{"team:web", "1", "0", "5.00", "2.50"},
+		{"", "1", "1", "0.00", "0.00"},
+	}
+	if !slices.EqualFunc(rows, expected, slices.Equal) {
+		t.Errorf("WriteCSV wrote %v, expected %v", rows, expected)
+	}
+}
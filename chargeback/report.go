@@ -0,0 +1,155 @@
+package chargeback
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/billing"
+)
+
+// cents rounds amounts to the cent
+const cents = 100
+
+// Report is the cost of the inventory per tag
+type Report struct {
+	// Costs of each tag, highest monthly cost first
+	Tags []TagCost `json:"tags"`
+
+	Resources []Resource `json:"resources"`
+
+	// Pending charges of the month, and the part of them that couldn't be
+	// attributed to a resource of the inventory, e.g. for block storage or
+	// bandwidth
+	Pending      float64 `json:"pending"`
+	Unattributed float64 `json:"unattributed"`
+}
+
+// TagCost is the cost of the resources with a tag
+type TagCost struct {
+	Tag       string `json:"tag"`
+	Resources int    `json:"resources"`
+
+	// Resources whose plan has no known price
+	Unpriced int `json:"unpriced"`
+
+	// Monthly cost at list price and pending charges of the month
+	Monthly float64 `json:"monthly"`
+	Pending float64 `json:"pending"`
+}
+
+// NewReport attributes the pending charges to the resources with
+// billing.Match and groups the resources by tag
+func NewReport(resources []Resource, charges []govultr.InvoiceItem) *Report {
+	r := &Report{Resources: slices.Clone(resources)}
+
+	candidates := make([]billing.Resource, len(r.Resources))
+	index := map[*billing.Resource]int{}
+	for i := range r.Resources {
+		candidates[i] = r.Resources[i].Resource
+		index[&candidates[i]] = i
+	}
+
+	for i := range charges {
+		total := billing.Amount(charges[i].Total)
+		r.Pending += total
+
+		match := billing.Match(charges[i].Description, candidates)
+		if match == nil {
+			r.Unattributed += total
+			continue
+		}
+
+		r.Resources[index[match]].Pending += total
+	}
+	r.Pending, r.Unattributed = round(r.Pending), round(r.Unattributed)
+
+	for i := range r.Resources {
+		r.Resources[i].Pending = round(r.Resources[i].Pending)
+	}
+	r.Tags = groupByTag(r.Resources)
+
+	return r
+}
+
+// groupByTag totals the resources of each tag, highest monthly cost first
+func groupByTag(resources []Resource) []TagCost {
+	tags := map[string]*TagCost{}
+	for i := range resources {
+		res := &resources[i]
+
+		keys := res.Tags
+		if len(keys) == 0 {
+			keys = []string{""}
+		}
+		for _, key := range keys {
+			t := tags[key]
+			if t == nil {
+				t = &TagCost{Tag: key}
+				tags[key] = t
+			}
+
+			t.Resources++
+			if !res.Priced {
+				t.Unpriced++
+			}
+			t.Monthly += res.Monthly
+			t.Pending += res.Pending
+		}
+	}
+
+	result := make([]TagCost, 0, len(tags))
+	for _, t := range tags {
+		t.Monthly, t.Pending = round(t.Monthly), round(t.Pending)
+		result = append(result, *t)
+	}
+	slices.SortFunc(result, func(a, b TagCost) int {
+		if a.Monthly != b.Monthly {
+			if a.Monthly > b.Monthly {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+
+	return result
+}
+
+func round(f float64) float64 {
+	return math.Round(f*cents) / cents
+}
+
+// WriteJSON writes the report to w as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+// csvHeader are the columns of WriteCSV
+var csvHeader = []string{"tag", "resources", "unpriced", "monthly", "pending"}
+
+// WriteCSV writes the costs of each tag to w as CSV
+func (r *Report) WriteCSV(w io.Writer) error {
+	rows := [][]string{csvHeader}
+	for _, t := range r.Tags {
+		rows = append(rows, []string{
+			t.Tag, strconv.Itoa(t.Resources), strconv.Itoa(t.Unpriced),
+			strconv.FormatFloat(t.Monthly, 'f', 2, 64), strconv.FormatFloat(t.Pending, 'f', 2, 64),
+		})
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/vultr/govultr/v3"
+)
+
+var (
+	contextType     = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+	responseType    = reflect.TypeOf((*http.Response)(nil))
+	metaType        = reflect.TypeOf((*govultr.Meta)(nil))
+	listOptionsType = reflect.TypeOf((*govultr.ListOptions)(nil))
+
+	// Args that can be part of a service or command name
+	word = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+)
+
+// aliases are shorter names of services, keyed by their normalized form
+var aliases = map[string]string{
+	"bm":        "baremetalserver",
+	"db":        "database",
+	"dns":       "domain",
+	"dnsrecord": "domainrecord",
+	"firewall":  "firewallgroup",
+	"k8s":       "kubernetes",
+	"lb":        "loadbalancer",
+	"vke":       "kubernetes",
+}
+
+// service is a service field of the Client
+type service struct {
+	name  string
+	iface reflect.Type
+	value reflect.Value
+}
+
+// command is a method of a service
+type command struct {
+	service *service
+	name    string
+	method  string
+	fn      reflect.Value
+	params  []string
+}
+
+// services returns the service fields of client in declaration order
+func services(client *govultr.Client) []*service {
+	v := reflect.ValueOf(client).Elem()
+
+	var result []*service
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Interface || !strings.HasSuffix(field.Type.Name(), "Service") {
+			continue
+		}
+
+		result = append(result, &service{name: kebab(field.Name), iface: field.Type, value: v.Field(i)})
+	}
+
+	return result
+}
+
+// commands returns the methods of the service in alphabetical order. Methods
+// that return iterators or channels, such as Logs.Stream, can't be printed
+// and are left out.
+func (s *service) commands() []*command {
+	result := make([]*command, 0, s.iface.NumMethod())
+	for i := range s.iface.NumMethod() {
+		method := s.iface.Method(i)
+		if !printable(method.Type) {
+			continue
+		}
+
+		result = append(result, &command{
+			service: s,
+			name:    kebab(method.Name),
+			method:  method.Name,
+			fn:      s.value.MethodByName(method.Name),
+			params:  params[s.iface.Name()+"."+method.Name],
+		})
+	}
+
+	return result
+}
+
+// printable reports whether none of the results of a method are functions
+// or channels
+func printable(fn reflect.Type) bool {
+	for i := range fn.NumOut() {
+		if kind := fn.Out(i).Kind(); kind == reflect.Func || kind == reflect.Chan {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolve finds the command named by the first words of args and returns it
+// with the remaining args. The service is named by one or two words, e.g.
+// "domain-record" or "dns record", and the command by one or two words in
+// either order, e.g. "create-user" or "user create". A service on its own
+// returns no command.
+func resolve(all []*service, args []string) (*service, *command, []string, error) {
+	svc, args := findService(all, args)
+	if svc == nil {
+		return nil, nil, nil, fmt.Errorf("unknown service %q", args[0])
+	}
+
+	if len(args) == 0 {
+		return svc, nil, nil, nil
+	}
+
+	commands := svc.commands()
+	find := func(name string) *command {
+		for _, cmd := range commands {
+			if normalize(cmd.method) == name {
+				return cmd
+			}
+		}
+		return nil
+	}
+
+	if len(args) > 1 && isWord(args[1]) {
+		for _, name := range []string{normalize(args[0] + args[1]), normalize(args[1] + args[0])} {
+			if cmd := find(name); cmd != nil {
+				return svc, cmd, args[2:], nil
+			}
+		}
+	}
+
+	for _, name := range []string{normalize(args[0]), "get" + normalize(args[0])} {
+		if cmd := find(name); cmd != nil {
+			return svc, cmd, args[1:], nil
+		}
+	}
+
+	return svc, nil, nil, fmt.Errorf("unknown %s command %q", svc.name, args[0])
+}
+
+// findService returns the service named by the first one or two args and
+// the remaining args
+func findService(all []*service, args []string) (*service, []string) {
+	find := func(name string) *service {
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		for _, svc := range all {
+			if normalize(svc.name) == name {
+				return svc
+			}
+		}
+		return nil
+	}
+
+	if len(args) > 1 && isWord(args[1]) {
+		if svc := find(normalize(args[0] + args[1])); svc != nil {
+			return svc, args[2:]
+		}
+	}
+
+	if svc := find(normalize(args[0])); svc != nil {
+		return svc, args[1:]
+	}
+
+	return nil, args
+}
+
+// result is the outcome of a command
+type result struct {
+	value any
+
+	// Cursor of the next page when only one page was read
+	next string
+}
+
+// call runs the command with args. All pages of list commands are read when
+// all is set.
+func (c *command) call(ctx context.Context, args []string, opts *govultr.ListOptions, all bool, stdin io.Reader) (*result, error) {
+	in, err := c.args(ctx, args, opts, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	values, meta, err := c.invoke(in)
+	if err != nil {
+		return nil, err
+	}
+
+	for all && hasNext(meta) && len(values) == 1 && values[0].Kind() == reflect.Slice {
+		opts.Cursor = meta.Links.Next
+
+		var page []reflect.Value
+		if page, meta, err = c.invoke(in); err != nil {
+			return nil, err
+		}
+		values[0] = reflect.AppendSlice(values[0], page[0])
+	}
+
+	r := &result{}
+	if hasNext(meta) {
+		r.next = meta.Links.Next
+	}
+
+	switch len(values) {
+	case 0:
+	case 1:
+		r.value = values[0].Interface()
+	default:
+		list := make([]any, len(values))
+		for i, v := range values {
+			list[i] = v.Interface()
+		}
+		r.value = list
+	}
+
+	return r, nil
+}
+
+func hasNext(meta *govultr.Meta) bool {
+	return meta != nil && meta.Links != nil && meta.Links.Next != ""
+}
+
+// invoke calls the method and splits its results into values, the meta and
+// the error
+func (c *command) invoke(in []reflect.Value) ([]reflect.Value, *govultr.Meta, error) {
+	var values []reflect.Value
+	var meta *govultr.Meta
+	for _, out := range c.fn.Call(in) {
+		switch out.Type() {
+		case errorType:
+			if !out.IsNil() {
+				return nil, nil, out.Interface().(error)
+			}
+		case responseType:
+		case metaType:
+			meta = out.Interface().(*govultr.Meta)
+		default:
+			values = append(values, out)
+		}
+	}
+
+	return values, meta, nil
+}
+
+// args converts the command line args to the method's arguments. The list
+// options come from the flags, not the args.
+func (c *command) args(ctx context.Context, args []string, opts *govultr.ListOptions, stdin io.Reader) ([]reflect.Value, error) {
+	ft := c.fn.Type()
+	in := make([]reflect.Value, 0, ft.NumIn())
+
+	for i := range ft.NumIn() {
+		t := ft.In(i)
+		switch {
+		case t == contextType:
+			in = append(in, reflect.ValueOf(ctx))
+			continue
+		case t == listOptionsType:
+			in = append(in, reflect.ValueOf(opts))
+			continue
+		}
+
+		name := c.param(len(in) - 1)
+		if len(args) == 0 {
+			if c.optional(name, t) {
+				in = append(in, reflect.Zero(t))
+				continue
+			}
+			return nil, fmt.Errorf("missing <%s>, usage: %s", name, c.usage())
+		}
+
+		v, err := parseArg(t, args[0], stdin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid <%s>: %w", name, err)
+		}
+		in = append(in, v)
+		args = args[1:]
+	}
+
+	if len(args) > 0 {
+		return nil, fmt.Errorf("too many arguments, usage: %s", c.usage())
+	}
+
+	return in, nil
+}
+
+// param returns the name of the i-th parameter after the context
+func (c *command) param(i int) string {
+	if i < len(c.params) {
+		return c.params[i]
+	}
+
+	return fmt.Sprintf("arg%d", i)
+}
+
+// optional reports whether a parameter may be left out, which is the case
+// for options passed as pointers
+func (c *command) optional(name string, t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer && strings.HasPrefix(strings.ToLower(name), "option")
+}
+
+// usage describes the arguments of the command, e.g.
+// "instance get <instanceID>"
+func (c *command) usage() string {
+	parts := []string{c.service.name, c.name}
+
+	ft := c.fn.Type()
+	for i, n := 0, 0; i < ft.NumIn(); i++ {
+		t := ft.In(i)
+		switch {
+		case t == contextType:
+			continue
+		case t == listOptionsType:
+			parts = append(parts, "[--all | --cursor C] [--per-page N]")
+		case c.optional(c.param(n), t):
+			parts = append(parts, "[<"+c.param(n)+">]")
+		default:
+			parts = append(parts, "<"+c.param(n)+">")
+		}
+		n++
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parseArg converts a command line arg to t. Strings, numbers and booleans
+// are taken as they are. Anything else is JSON, read from a file when the
+// arg is @file and from stdin when it's -.
+func parseArg(t reflect.Type, arg string, stdin io.Reader) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.String:
+		v.SetString(arg)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(arg, 10, t.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(arg, t.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	default:
+		data, err := readArg(arg, stdin)
+		if err != nil {
+			return v, err
+		}
+		if err := json.Unmarshal(data, v.Addr().Interface()); err != nil {
+			return v, err
+		}
+	}
+
+	return v, nil
+}
+
+func readArg(arg string, stdin io.Reader) ([]byte, error) {
+	switch {
+	case arg == "-":
+		if stdin == nil {
+			return nil, errors.New("no stdin")
+		}
+		return io.ReadAll(stdin)
+	case strings.HasPrefix(arg, "@"):
+		return os.ReadFile(arg[1:])
+	default:
+		return []byte(arg), nil
+	}
+}
+
+// kebab converts a Go name to a command name, e.g. "BareMetalServer" to
+// "bare-metal-server" and "SSHKey" to "ssh-key"
+func kebab(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('-')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// isWord reports whether an arg can be part of a service or command name
+func isWord(arg string) bool {
+	return word.MatchString(arg)
+}
+
+// normalize lower cases a name and drops its separators so "ssh-key",
+// "ssh_key" and "SSHKey" match
+func normalize(name string) string {
+	name = strings.ToLower(name)
+	return strings.NewReplacer("-", "", "_", "").Replace(name)
+}
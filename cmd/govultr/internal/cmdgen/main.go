@@ -0,0 +1,134 @@
+// Command cmdgen generates the parameter names of the govultr service methods
+// the govultr command shows in its help. Run it with go generate in the
+// cmd/govultr directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+func main() {
+	src := flag.String("src", "../..", "directory of the govultr package")
+	out := flag.String("out", "params_gen.go", "file the parameter names are written to")
+	flag.Parse()
+
+	code, err := generate(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, code, 0o600); err != nil { //nolint:mnd
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the parameter names of the
+// service interfaces of the govultr package in dir
+func generate(dir string) ([]byte, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string][]string{}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		addServices(f, params)
+	}
+
+	if len(params) == 0 {
+		return nil, fmt.Errorf("no service interfaces in %s", dir)
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by cmdgen from the govultr service interfaces. DO NOT EDIT.\n\n")
+	out.WriteString("package main\n\n")
+	out.WriteString("// params holds the parameter names of the service methods, without the context\n")
+	out.WriteString("var params = map[string][]string{\n")
+	for _, key := range keys {
+		quoted := make([]string, len(params[key]))
+		for i, name := range params[key] {
+			quoted[i] = fmt.Sprintf("%q", name)
+		}
+		fmt.Fprintf(&out, "\t%q: {%s},\n", key, strings.Join(quoted, ", "))
+	}
+	out.WriteString("}\n")
+
+	return format.Source(out.Bytes())
+}
+
+// addServices adds the parameter names of the methods of the interfaces in f
+// whose names end in Service, keyed by "Interface.Method"
+func addServices(f *ast.File, params map[string][]string) {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			iface, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(typeSpec.Name.Name, "Service") {
+				continue
+			}
+
+			for _, method := range iface.Methods.List {
+				fn, ok := method.Type.(*ast.FuncType)
+				if !ok || len(method.Names) == 0 {
+					continue
+				}
+
+				params[typeSpec.Name.Name+"."+method.Names[0].Name] = paramNames(fn)
+			}
+		}
+	}
+}
+
+// paramNames returns the names of the parameters of fn after the context,
+// numbering the unnamed ones
+func paramNames(fn *ast.FuncType) []string {
+	var names []string
+	for _, field := range fn.Params.List {
+		if len(field.Names) == 0 {
+			names = append(names, fmt.Sprintf("arg%d", len(names)))
+			continue
+		}
+
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+
+	if len(names) > 0 {
+		names = names[1:]
+	}
+
+	return names
+}
@@ -0,0 +1,203 @@
+// Command govultr calls the Vultr API from the command line. Every method of
+// the services of a govultr.Client is a command, named after the service and
+// the method:
+//
+//	govultr instance list -o table
+//	govultr instance get cb676a46-66fd-4dfb-b839-443f2e6c0b60
+//	govultr instance create '{"region":"ewr","plan":"vc2-1c-1gb","os_id":2284}'
+//	govultr dns record list example.com --all
+//	govultr k8s kubeconfig 455dcd32-e621-48ee-a10e-0cb5f0c0fb1c
+//	govultr db user create 9b4e4bc7-fe94-4ab9-a1ac-95e2c16e4d5c @user.json
+//
+// Arguments that aren't strings, numbers or booleans, such as request
+// bodies, are JSON. They are read from a file when written as @file and from
+// stdin when written as -. Run govultr with a service and no command to list
+// its commands.
+//
+// The API key is read from VULTR_API_KEY and VULTR_API_URL overrides the base
+// URL, the same way govultr.NewClientFromEnv does it; the --base-url flag
+// takes precedence over it. Requests are retried, paginated and authenticated
+// the same way the library does it.
+package main
+
+//go:generate go run ./internal/cmdgen -src ../.. -out params_gen.go
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const userAgent = "govultr-cli"
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Getenv, os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// config holds the flags
+type config struct {
+	output  string
+	baseURL string
+	retries int
+	all     bool
+	list    govultr.ListOptions
+}
+
+func (c *config) flags(fs *flag.FlagSet) {
+	fs.StringVar(&c.output, "o", formatJSON, "output format: json, yaml or table")
+	fs.StringVar(&c.output, "output", formatJSON, "output format: json, yaml or table")
+	fs.StringVar(&c.baseURL, "base-url", "", "base URL of the API")
+	fs.IntVar(&c.retries, "retries", -1, "how many times failed requests are retried, the library default if negative")
+	fs.BoolVar(&c.all, "all", false, "read every page of list commands")
+	fs.IntVar(&c.list.PerPage, "per-page", 0, "items per page of list commands")
+	fs.StringVar(&c.list.Cursor, "cursor", "", "cursor of the page to read")
+	fs.StringVar(&c.list.Label, "label", "", "only list items with this label")
+	fs.StringVar(&c.list.Tag, "tag", "", "only list items with this tag")
+	fs.StringVar(&c.list.Region, "region", "", "only list items in this region")
+}
+
+// run runs the command line args and returns the exit code
+func run(ctx context.Context, args []string, getenv func(string) string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cfg := &config{}
+	fs := flag.NewFlagSet("govultr", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	cfg.flags(fs)
+	fs.Usage = func() { usage(stderr, fs, nil) }
+
+	args, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	} else if err != nil {
+		return 2 //nolint:mnd
+	}
+
+	client, err := newClient(cfg, getenv)
+	if err != nil {
+		fmt.Fprintf(stderr, "govultr: %s\n", err)
+		return 1
+	}
+
+	all := services(client)
+	if len(args) == 0 {
+		usage(stderr, fs, all)
+		return 2 //nolint:mnd
+	}
+
+	svc, cmd, args, err := resolve(all, args)
+	if err != nil {
+		fmt.Fprintf(stderr, "govultr: %s\n", err)
+		return 2 //nolint:mnd
+	}
+	if cmd == nil {
+		commandUsage(stderr, svc)
+		return 2 //nolint:mnd
+	}
+
+	res, err := cmd.call(ctx, args, &cfg.list, cfg.all, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "govultr: %s\n", err)
+		return 1
+	}
+
+	if err := write(stdout, cfg.output, res.value); err != nil {
+		fmt.Fprintf(stderr, "govultr: %s\n", err)
+		return 1
+	}
+
+	if res.next != "" {
+		fmt.Fprintf(stderr, "more results, rerun with --cursor %s or --all\n", res.next)
+	}
+
+	return 0
+}
+
+// parseArgs parses the flags wherever they are and returns the other args.
+// Everything after -- is an arg.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var rest []string
+	for i, arg := range args {
+		if arg == "--" {
+			rest = args[i+1:]
+			args = args[:i]
+			break
+		}
+	}
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	return append(positional, rest...), nil
+}
+
+// newClient returns a client configured by the flags and environment
+func newClient(cfg *config, getenv func(string) string) (*govultr.Client, error) {
+	opts := []govultr.ClientOption{govultr.WithUserAgentSuffix(userAgent)}
+
+	if key := getenv(govultr.EnvAPIKey); key != "" {
+		opts = append(opts, govultr.WithAPIKey(key))
+	}
+	if baseURL := getenv(govultr.EnvAPIURL); baseURL != "" {
+		opts = append(opts, govultr.WithBaseURL(baseURL))
+	}
+	if cfg.baseURL != "" {
+		opts = append(opts, govultr.WithBaseURL(cfg.baseURL))
+	}
+	if cfg.retries >= 0 {
+		opts = append(opts, govultr.WithRetryLimit(cfg.retries))
+	}
+
+	return govultr.NewClientWithOptions(opts...)
+}
+
+func usage(w io.Writer, fs *flag.FlagSet, all []*service) {
+	fmt.Fprintln(w, "usage: govultr [flags] <service> <command> [args...]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "The API key is read from VULTR_API_KEY and VULTR_API_URL overrides the base URL.")
+	fmt.Fprintln(w, "Run govultr <service> to list its commands.")
+
+	if all != nil {
+		names := make([]string, len(all))
+		for i, svc := range all {
+			names[i] = svc.name
+		}
+
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "services:")
+		fmt.Fprintln(w, "  "+strings.Join(names, ", "))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "flags:")
+	fs.PrintDefaults()
+}
+
+func commandUsage(w io.Writer, svc *service) {
+	fmt.Fprintf(w, "usage: govultr %s <command> [args...]\n", svc.name)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range svc.commands() {
+		fmt.Fprintln(w, "  govultr "+cmd.usage())
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Arguments that aren't strings, numbers or booleans are JSON, @file or - for stdin.")
+}
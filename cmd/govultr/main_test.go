@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/govultrtest"
+)
+
+const apiKey = "test-key"
+
+// runCLI runs the command against srv and returns its exit code and output
+func runCLI(t *testing.T, srv *govultrtest.Server, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	getenv := func(name string) string {
+		if name == "VULTR_API_KEY" {
+			return apiKey
+		}
+		return ""
+	}
+
+	args = append([]string{"--base-url", srv.URL(), "--retries", "0"}, args...)
+	code := run(context.Background(), args, getenv, strings.NewReader(stdin), &stdout, &stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Instance(t *testing.T) {
+	srv := govultrtest.NewServer(govultrtest.WithAPIKey(apiKey))
+	defer srv.Close()
+
+	code, out, errOut := runCLI(t, srv, "", "instance", "create", `{"region":"ewr","plan":"vc2-1c-1gb","os_id":2284,"label":"web"}`)
+	if code != 0 {
+		t.Fatalf("instance create exited with %d: %s", code, errOut)
+	}
+
+	created := &govultr.Instance{}
+	if err := json.Unmarshal([]byte(out), created); err != nil || created.Label != "web" {
+		t.Fatalf("instance create printed %s, %+v", out, err)
+	}
+
+	code, out, errOut = runCLI(t, srv, "", "instance", "get", created.ID, "-o", "yaml")
+	if code != 0 {
+		t.Fatalf("instance get exited with %d: %s", code, errOut)
+	}
+	if !strings.Contains(out, "\nlabel: web\n") || !strings.Contains(out, "id: "+created.ID+"\n") {
+		t.Errorf("instance get printed %s, expected YAML", out)
+	}
+
+	code, out, errOut = runCLI(t, srv, "", "-o", "table", "instance", "list")
+	if code != 0 {
+		t.Fatalf("instance list exited with %d: %s", code, errOut)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "ID ") || !strings.HasPrefix(lines[1], created.ID) {
+		t.Errorf("instance list printed %s, expected a table", out)
+	}
+
+	code, out, errOut = runCLI(t, srv, "", "instance", "delete", created.ID)
+	if code != 0 || out != "" {
+		t.Errorf("instance delete exited with %d, %q: %s", code, out, errOut)
+	}
+}
+
+func TestRun_Pagination(t *testing.T) {
+	srv := govultrtest.NewServer(govultrtest.WithAPIKey(apiKey))
+	defer srv.Close()
+
+	for _, label := range []string{"a", "b", "c"} {
+		if code, _, errOut := runCLI(t, srv, "", "ssh-key", "create", `{"name":"`+label+`","ssh_key":"ssh-ed25519 AAAA"}`); code != 0 {
+			t.Fatalf("ssh-key create exited with %d: %s", code, errOut)
+		}
+	}
+
+	code, out, errOut := runCLI(t, srv, "", "ssh-key", "list", "--per-page", "2")
+	var keys []govultr.SSHKey
+	if code != 0 || json.Unmarshal([]byte(out), &keys) != nil || len(keys) != 2 {
+		t.Fatalf("ssh-key list exited with %d and printed %s", code, out)
+	}
+	if !strings.Contains(errOut, "--cursor") {
+		t.Errorf("ssh-key list printed %q, expected a hint about the next page", errOut)
+	}
+
+	code, out, errOut = runCLI(t, srv, "", "ssh-key", "list", "--per-page", "2", "--all")
+	if code != 0 || json.Unmarshal([]byte(out), &keys) != nil || len(keys) != 3 || errOut != "" {
+		t.Errorf("ssh-key list --all exited with %d and printed %s, %s", code, out, errOut)
+	}
+}
+
+func TestRun_Aliases(t *testing.T) {
+	srv := govultrtest.NewServer(govultrtest.WithAPIKey(apiKey))
+	defer srv.Close()
+
+	if code, _, errOut := runCLI(t, srv, "", "domain", "create", `{"domain":"example.com"}`); code != 0 {
+		t.Fatalf("domain create exited with %d: %s", code, errOut)
+	}
+
+	file := filepath.Join(t.TempDir(), "record.json")
+	if err := os.WriteFile(file, []byte(`{"type":"A","name":"www","data":"192.0.2.1"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if code, _, errOut := runCLI(t, srv, "", "dns", "record", "create", "example.com", "@"+file); code != 0 {
+		t.Fatalf("dns record create exited with %d: %s", code, errOut)
+	}
+
+	code, out, errOut := runCLI(t, srv, "", "dns", "record", "list", "example.com", "-o", "table")
+	if code != 0 || !strings.Contains(out, "192.0.2.1") || !strings.Contains(out, "ns1.vultr.com") {
+		t.Errorf("dns record list exited with %d and printed %s, %s", code, out, errOut)
+	}
+
+	code, out, errOut = runCLI(t, srv, `{"name":"staging","ssh_key":"ssh-ed25519 AAAA"}`, "ssh", "key", "create", "-")
+	if code != 0 || !strings.Contains(out, `"name": "staging"`) {
+		t.Errorf("ssh key create exited with %d and printed %s, %s", code, out, errOut)
+	}
+}
+
+func TestRun_Environment(t *testing.T) {
+	srv := govultrtest.NewServer(govultrtest.WithAPIKey(apiKey))
+	defer srv.Close()
+
+	env := map[string]string{"VULTR_API_KEY": apiKey, "VULTR_API_URL": srv.URL()}
+	getenv := func(name string) string { return env[name] }
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"--retries", "0", "instance", "list"}, getenv, nil, &stdout, &stderr); code != 0 {
+		t.Errorf("instance list exited with %d: %s, expected it to use VULTR_API_URL", code, stderr.String())
+	}
+
+	env["VULTR_API_URL"] = "http://127.0.0.1:1"
+	stderr.Reset()
+	args := []string{"--base-url", srv.URL(), "--retries", "0", "instance", "list"}
+	if code := run(context.Background(), args, getenv, nil, &stdout, &stderr); code != 0 {
+		t.Errorf("instance list exited with %d: %s, expected --base-url to take precedence", code, stderr.String())
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	srv := govultrtest.NewServer(govultrtest.WithAPIKey(apiKey))
+	defer srv.Close()
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+		err  string
+	}{
+		{name: "no args", code: 2, err: "usage: govultr"},
+		{name: "service", args: []string{"instance"}, code: 2, err: "govultr instance get <instanceID>"},
+		{name: "unknown service", args: []string{"servers", "list"}, code: 2, err: `unknown service "servers"`},
+		{name: "unknown command", args: []string{"instance", "launch"}, code: 2, err: `unknown instance command "launch"`},
+		{name: "missing arg", args: []string{"instance", "get"}, code: 1, err: "missing <instanceID>"},
+		{name: "too many args", args: []string{"account", "get", "x"}, code: 1, err: "too many arguments"},
+		{name: "invalid JSON", args: []string{"instance", "create", "{"}, code: 1, err: "invalid <instanceReq>"},
+		{name: "not found", args: []string{"instance", "get", "missing"}, code: 1, err: "Instance not found"},
+		{name: "output", args: []string{"instance", "list", "-o", "xml"}, code: 1, err: `unknown output format "xml"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, errOut := runCLI(t, srv, "", tt.args...)
+			if code != tt.code || !strings.Contains(errOut, tt.err) {
+				t.Errorf("run exited with %d: %s, expected %d: %s", code, errOut, tt.code, tt.err)
+			}
+		})
+	}
+}
+
+func TestKebab(t *testing.T) {
+	tests := map[string]string{
+		"Instance":                 "instance",
+		"BareMetalServer":          "bare-metal-server",
+		"SSHKey":                   "ssh-key",
+		"VPC2":                     "vpc2",
+		"GetKubeConfig":            "get-kube-config",
+		"ListVPCInfo":              "list-vpc-info",
+		"VirtualFileSystemStorage": "virtual-file-system-storage",
+	}
+
+	for name, expected := range tests {
+		if got := kebab(name); got != expected {
+			t.Errorf("kebab(%q) returned %q, expected %q", name, got, expected)
+		}
+	}
+}
+
+func TestYAMLScalar(t *testing.T) {
+	tests := map[string]string{
+		"web":         "web",
+		"":            `""`,
+		"true":        `"true"`,
+		"2284":        `"2284"`,
+		"a: b":        `"a: b"`,
+		"#comment":    `"#comment"`,
+		"192.0.2.1":   `"192.0.2.1"`,
+		"vc2-1c-1gb":  "vc2-1c-1gb",
+		"line\nbreak": `"line\nbreak"`,
+	}
+
+	for s, expected := range tests {
+		if got := yamlScalar(s); got != expected {
+			t.Errorf("yamlScalar(%q) returned %s, expected %s", s, got, expected)
+		}
+	}
+}
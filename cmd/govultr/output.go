@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats
+const (
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+	formatTable = "table"
+)
+
+// object is a JSON object with its keys in their original order
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+// write writes v to w in format
+func write(w io.Writer, format string, v any) error {
+	if v == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case formatJSON:
+		var out bytes.Buffer
+		if err := json.Indent(&out, data, "", "  "); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+		_, err = w.Write(out.Bytes())
+		return err
+	case formatYAML, formatTable:
+	default:
+		return fmt.Errorf("unknown output format %q, use json, yaml or table", format)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	value, err := decode(dec)
+	if err != nil {
+		return err
+	}
+
+	if format == formatYAML {
+		_, err = io.WriteString(w, strings.Join(yamlLines(value), "\n")+"\n")
+		return err
+	}
+
+	return writeTable(w, value)
+}
+
+// decode reads the next JSON value, keeping the order of object keys
+func decode(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := &object{values: map[string]any{}}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decode(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.keys = append(obj.keys, key.(string))
+			obj.values[key.(string)] = value
+		}
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		list := []any{}
+		for dec.More() {
+			value, err := decode(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err = dec.Token()
+		return list, err
+	default:
+		return tok, nil
+	}
+}
+
+// yamlLines renders a value as YAML
+func yamlLines(v any) []string {
+	switch v := v.(type) {
+	case *object:
+		if len(v.keys) == 0 {
+			return []string{"{}"}
+		}
+
+		var lines []string
+		for _, key := range v.keys {
+			value := v.values[key]
+			if isScalar(value) || isEmpty(value) {
+				lines = append(lines, yamlScalar(key)+": "+yamlLines(value)[0])
+				continue
+			}
+
+			lines = append(lines, yamlScalar(key)+":")
+			for _, line := range yamlLines(value) {
+				lines = append(lines, "  "+line)
+			}
+		}
+		return lines
+	case []any:
+		if len(v) == 0 {
+			return []string{"[]"}
+		}
+
+		var lines []string
+		for _, item := range v {
+			for i, line := range yamlLines(item) {
+				if i == 0 {
+					lines = append(lines, "- "+line)
+				} else {
+					lines = append(lines, "  "+line)
+				}
+			}
+		}
+		return lines
+	case string:
+		return []string{yamlScalar(v)}
+	case nil:
+		return []string{"null"}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+var (
+	plainYAML     = regexp.MustCompile(`^[A-Za-z0-9_./@+(][A-Za-z0-9 _./@+()=,-]*$`)
+	ambiguousYAML = regexp.MustCompile(`^(?i:true|false|yes|no|on|off|null|~|y|n)$|^[-+]?[0-9._]+([eE][-+]?[0-9]+)?$|^0[xo]`)
+)
+
+// yamlScalar quotes a string unless it reads as the same string in YAML
+func yamlScalar(s string) string {
+	if plainYAML.MatchString(s) && !ambiguousYAML.MatchString(s) && !strings.HasSuffix(s, " ") {
+		return s
+	}
+
+	return strconv.Quote(s)
+}
+
+func isScalar(v any) bool {
+	switch v.(type) {
+	case *object, []any:
+		return false
+	default:
+		return true
+	}
+}
+
+func isEmpty(v any) bool {
+	switch v := v.(type) {
+	case *object:
+		return len(v.keys) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// writeTable writes a list of objects as rows with a column per field, and
+// anything else as rows of fields and values. Nested objects are left out of
+// rows and shown as JSON in the fields of a single object.
+func writeTable(w io.Writer, v any) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	switch v := v.(type) {
+	case []any:
+		columns := tableColumns(v)
+		if columns == nil {
+			fmt.Fprintln(tw, "VALUE")
+			for _, item := range v {
+				fmt.Fprintln(tw, cell(item))
+			}
+			break
+		}
+
+		header := make([]string, len(columns))
+		for i, column := range columns {
+			header[i] = strings.ToUpper(column)
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+		for _, item := range v {
+			obj, _ := item.(*object)
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				if obj != nil {
+					row[i] = cell(obj.values[column])
+				}
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+	case *object:
+		fmt.Fprintln(tw, "FIELD\tVALUE")
+		for _, key := range v.keys {
+			fmt.Fprintf(tw, "%s\t%s\n", key, cell(v.values[key]))
+		}
+	default:
+		fmt.Fprintln(tw, cell(v))
+	}
+
+	return tw.Flush()
+}
+
+// tableColumns returns the fields of a list of objects that fit in a cell,
+// in the order they first appear, nil if the list holds no objects
+func tableColumns(list []any) []string {
+	var columns []string
+	seen := map[string]bool{}
+	for _, item := range list {
+		obj, ok := item.(*object)
+		if !ok {
+			continue
+		}
+
+		for _, key := range obj.keys {
+			if seen[key] || !fitsCell(obj.values[key]) {
+				continue
+			}
+			seen[key] = true
+			columns = append(columns, key)
+		}
+	}
+
+	return columns
+}
+
+// fitsCell reports whether a value is a scalar or a list of scalars
+func fitsCell(v any) bool {
+	list, ok := v.([]any)
+	if !ok {
+		return isScalar(v)
+	}
+
+	for _, item := range list {
+		if !isScalar(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cell formats a value for a table, lists of scalars are comma separated
+// and anything else nested is JSON
+func cell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []any:
+		if fitsCell(v) {
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = cell(item)
+			}
+			return strings.Join(items, ",")
+		}
+		return compact(v)
+	case *object:
+		return compact(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// compact renders a nested value as single line JSON
+func compact(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
+
+// MarshalJSON keeps the order of the keys of the object
+func (o *object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,430 @@
+// Code generated by cmdgen from the govultr service interfaces. DO NOT EDIT.
+
+package main
+
+// params holds the parameter names of the service methods, without the context
+var params = map[string][]string{
+	"AccountService.Get":                                  {},
+	"AccountService.GetBandwidth":                         {},
+	"ApplicationService.List":                             {"options"},
+	"BackupService.Get":                                   {"backupID"},
+	"BackupService.List":                                  {"options"},
+	"BareMetalServerService.AttachVPC":                    {"serverID", "vpcID"},
+	"BareMetalServerService.Create":                       {"bmCreate"},
+	"BareMetalServerService.Delete":                       {"serverID"},
+	"BareMetalServerService.DetachVPC":                    {"serverID", "vpcID"},
+	"BareMetalServerService.Get":                          {"serverID"},
+	"BareMetalServerService.GetBandwidth":                 {"serverID"},
+	"BareMetalServerService.GetUpgrades":                  {"serverID"},
+	"BareMetalServerService.GetUserData":                  {"serverID"},
+	"BareMetalServerService.GetVNCUrl":                    {"serverID"},
+	"BareMetalServerService.Halt":                         {"serverID"},
+	"BareMetalServerService.List":                         {"options"},
+	"BareMetalServerService.ListIPv4s":                    {"serverID", "options"},
+	"BareMetalServerService.ListIPv6s":                    {"serverID", "options"},
+	"BareMetalServerService.ListVPCInfo":                  {"serverID"},
+	"BareMetalServerService.MassHalt":                     {"serverList"},
+	"BareMetalServerService.MassReboot":                   {"serverList"},
+	"BareMetalServerService.MassStart":                    {"serverList"},
+	"BareMetalServerService.Reboot":                       {"serverID"},
+	"BareMetalServerService.Reinstall":                    {"serverID"},
+	"BareMetalServerService.Start":                        {"serverID"},
+	"BareMetalServerService.Update":                       {"serverID", "bmReq"},
+	"BillingService.GetInvoice":                           {"invoiceID"},
+	"BillingService.ListHistory":                          {"options"},
+	"BillingService.ListInvoiceItems":                     {"invoiceID", "options"},
+	"BillingService.ListInvoices":                         {"options"},
+	"BillingService.ListPendingCharges":                   {"options"},
+	"BlockStorageService.Attach":                          {"blockID", "attach"},
+	"BlockStorageService.Create":                          {"blockReq"},
+	"BlockStorageService.CreateSnapshot":                  {"snapshotReq"},
+	"BlockStorageService.Delete":                          {"blockID"},
+	"BlockStorageService.DeleteSnapshot":                  {"snapshotID"},
+	"BlockStorageService.Detach":                          {"blockID", "detach"},
+	"BlockStorageService.Get":                             {"blockID"},
+	"BlockStorageService.GetSnapshot":                     {"snapshotID"},
+	"BlockStorageService.List":                            {"options"},
+	"BlockStorageService.ListSnapshots":                   {"options"},
+	"BlockStorageService.Update":                          {"blockID", "blockReq"},
+	"BlockStorageService.UpdateSnapshot":                  {"snapshotID", "snapshotReq"},
+	"CDNService.CreatePullZone":                           {"zoneReq"},
+	"CDNService.CreatePushZone":                           {"zoneReq"},
+	"CDNService.CreatePushZoneFileEndpoint":               {"zoneID", "endpointReq"},
+	"CDNService.DeletePullZone":                           {"zoneID"},
+	"CDNService.DeletePushZone":                           {"zoneID"},
+	"CDNService.DeletePushZoneFile":                       {"zoneID", "fileName"},
+	"CDNService.GetPullZone":                              {"zoneID"},
+	"CDNService.GetPushZone":                              {"zoneID"},
+	"CDNService.GetPushZoneFile":                          {"zoneID", "fileName"},
+	"CDNService.ListPullZones":                            {},
+	"CDNService.ListPushZoneFiles":                        {"zoneID"},
+	"CDNService.ListPushZones":                            {},
+	"CDNService.PurgePullZone":                            {"zoneID"},
+	"CDNService.UpdatePullZone":                           {"zoneID", "zoneReq"},
+	"CDNService.UpdatePushZone":                           {"zoneID", "zoneReq"},
+	"ContainerRegistryService.Create":                     {"createReq"},
+	"ContainerRegistryService.CreateDockerCredentials":    {"vcrID", "createOptions"},
+	"ContainerRegistryService.CreateReplication":          {"vcrID", "regionID"},
+	"ContainerRegistryService.CreateRetentionRule":        {"vcrID", "ruleReq"},
+	"ContainerRegistryService.Delete":                     {"vcrID"},
+	"ContainerRegistryService.DeleteArtifact":             {"vcrID", "imageName", "artifactDigest"},
+	"ContainerRegistryService.DeleteReplication":          {"vcrID", "regionID"},
+	"ContainerRegistryService.DeleteRepository":           {"vcrID", "imageName"},
+	"ContainerRegistryService.DeleteRetentionRule":        {"vcrID", "ruleID"},
+	"ContainerRegistryService.DeleteRobot":                {"vcrID", "robotName"},
+	"ContainerRegistryService.ExecuteRetention":           {"vcrID", "dryRun"},
+	"ContainerRegistryService.Get":                        {"vcrID"},
+	"ContainerRegistryService.GetArtifact":                {"vcrID", "imageName", "artifactDigest"},
+	"ContainerRegistryService.GetReplication":             {"vcrID", "regionID"},
+	"ContainerRegistryService.GetRepository":              {"vcrID", "imageName"},
+	"ContainerRegistryService.GetRetentionRule":           {"vcrID", "ruleID"},
+	"ContainerRegistryService.GetRobot":                   {"vcrID", "robotName"},
+	"ContainerRegistryService.List":                       {"options"},
+	"ContainerRegistryService.ListArtifacts":              {"vcrID", "imageName", "options"},
+	"ContainerRegistryService.ListPlans":                  {},
+	"ContainerRegistryService.ListRegions":                {},
+	"ContainerRegistryService.ListReplications":           {"vcrID", "options"},
+	"ContainerRegistryService.ListRepositories":           {"vcrID", "options"},
+	"ContainerRegistryService.ListRetentionRules":         {"vcrID", "options"},
+	"ContainerRegistryService.ListRobots":                 {"vcrID", "options"},
+	"ContainerRegistryService.Update":                     {"vcrID", "updateReq"},
+	"ContainerRegistryService.UpdateRepository":           {"vcrID", "imageName", "updateReq"},
+	"ContainerRegistryService.UpdateRetentionRule":        {"vcrID", "ruleID", "disabled"},
+	"ContainerRegistryService.UpdateRetentionSchedule":    {"vcrID", "schedule"},
+	"ContainerRegistryService.UpdateRobot":                {"vcrID", "robotName", "updateReq"},
+	"DatabaseService.AddReadOnlyReplica":                  {"databaseID", "databaseReplicaReq"},
+	"DatabaseService.Create":                              {"databaseReq"},
+	"DatabaseService.CreateConnectionPool":                {"databaseID", "databaseConnectionPoolReq"},
+	"DatabaseService.CreateConnector":                     {"databaseID", "databaseConnectorReq"},
+	"DatabaseService.CreateDB":                            {"databaseID", "databaseDBReq"},
+	"DatabaseService.CreateQuota":                         {"databaseID", "databaseQuotaReq"},
+	"DatabaseService.CreateTopic":                         {"databaseID", "databaseTopicReq"},
+	"DatabaseService.CreateUser":                          {"databaseID", "databaseUserReq"},
+	"DatabaseService.Delete":                              {"databaseID"},
+	"DatabaseService.DeleteConnectionPool":                {"databaseID", "poolName"},
+	"DatabaseService.DeleteConnector":                     {"databaseID", "connectorName"},
+	"DatabaseService.DeleteDB":                            {"databaseID", "dbname"},
+	"DatabaseService.DeleteQuota":                         {"databaseID", "clientID", "username"},
+	"DatabaseService.DeleteTopic":                         {"databaseID", "topicName"},
+	"DatabaseService.DeleteUser":                          {"databaseID", "username"},
+	"DatabaseService.DetachMigration":                     {"databaseID"},
+	"DatabaseService.Fork":                                {"databaseID", "databaseForkReq"},
+	"DatabaseService.Get":                                 {"databaseID"},
+	"DatabaseService.GetBackupInformation":                {"databaseID"},
+	"DatabaseService.GetConnectionPool":                   {"databaseID", "poolName"},
+	"DatabaseService.GetConnector":                        {"databaseID", "connectorName"},
+	"DatabaseService.GetConnectorConfigurationSchema":     {"databaseID", "connectorClass"},
+	"DatabaseService.GetConnectorStatus":                  {"databaseID", "connectorName"},
+	"DatabaseService.GetDB":                               {"databaseID", "dbname"},
+	"DatabaseService.GetMigrationStatus":                  {"databaseID"},
+	"DatabaseService.GetQuota":                            {"databaseID", "clientID", "username"},
+	"DatabaseService.GetTopic":                            {"databaseID", "topicName"},
+	"DatabaseService.GetUsage":                            {"databaseID"},
+	"DatabaseService.GetUser":                             {"databaseID", "username"},
+	"DatabaseService.List":                                {"options"},
+	"DatabaseService.ListAdvancedOptions":                 {"databaseID"},
+	"DatabaseService.ListAvailableConnectors":             {"databaseID"},
+	"DatabaseService.ListAvailableVersions":               {"databaseID"},
+	"DatabaseService.ListConnectionPools":                 {"databaseID"},
+	"DatabaseService.ListConnectors":                      {"databaseID"},
+	"DatabaseService.ListDBs":                             {"databaseID"},
+	"DatabaseService.ListKafkaConnectAdvancedOptions":     {"databaseID"},
+	"DatabaseService.ListKafkaRESTAdvancedOptions":        {"databaseID"},
+	"DatabaseService.ListMaintenanceUpdates":              {"databaseID"},
+	"DatabaseService.ListPlans":                           {"options"},
+	"DatabaseService.ListQuotas":                          {"databaseID"},
+	"DatabaseService.ListSchemaRegistryAdvancedOptions":   {"databaseID"},
+	"DatabaseService.ListServiceAlerts":                   {"databaseID", "databaseAlertsReq"},
+	"DatabaseService.ListTopics":                          {"databaseID"},
+	"DatabaseService.ListUsers":                           {"databaseID"},
+	"DatabaseService.PauseConnector":                      {"databaseID", "connectorName"},
+	"DatabaseService.PromoteReadReplica":                  {"databaseID"},
+	"DatabaseService.RestartConnector":                    {"databaseID", "connectorName"},
+	"DatabaseService.RestartConnectorTask":                {"databaseID", "connectorName", "taskID"},
+	"DatabaseService.RestoreFromBackup":                   {"databaseID", "databaseRestoreReq"},
+	"DatabaseService.ResumeConnector":                     {"databaseID", "connectorName"},
+	"DatabaseService.StartMaintenance":                    {"databaseID"},
+	"DatabaseService.StartMigration":                      {"databaseID", "databaseMigrationReq"},
+	"DatabaseService.StartVersionUpgrade":                 {"databaseID", "databaseVersionUpgradeReq"},
+	"DatabaseService.Update":                              {"databaseID", "databaseReq"},
+	"DatabaseService.UpdateAdvancedOptions":               {"databaseID", "databaseAdvancedOptionsReq"},
+	"DatabaseService.UpdateConnectionPool":                {"databaseID", "poolName", "databaseConnectionPoolReq"},
+	"DatabaseService.UpdateConnector":                     {"databaseID", "connectorName", "databaseConnectorReq"},
+	"DatabaseService.UpdateKafkaConnectAdvancedOptions":   {"databaseID", "databaseKafkaConnectAdvancedOptionsReq"},
+	"DatabaseService.UpdateKafkaRESTAdvancedOptions":      {"databaseID", "databaseKafkaRESTAdvancedOptionsReq"},
+	"DatabaseService.UpdateQuota":                         {"databaseID", "clientID", "username", "databaseQuotaReq"},
+	"DatabaseService.UpdateSchemaRegistryAdvancedOptions": {"databaseID", "databaseSchemaRegistryAdvancedOptionsReq"},
+	"DatabaseService.UpdateTopic":                         {"databaseID", "topicName", "databaseTopicReq"},
+	"DatabaseService.UpdateUser":                          {"databaseID", "username", "databaseUserReq"},
+	"DatabaseService.UpdateUserACL":                       {"databaseID", "username", "databaseUserACLReq"},
+	"DomainRecordService.Create":                          {"domain", "domainRecordCreateReq"},
+	"DomainRecordService.Delete":                          {"domain", "recordID"},
+	"DomainRecordService.Get":                             {"domain", "recordID"},
+	"DomainRecordService.List":                            {"domain", "options"},
+	"DomainRecordService.Update":                          {"domain", "recordID", "domainRecordUpdateReq"},
+	"DomainService.Create":                                {"domainReq"},
+	"DomainService.Delete":                                {"domain"},
+	"DomainService.Get":                                   {"domain"},
+	"DomainService.GetDNSSec":                             {"domain"},
+	"DomainService.GetSoa":                                {"domain"},
+	"DomainService.List":                                  {"options"},
+	"DomainService.Update":                                {"domain", "dnsSec"},
+	"DomainService.UpdateSoa":                             {"domain", "soaReq"},
+	"FireWallRuleService.Create":                          {"fwGroupID", "fwRuleReq"},
+	"FireWallRuleService.Delete":                          {"fwGroupID", "fwRuleID"},
+	"FireWallRuleService.Get":                             {"fwGroupID", "fwRuleID"},
+	"FireWallRuleService.List":                            {"fwGroupID", "options"},
+	"FirewallGroupService.Create":                         {"fwGroupReq"},
+	"FirewallGroupService.Delete":                         {"fwGroupID"},
+	"FirewallGroupService.Get":                            {"groupID"},
+	"FirewallGroupService.List":                           {"options"},
+	"FirewallGroupService.Update":                         {"fwGroupID", "fwGroupReq"},
+	"ISOService.Create":                                   {"isoReq"},
+	"ISOService.Delete":                                   {"isoID"},
+	"ISOService.Get":                                      {"isoID"},
+	"ISOService.List":                                     {"options"},
+	"ISOService.ListPublic":                               {"options"},
+	"InferenceService.Create":                             {"inferenceReq"},
+	"InferenceService.Delete":                             {"inferenceID"},
+	"InferenceService.Get":                                {"inferenceID"},
+	"InferenceService.GetUsage":                           {"inferenceID"},
+	"InferenceService.List":                               {},
+	"InferenceService.Update":                             {"inferenceID", "inferenceReq"},
+	"InstanceService.AttachISO":                           {"instanceID", "isoID"},
+	"InstanceService.AttachVPC":                           {"instanceID", "vpcID"},
+	"InstanceService.Create":                              {"instanceReq"},
+	"InstanceService.CreateIPv4":                          {"instanceID", "reboot"},
+	"InstanceService.CreateReverseIPv4":                   {"instanceID", "reverseReq"},
+	"InstanceService.CreateReverseIPv6":                   {"instanceID", "reverseReq"},
+	"InstanceService.DefaultReverseIPv4":                  {"instanceID", "ip"},
+	"InstanceService.Delete":                              {"instanceID"},
+	"InstanceService.DeleteIPv4":                          {"instanceID", "ip"},
+	"InstanceService.DeleteReverseIPv6":                   {"instanceID", "ip"},
+	"InstanceService.DetachISO":                           {"instanceID"},
+	"InstanceService.DetachVPC":                           {"instanceID", "vpcID"},
+	"InstanceService.Get":                                 {"instanceID"},
+	"InstanceService.GetBackupSchedule":                   {"instanceID"},
+	"InstanceService.GetBandwidth":                        {"instanceID"},
+	"InstanceService.GetNeighbors":                        {"instanceID"},
+	"InstanceService.GetUpgrades":                         {"instanceID"},
+	"InstanceService.GetUserData":                         {"instanceID"},
+	"InstanceService.Halt":                                {"instanceID"},
+	"InstanceService.ISOStatus":                           {"instanceID"},
+	"InstanceService.List":                                {"options"},
+	"InstanceService.ListIPv4":                            {"instanceID", "option"},
+	"InstanceService.ListIPv6":                            {"instanceID", "option"},
+	"InstanceService.ListReverseIPv6":                     {"instanceID"},
+	"InstanceService.ListVPCInfo":                         {"instanceID", "options"},
+	"InstanceService.MassHalt":                            {"instanceList"},
+	"InstanceService.MassReboot":                          {"instanceList"},
+	"InstanceService.MassStart":                           {"instanceList"},
+	"InstanceService.Reboot":                              {"instanceID"},
+	"InstanceService.Reinstall":                           {"instanceID", "reinstallReq"},
+	"InstanceService.Restore":                             {"instanceID", "restoreReq"},
+	"InstanceService.SetBackupSchedule":                   {"instanceID", "backup"},
+	"InstanceService.Start":                               {"instanceID"},
+	"InstanceService.Update":                              {"instanceID", "instanceReq"},
+	"KubernetesService.CreateCluster":                     {"createReq"},
+	"KubernetesService.CreateNodePool":                    {"vkeID", "nodePoolReq"},
+	"KubernetesService.CreateNodePoolLabel":               {"vkeID", "nodePoolID", "nodePoolLabelReq"},
+	"KubernetesService.CreateNodePoolTaint":               {"vkeID", "nodePoolID", "nodePoolTaintReq"},
+	"KubernetesService.DeleteCluster":                     {"id"},
+	"KubernetesService.DeleteClusterWithResources":        {"id"},
+	"KubernetesService.DeleteNodePool":                    {"vkeID", "nodePoolID"},
+	"KubernetesService.DeleteNodePoolInstance":            {"vkeID", "nodePoolID", "nodeID"},
+	"KubernetesService.DeleteNodePoolLabel":               {"vkeID", "nodePoolID", "nodePoolLabelID"},
+	"KubernetesService.DeleteNodePoolTaint":               {"vkeID", "nodePoolID", "nodePoolTaintID"},
+	"KubernetesService.GetCluster":                        {"id"},
+	"KubernetesService.GetKubeConfig":                     {"vkeID"},
+	"KubernetesService.GetNodePool":                       {"vkeID", "nodePoolID"},
+	"KubernetesService.GetNodePoolLabel":                  {"vkeID", "nodePoolID", "nodePoolLabelID"},
+	"KubernetesService.GetNodePoolTaint":                  {"vkeID", "nodePoolID", "nodePoolTaintID"},
+	"KubernetesService.GetUpgrades":                       {"vkeID"},
+	"KubernetesService.GetVersions":                       {},
+	"KubernetesService.ListClusters":                      {"options"},
+	"KubernetesService.ListNodePoolLabels":                {"vkeID", "nodePoolID"},
+	"KubernetesService.ListNodePoolTaints":                {"vkeID", "nodePoolID"},
+	"KubernetesService.ListNodePools":                     {"vkeID", "options"},
+	"KubernetesService.ListWorkerNodes":                   {"vkeID", "nodePoolID", "options"},
+	"KubernetesService.RecycleNodePoolInstance":           {"vkeID", "nodePoolID", "nodeID"},
+	"KubernetesService.UpdateCluster":                     {"vkeID", "updateReq"},
+	"KubernetesService.UpdateNodePool":                    {"vkeID", "nodePoolID", "updateReq"},
+	"KubernetesService.Upgrade":                           {"vkeID", "body"},
+	"LoadBalancerService.Create":                          {"createReq"},
+	"LoadBalancerService.CreateFirewallRules":             {"lbID", "fwRule"},
+	"LoadBalancerService.CreateForwardingRule":            {"lbID", "rule"},
+	"LoadBalancerService.Delete":                          {"lbID"},
+	"LoadBalancerService.DeleteAutoSSL":                   {"lbID"},
+	"LoadBalancerService.DeleteFirewallRule":              {"lbID", "fwRuleID"},
+	"LoadBalancerService.DeleteForwardingRule":            {"lbID", "RuleID"},
+	"LoadBalancerService.DeleteSSL":                       {"lbID"},
+	"LoadBalancerService.Get":                             {"lbID"},
+	"LoadBalancerService.GetFirewallRule":                 {"lbID", "ruleID"},
+	"LoadBalancerService.GetForwardingRule":               {"lbID", "ruleID"},
+	"LoadBalancerService.List":                            {"options"},
+	"LoadBalancerService.ListFirewallRules":               {"lbID", "options"},
+	"LoadBalancerService.ListForwardingRules":             {"lbID", "options"},
+	"LoadBalancerService.Update":                          {"lbID", "updateReq"},
+	"LogsService.Follow":                                  {"options", "followOptions"},
+	"LogsService.List":                                    {"options"},
+	"LogsService.Stream":                                  {"options"},
+	"MarketplaceService.ListAppVariables":                 {"imageID"},
+	"OIDCService.AuthorizeOIDC":                           {"oidcAuthParams"},
+	"OIDCService.CreateOIDCIssuer":                        {"oidcIssuerReq"},
+	"OIDCService.CreateOIDCProvider":                      {"oidcProviderReq"},
+	"OIDCService.CreateOIDCToken":                         {"oidcTokenReq"},
+	"OIDCService.DeleteOIDCIssuer":                        {"oidcIssuerID"},
+	"OIDCService.DeleteOIDCProvider":                      {"oidcProviderID"},
+	"OIDCService.DiscoveryOIDC":                           {"oidcProviderID"},
+	"OIDCService.GetOIDCIssuer":                           {"oidcIssuerID"},
+	"OIDCService.GetOIDCProvider":                         {"oidcProviderID"},
+	"OIDCService.GetOIDCToken":                            {"oidcTokenID"},
+	"OIDCService.ListOIDCIssuers":                         {},
+	"OIDCService.ListOIDCProviders":                       {},
+	"OSService.List":                                      {"options"},
+	"ObjectStorageService.Create":                         {"objReq"},
+	"ObjectStorageService.CreateBucket":                   {"osID", "bucketReq"},
+	"ObjectStorageService.Delete":                         {"id"},
+	"ObjectStorageService.DeleteBucket":                   {"osID", "bucketName"},
+	"ObjectStorageService.Get":                            {"id"},
+	"ObjectStorageService.List":                           {"options"},
+	"ObjectStorageService.ListBuckets":                    {"osID"},
+	"ObjectStorageService.ListCluster":                    {"options"},
+	"ObjectStorageService.ListClusterTiers":               {"clusterID"},
+	"ObjectStorageService.ListTiers":                      {},
+	"ObjectStorageService.RegenerateKeys":                 {"id"},
+	"ObjectStorageService.Update":                         {"id", "objReq"},
+	"OrganizationService.AddGroupMember":                  {"groupID", "memberReq"},
+	"OrganizationService.AttachPolicyGroup":               {"policyID", "groupID"},
+	"OrganizationService.AttachPolicyUser":                {"policyID", "userID"},
+	"OrganizationService.AttachRoleGroup":                 {"roleID", "groupID"},
+	"OrganizationService.AttachRolePolicy":                {"roleID", "policyID"},
+	"OrganizationService.AttachRoleUser":                  {"roleID", "userID"},
+	"OrganizationService.CreateGroup":                     {"groupReq"},
+	"OrganizationService.CreateInvitation":                {"invitationReq"},
+	"OrganizationService.CreateOrganization":              {"organizationReq"},
+	"OrganizationService.CreatePolicy":                    {"policyReq"},
+	"OrganizationService.CreateRole":                      {"roleReq"},
+	"OrganizationService.CreateRoleSession":               {"roleSessionReq"},
+	"OrganizationService.CreateRoleTrust":                 {"roleTrustReq"},
+	"OrganizationService.DeleteGroup":                     {"groupID"},
+	"OrganizationService.DeleteOrganization":              {"organizationID"},
+	"OrganizationService.DeletePolicy":                    {"policyID"},
+	"OrganizationService.DeleteRole":                      {"roleID"},
+	"OrganizationService.DeleteRoleTrust":                 {"roleTrustID"},
+	"OrganizationService.DeleteUser":                      {"organizationID", "userID"},
+	"OrganizationService.DetachPolicyGroup":               {"policyID", "groupID"},
+	"OrganizationService.DetachPolicyUser":                {"policyID", "userID"},
+	"OrganizationService.DetachRoleGroup":                 {"roleID", "groupID"},
+	"OrganizationService.DetachRolePolicy":                {"roleID", "policyID"},
+	"OrganizationService.DetachRoleUser":                  {"roleID", "userID"},
+	"OrganizationService.GetGroup":                        {"groupID"},
+	"OrganizationService.GetInvitation":                   {"invitationID"},
+	"OrganizationService.GetOrganization":                 {"organizationID"},
+	"OrganizationService.GetPolicy":                       {"policyID"},
+	"OrganizationService.GetRole":                         {"roleID"},
+	"OrganizationService.GetRoleSession":                  {"token"},
+	"OrganizationService.GetRoleTrust":                    {"roleTrustID"},
+	"OrganizationService.ListCurrentUserGroups":           {"options"},
+	"OrganizationService.ListCurrentUserPolicies":         {"options"},
+	"OrganizationService.ListCurrentUserRoles":            {"options"},
+	"OrganizationService.ListGroupPolicies":               {"groupID"},
+	"OrganizationService.ListGroupRoles":                  {"groupID"},
+	"OrganizationService.ListGroups":                      {"options"},
+	"OrganizationService.ListInvitations":                 {"options"},
+	"OrganizationService.ListOrganizations":               {"options"},
+	"OrganizationService.ListPolicies":                    {"options"},
+	"OrganizationService.ListPolicyGroups":                {"policyID", "options"},
+	"OrganizationService.ListPolicyUsers":                 {"policyID", "options"},
+	"OrganizationService.ListRoleGroups":                  {"roleID", "options"},
+	"OrganizationService.ListRolePolicies":                {"roleID", "options"},
+	"OrganizationService.ListRoleSessions":                {"userID"},
+	"OrganizationService.ListRoleTrusts":                  {"options"},
+	"OrganizationService.ListRoleTrustsByRole":            {"roleID"},
+	"OrganizationService.ListRoleTrustsByUser":            {"userID"},
+	"OrganizationService.ListRoleUsers":                   {"roleID", "options"},
+	"OrganizationService.ListRoles":                       {"options"},
+	"OrganizationService.ListSuspendedUsers":              {"organizationIDstring", "options"},
+	"OrganizationService.ListUserGroups":                  {"userID", "options"},
+	"OrganizationService.ListUserPolicies":                {"userID", "options"},
+	"OrganizationService.ListUserRoles":                   {"userID", "options"},
+	"OrganizationService.RemoveGroupMember":               {"groupID", "userID"},
+	"OrganizationService.ResendInvitation":                {"invitationID"},
+	"OrganizationService.RestoreOrganization":             {"organizationID"},
+	"OrganizationService.RestorePolicy":                   {"policyID"},
+	"OrganizationService.RestoreRole":                     {"roleID"},
+	"OrganizationService.RestoreRoleTrust":                {"roleTrustID"},
+	"OrganizationService.RevokeRoleSession":               {"token"},
+	"OrganizationService.SuspendUser":                     {"organizationID", "userID"},
+	"OrganizationService.UnsuspendUser":                   {"organizationID", "userID"},
+	"OrganizationService.UpdateGroup":                     {"groupID", "groupReq"},
+	"OrganizationService.UpdateOrganization":              {"organizationID", "organizationReq"},
+	"OrganizationService.UpdatePolicy":                    {"policyID", "policyReq"},
+	"OrganizationService.UpdateRole":                      {"roleID", "roleReq"},
+	"OrganizationService.UpdateRoleTrust":                 {"roleTrustID", "roleTrustReq"},
+	"PlanService.List":                                    {"planType", "options"},
+	"PlanService.ListBareMetal":                           {"options"},
+	"RegionService.Availability":                          {"regionID", "planType"},
+	"RegionService.List":                                  {"options"},
+	"ReservedIPService.Attach":                            {"id", "instance"},
+	"ReservedIPService.Convert":                           {"ripConvert"},
+	"ReservedIPService.Create":                            {"ripCreate"},
+	"ReservedIPService.Delete":                            {"id"},
+	"ReservedIPService.Detach":                            {"id"},
+	"ReservedIPService.Get":                               {"id"},
+	"ReservedIPService.List":                              {"options"},
+	"ReservedIPService.Update":                            {"id", "ripUpdate"},
+	"SSHKeyService.Create":                                {"sshKeyReq"},
+	"SSHKeyService.Delete":                                {"sshKeyID"},
+	"SSHKeyService.Get":                                   {"sshKeyID"},
+	"SSHKeyService.List":                                  {"options"},
+	"SSHKeyService.Update":                                {"sshKeyID", "sshKeyReq"},
+	"SnapshotService.Create":                              {"snapshotReq"},
+	"SnapshotService.CreateFromURL":                       {"snapshotURLReq"},
+	"SnapshotService.Delete":                              {"snapshotID"},
+	"SnapshotService.Get":                                 {"snapshotID"},
+	"SnapshotService.List":                                {"options"},
+	"SnapshotService.Update":                              {"snapshotID", "snapshotReq"},
+	"StartupScriptService.Create":                         {"req"},
+	"StartupScriptService.Delete":                         {"scriptID"},
+	"StartupScriptService.Get":                            {"scriptID"},
+	"StartupScriptService.List":                           {"options"},
+	"StartupScriptService.Update":                         {"scriptID", "scriptReq"},
+	"SubAccountService.Create":                            {"saReq"},
+	"SubAccountService.List":                              {"options"},
+	"UserService.Create":                                  {"userCreate"},
+	"UserService.Delete":                                  {"userID"},
+	"UserService.Get":                                     {"userID"},
+	"UserService.List":                                    {"options"},
+	"UserService.Update":                                  {"userID", "userReq"},
+	"VPCService.Create":                                   {"createReq"},
+	"VPCService.CreateNATGateway":                         {"vpcID", "createReq"},
+	"VPCService.CreateNATGatewayFirewallRule":             {"vpcID", "gatewayID", "createReq"},
+	"VPCService.CreateNATGatewayPortForwardingRule":       {"vpcID", "gatewayID", "createReq"},
+	"VPCService.Delete":                                   {"vpcID"},
+	"VPCService.DeleteNATGateway":                         {"vpcID", "gatewayID"},
+	"VPCService.DeleteNATGatewayFirewallRule":             {"vpcID", "gatewayID", "ruleID"},
+	"VPCService.DeleteNATGatewayPortForwardingRule":       {"vpcID", "gatewayID", "ruleID"},
+	"VPCService.Get":                                      {"vpcID"},
+	"VPCService.GetNATGateway":                            {"vpcID", "gatewayID"},
+	"VPCService.GetNATGatewayFirewallRule":                {"vpcID", "gatewayID", "ruleID"},
+	"VPCService.GetNATGatewayPortForwardingRule":          {"vpcID", "gatewayID", "ruleID"},
+	"VPCService.List":                                     {"options"},
+	"VPCService.ListAttachments":                          {"vpcID", "options"},
+	"VPCService.ListNATGatewayFirewallRules":              {"vpcID", "gatewayID", "options"},
+	"VPCService.ListNATGatewayPortForwardingRules":        {"vpcID", "gatewayID", "options"},
+	"VPCService.ListNATGateways":                          {"vpcID", "options"},
+	"VPCService.Update":                                   {"vpcID", "description"},
+	"VPCService.UpdateNATGateway":                         {"vpcID", "gatewayID", "updateReq"},
+	"VPCService.UpdateNATGatewayFirewallRule":             {"vpcID", "gatewayID", "ruleID", "updateReq"},
+	"VPCService.UpdateNATGatewayPortForwardingRule":       {"vpcID", "gatewayID", "ruleID", "updateReq"},
+	"VirtualFileSystemStorageService.Attach":              {"vfsID", "targetID"},
+	"VirtualFileSystemStorageService.AttachmentGet":       {"vfsID", "targetID"},
+	"VirtualFileSystemStorageService.AttachmentList":      {"vfsID"},
+	"VirtualFileSystemStorageService.Create":              {"vfsReq"},
+	"VirtualFileSystemStorageService.Delete":              {"vfsID"},
+	"VirtualFileSystemStorageService.Detach":              {"vfsID", "targetID"},
+	"VirtualFileSystemStorageService.Get":                 {"vfsID"},
+	"VirtualFileSystemStorageService.List":                {"options"},
+	"VirtualFileSystemStorageService.Update":              {"vfsID", "vfsUpdateReq"},
+}
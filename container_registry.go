@@ -67,7 +67,7 @@ type ContainerRegistry struct {
 	Name        string                    `json:"name"`
 	URN         string                    `json:"urn"`
 	Storage     ContainerRegistryStorage  `json:"storage"`
-	DateCreated string                    `json:"date_created"`
+	DateCreated Timestamp                 `json:"date_created"`
 	Public      bool                      `json:"public"`
 	RootUser    ContainerRegistryUser     `json:"root_user"`
 	Metadata    ContainerRegistryMetadata `json:"metadata"`
@@ -86,21 +86,21 @@ type ContainerRegistryStorage struct {
 
 // ContainerRegistryStorageCount represents the different storage usage counts.
 type ContainerRegistryStorageCount struct {
-	Bytes        float32 `json:"bytes"`
-	MegaBytes    float32 `json:"mb"`
-	GigaBytes    float32 `json:"gb"`
-	TeraBytes    float32 `json:"tb"`
-	DateModified string  `json:"updated_at"`
+	Bytes        float32   `json:"bytes"`
+	MegaBytes    float32   `json:"mb"`
+	GigaBytes    float32   `json:"gb"`
+	TeraBytes    float32   `json:"tb"`
+	DateModified Timestamp `json:"updated_at"`
 }
 
 // ContainerRegistryUser contains the user data.
 type ContainerRegistryUser struct {
-	ID           int    `json:"id"`
-	UserName     string `json:"username"`
-	Password     string `json:"password"`
-	Root         bool   `json:"root"`
-	DateCreated  string `json:"added_at"`
-	DateModified string `json:"updated_at"`
+	ID           int       `json:"id"`
+	UserName     string    `json:"username"`
+	Password     string    `json:"password"`
+	Root         bool      `json:"root"`
+	DateCreated  Timestamp `json:"added_at"`
+	DateModified Timestamp `json:"updated_at"`
 }
 
 // ContainerRegistryMetadata contains the meta data for the registry.
@@ -138,13 +138,13 @@ type ContainerRegistryUpdateReq struct {
 
 // ContainerRegistryRepo represents the data of a registry repository.
 type ContainerRegistryRepo struct {
-	Name          string `json:"name"`
-	Image         string `json:"image"`
-	Description   string `json:"description"`
-	DateCreated   string `json:"added_at"`
-	DateModified  string `json:"updated_at"`
-	PullCount     int    `json:"pull_count"`
-	ArtifactCount int    `json:"artifact_count"`
+	Name          string    `json:"name"`
+	Image         string    `json:"image"`
+	Description   string    `json:"description"`
+	DateCreated   Timestamp `json:"added_at"`
+	DateModified  Timestamp `json:"updated_at"`
+	PullCount     int       `json:"pull_count"`
+	ArtifactCount int       `json:"artifact_count"`
 }
 
 type containerRegistryRepos struct {
@@ -178,8 +178,8 @@ type containerRegistryReplicationsBase struct {
 // ContainerRegistryRetentionSchedule represents a container registry retention
 // schedule.
 type ContainerRegistryRetentionSchedule struct {
-	Schedule          string `json:"schedule"`
-	NextScheduledTime string `json:"next_scheduled_time"`
+	Schedule          string    `json:"schedule"`
+	NextScheduledTime Timestamp `json:"next_scheduled_time"`
 }
 
 // ContainerRegistryRetentionScheduleReq represents a container registry
@@ -191,10 +191,10 @@ type ContainerRegistryRetentionScheduleReq struct {
 // ContainerRegistryRetentionExecution represents a container registry
 // execution.
 type ContainerRegistryRetentionExecution struct {
-	Start   string `json:"start_time"`
-	End     string `json:"end_time"`
-	Trigger string `json:"trigger"`
-	DryRun  bool   `json:"dry_run"`
+	Start   Timestamp `json:"start_time"`
+	End     Timestamp `json:"end_time"`
+	Trigger string    `json:"trigger"`
+	DryRun  bool      `json:"dry_run"`
 }
 
 // ContainerRegistryRetentionExecutionReq represents a container registry
@@ -266,7 +266,7 @@ type ContainerRegistryRobot struct {
 	Disable     bool                               `json:"disable"`
 	Duration    int                                `json:"duration"`
 	Permissions []ContainerRegistryRobotPermission `json:"permissions"`
-	DateCreated string                             `json:"creation_time"`
+	DateCreated Timestamp                          `json:"creation_time"`
 }
 
 // ContainerRegistryRobotPermission represent container registry robot
@@ -307,16 +307,16 @@ type ContainerRegistryArtifact struct {
 	Size              int                            `json:"size"`
 	Type              string                         `json:"type"`
 	Tags              []ContainerRegistryArtifactTag `json:"tags"`
-	DatePulled        string                         `json:"pull_time"`
-	DatePushed        string                         `json:"push_time"`
+	DatePulled        Timestamp                      `json:"pull_time"`
+	DatePushed        Timestamp                      `json:"push_time"`
 }
 
 // ContainerRegistryArtifactTag represents tags on an artifact.
 type ContainerRegistryArtifactTag struct {
-	Name       string `json:"name"`
-	Immutable  bool   `json:"immutable"`
-	DatePulled string `json:"pull_time"`
-	DatePushed string `json:"push_time"`
+	Name       string    `json:"name"`
+	Immutable  bool      `json:"immutable"`
+	DatePulled Timestamp `json:"pull_time"`
+	DatePushed Timestamp `json:"push_time"`
 }
 
 type containerRegistryArtifactsBase struct {
@@ -353,8 +353,8 @@ type ContainerRegistryRegion struct {
 	URN          string                            `json:"urn"`
 	BaseURL      string                            `json:"base_url"`
 	Public       bool                              `json:"public"`
-	DateCreated  string                            `json:"added_at"`
-	DateModified string                            `json:"updated_at"`
+	DateCreated  Timestamp                         `json:"added_at"`
+	DateModified Timestamp                         `json:"updated_at"`
 	DataCenter   ContainerRegistryRegionDataCenter `json:"data_center"`
 }
 
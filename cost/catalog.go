@@ -0,0 +1,110 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Catalog looks up the monthly prices of plans and object storage tiers.
+// Each price list is read from the API the first time it's needed and kept.
+// A Catalog isn't safe for concurrent use.
+type Catalog struct {
+	client *govultr.Client
+
+	plans     map[string]float64
+	bareMetal map[string]float64
+	databases map[string]float64
+	tiers     []govultr.ObjectStorageTier
+}
+
+// NewCatalog returns a Catalog that reads price lists with client's services
+func NewCatalog(client *govultr.Client) *Catalog {
+	return &Catalog{client: client}
+}
+
+// PlanPrice returns the monthly price of an instance plan, which is also the
+// price of a Kubernetes node of the plan
+func (c *Catalog) PlanPrice(ctx context.Context, plan string) (float64, error) {
+	if c.plans == nil {
+		list := func(ctx context.Context, o *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error) {
+			return c.client.Plan.List(ctx, "all", o)
+		}
+		plans, err := govultr.CollectAll(ctx, nil, list)
+		if err != nil {
+			return 0, err
+		}
+
+		c.plans = map[string]float64{}
+		for i := range plans {
+			c.plans[plans[i].ID] = float64(plans[i].MonthlyCost)
+		}
+	}
+
+	return lookup(c.plans, plan)
+}
+
+// BareMetalPrice returns the monthly price of a bare metal plan
+func (c *Catalog) BareMetalPrice(ctx context.Context, plan string) (float64, error) {
+	if c.bareMetal == nil {
+		plans, err := govultr.CollectAll(ctx, nil, c.client.Plan.ListBareMetal)
+		if err != nil {
+			return 0, err
+		}
+
+		c.bareMetal = map[string]float64{}
+		for i := range plans {
+			c.bareMetal[plans[i].ID] = float64(plans[i].MonthlyCost)
+		}
+	}
+
+	return lookup(c.bareMetal, plan)
+}
+
+// DatabasePrice returns the monthly price of a managed database plan
+func (c *Catalog) DatabasePrice(ctx context.Context, plan string) (float64, error) {
+	if c.databases == nil {
+		plans, _, _, err := c.client.Database.ListPlans(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		c.databases = map[string]float64{}
+		for i := range plans {
+			c.databases[plans[i].ID] = float64(plans[i].MonthlyCost)
+		}
+	}
+
+	return lookup(c.databases, plan)
+}
+
+// Tier returns the object storage tier with id, or the default tier when id
+// is 0
+func (c *Catalog) Tier(ctx context.Context, id int) (*govultr.ObjectStorageTier, error) {
+	if c.tiers == nil {
+		tiers, _, err := c.client.ObjectStorage.ListTiers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.tiers = tiers
+	}
+
+	for i := range c.tiers {
+		if (id == 0 && c.tiers[i].Default == "yes") || (id != 0 && c.tiers[i].ID == id) {
+			return &c.tiers[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: tier %d", ErrUnknownPlan, id)
+}
+
+func lookup(prices map[string]float64, plan string) (float64, error) {
+	price, ok := prices[plan]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownPlan, plan)
+	}
+
+	return price, nil
+}
@@ -0,0 +1,77 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Comparison puts an estimate next to the account's pending charges
+type Comparison struct {
+	Estimate *Estimate
+
+	// Charges of the month so far, and their total
+	Charges []govultr.InvoiceItem
+	Pending float64
+
+	// Hours left in the month when the comparison was made, at most
+	// HoursPerMonth
+	RemainingHours float64
+
+	// What the deployment would add to this month's bill if it were created
+	// now
+	ThisMonth float64
+
+	// Pending charges plus ThisMonth. Resources that already exist keep
+	// adding to the bill, so the month will end higher.
+	Projected float64
+
+	// Monthly cost of the deployment relative to the pending charges, e.g.
+	// 0.25 when it's a quarter of them. 0 when nothing is pending.
+	Ratio float64
+}
+
+// String summarizes the comparison
+func (c *Comparison) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "pending charges:  %.2f\n", c.Pending)
+	fmt.Fprintf(&b, "estimate:         %.2f/month, %.4f/hour\n", c.Estimate.Monthly, c.Estimate.Hourly)
+	fmt.Fprintf(&b, "this month:       %.2f over %.0f hours\n", c.ThisMonth, c.RemainingHours)
+	fmt.Fprintf(&b, "projected:        %.2f\n", c.Projected)
+
+	return b.String()
+}
+
+// Compare reads the pending charges of the account and compares the estimate
+// with them
+func (e *Estimator) Compare(ctx context.Context, estimate *Estimate) (*Comparison, error) {
+	charges, _, err := e.client.Billing.ListPendingCharges(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return compare(estimate, charges, time.Now()), nil
+}
+
+func compare(estimate *Estimate, charges []govultr.InvoiceItem, now time.Time) *Comparison {
+	c := &Comparison{Estimate: estimate, Charges: charges}
+	for i := range charges {
+		c.Pending += float64(charges[i].Total)
+	}
+
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	c.RemainingHours = min(next.Sub(now).Hours(), HoursPerMonth)
+
+	c.ThisMonth = estimate.Hourly * c.RemainingHours
+	c.Projected = c.Pending + c.ThisMonth
+	if c.Pending > 0 {
+		c.Ratio = estimate.Monthly / c.Pending
+	}
+
+	return c
+}
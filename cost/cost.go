@@ -0,0 +1,297 @@
+// Package cost estimates what a proposed deployment will cost before it is
+// created.
+//
+// A Deployment holds the create requests of the resources to estimate.
+// Estimate looks up the prices of their plans on the API and returns the
+// hourly and monthly cost of each resource and of the whole deployment.
+// Compare puts an estimate next to the account's pending charges for the
+// month.
+//
+//	e := cost.New(client)
+//
+//	estimate, err := e.Estimate(ctx, &cost.Deployment{
+//		Instances: []govultr.InstanceCreateReq{{Label: "web", Plan: "vc2-1c-1gb", Region: "ewr", OsID: 2284}},
+//		BlockStorage: []govultr.BlockStorageCreate{{Label: "data", SizeGB: 100, Region: "ewr"}},
+//	})
+//	fmt.Print(estimate)
+//
+// Prices are list prices in USD. Plans, bare metal plans, database plans and
+// object storage tiers come from the API. Block storage, file system storage
+// and load balancers have no price endpoint and use Prices, which can be
+// changed with WithPrices.
+package cost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// HoursPerMonth is the number of hours after which Vultr stops billing a
+// resource for the month, so the hourly price is the monthly one divided by it
+const HoursPerMonth = 672
+
+// ErrUnknownPlan is returned when a resource's plan, block type or tier
+// doesn't exist
+var ErrUnknownPlan = errors.New("unknown plan")
+
+// Prices are the monthly prices of the resources without a price endpoint
+type Prices struct {
+	// Per GB of block storage by type. An empty block type is high_perf.
+	BlockStorageGB map[govultr.BlockType]float64
+
+	// Per GB of virtual file system storage
+	VirtualFileSystemStorageGB float64
+
+	// Per load balancer node
+	LoadBalancerNode float64
+}
+
+// DefaultPrices returns the list prices of block storage, file system
+// storage and load balancers
+func DefaultPrices() Prices {
+	return Prices{
+		BlockStorageGB: map[govultr.BlockType]float64{
+			govultr.BlockTypeHighPerf:   0.10,
+			govultr.BlockTypeStorageOpt: 0.025,
+		},
+		VirtualFileSystemStorageGB: 0.10,
+		LoadBalancerNode:           10,
+	}
+}
+
+// Deployment is a set of resources to estimate, as the requests that would
+// create them
+type Deployment struct {
+	Instances                []govultr.InstanceCreateReq
+	BareMetalServers         []govultr.BareMetalCreate
+	BlockStorage             []govultr.BlockStorageCreate
+	Databases                []govultr.DatabaseCreateReq
+	NodePools                []govultr.NodePoolReq
+	VirtualFileSystemStorage []govultr.VirtualFileSystemStorageReq
+	LoadBalancers            []govultr.LoadBalancerReq
+	ObjectStorage            []govultr.ObjectStorageReq
+}
+
+// Item is the cost of a resource of an estimate
+type Item struct {
+	// Kind of resource, e.g. "instance" or "block storage"
+	Kind  string
+	Label string
+
+	// Plan, block type or tier the price is for
+	Plan string
+
+	// Nodes, servers or GB the unit price is multiplied by
+	Quantity int
+	Unit     string
+
+	// Monthly price of one unit
+	UnitPrice float64
+
+	Monthly float64
+	Hourly  float64
+}
+
+// Estimate is the cost of a deployment with a breakdown per resource
+type Estimate struct {
+	Items   []Item
+	Monthly float64
+	Hourly  float64
+}
+
+// String returns the breakdown of the estimate as a table
+func (e *Estimate) String() string {
+	var b strings.Builder
+
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', tabwriter.AlignRight) //nolint:mnd
+	fmt.Fprintln(tw, "KIND\tLABEL\tPLAN\tQUANTITY\tHOURLY\tMONTHLY\t")
+	for _, item := range e.Items {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d %s\t%.4f\t%.2f\t\n",
+			item.Kind, item.Label, item.Plan, item.Quantity, item.Unit, item.Hourly, item.Monthly)
+	}
+	fmt.Fprintf(tw, "total\t\t\t\t%.4f\t%.2f\t\n", e.Hourly, e.Monthly)
+	_ = tw.Flush()
+
+	return b.String()
+}
+
+// add adds an item to the estimate, pricing it from the unit price
+func (e *Estimate) add(item Item) {
+	item.Monthly = item.UnitPrice * float64(item.Quantity)
+	item.Hourly = item.Monthly / HoursPerMonth
+
+	e.Items = append(e.Items, item)
+	e.Monthly += item.Monthly
+	e.Hourly += item.Hourly
+}
+
+// Estimator prices deployments
+type Estimator struct {
+	client *govultr.Client
+	prices Prices
+}
+
+// Option configures an Estimator
+type Option func(*Estimator)
+
+// WithPrices replaces the prices of the resources without a price endpoint
+func WithPrices(prices Prices) Option {
+	return func(e *Estimator) {
+		e.prices = prices
+	}
+}
+
+// New returns an Estimator that looks prices up with client's services
+func New(client *govultr.Client, opts ...Option) *Estimator {
+	e := &Estimator{client: client, prices: DefaultPrices()}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// Estimate returns the cost of the deployment. Only the price lists the
+// deployment needs are read.
+func (e *Estimator) Estimate(ctx context.Context, d *Deployment) (*Estimate, error) {
+	c := NewCatalog(e.client)
+	estimate := &Estimate{}
+
+	for _, price := range []func(context.Context, *Catalog, *Deployment, *Estimate) error{
+		e.instances,
+		e.bareMetalServers,
+		e.databases,
+		e.nodePools,
+		e.objectStorage,
+	} {
+		if err := price(ctx, c, d, estimate); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.storage(d, estimate); err != nil {
+		return nil, err
+	}
+
+	for i := range d.LoadBalancers {
+		lb := &d.LoadBalancers[i]
+		estimate.add(Item{
+			Kind: "load balancer", Label: lb.Label, Plan: "load balancer",
+			Quantity: max(lb.Nodes, 1), Unit: "nodes", UnitPrice: e.prices.LoadBalancerNode,
+		})
+	}
+
+	return estimate, nil
+}
+
+func (e *Estimator) instances(ctx context.Context, c *Catalog, d *Deployment, estimate *Estimate) error {
+	for i := range d.Instances {
+		req := &d.Instances[i]
+		price, err := c.PlanPrice(ctx, req.Plan)
+		if err != nil {
+			return fmt.Errorf("instance %q: %w", req.Label, err)
+		}
+
+		estimate.add(Item{Kind: "instance", Label: req.Label, Plan: req.Plan, Quantity: 1, Unit: "servers", UnitPrice: price})
+	}
+
+	return nil
+}
+
+func (e *Estimator) bareMetalServers(ctx context.Context, c *Catalog, d *Deployment, estimate *Estimate) error {
+	for i := range d.BareMetalServers {
+		req := &d.BareMetalServers[i]
+		price, err := c.BareMetalPrice(ctx, req.Plan)
+		if err != nil {
+			return fmt.Errorf("bare metal server %q: %w", req.Label, err)
+		}
+
+		estimate.add(Item{Kind: "bare metal", Label: req.Label, Plan: req.Plan, Quantity: 1, Unit: "servers", UnitPrice: price})
+	}
+
+	return nil
+}
+
+func (e *Estimator) databases(ctx context.Context, c *Catalog, d *Deployment, estimate *Estimate) error {
+	for i := range d.Databases {
+		req := &d.Databases[i]
+		price, err := c.DatabasePrice(ctx, req.Plan)
+		if err != nil {
+			return fmt.Errorf("database %q: %w", req.Label, err)
+		}
+
+		estimate.add(Item{Kind: "database", Label: req.Label, Plan: req.Plan, Quantity: 1, Unit: "clusters", UnitPrice: price})
+	}
+
+	return nil
+}
+
+// nodePools prices the nodes of each pool at its initial quantity, an auto
+// scaler may add more
+func (e *Estimator) nodePools(ctx context.Context, c *Catalog, d *Deployment, estimate *Estimate) error {
+	for i := range d.NodePools {
+		req := &d.NodePools[i]
+		price, err := c.PlanPrice(ctx, req.Plan)
+		if err != nil {
+			return fmt.Errorf("node pool %q: %w", req.Label, err)
+		}
+
+		estimate.add(Item{Kind: "node pool", Label: req.Label, Plan: req.Plan, Quantity: req.NodeQuantity, Unit: "nodes", UnitPrice: price})
+	}
+
+	return nil
+}
+
+// objectStorage prices each subscription at its tier, the default tier when
+// none is set. Bandwidth and storage beyond the tier's allowance aren't
+// included.
+func (e *Estimator) objectStorage(ctx context.Context, c *Catalog, d *Deployment, estimate *Estimate) error {
+	for i := range d.ObjectStorage {
+		req := &d.ObjectStorage[i]
+		tier, err := c.Tier(ctx, req.TierID)
+		if err != nil {
+			return fmt.Errorf("object storage %q: %w", req.Label, err)
+		}
+
+		estimate.add(Item{
+			Kind: "object storage", Label: req.Label, Plan: tier.Slug,
+			Quantity: 1, Unit: "subscriptions", UnitPrice: float64(tier.Price),
+		})
+	}
+
+	return nil
+}
+
+// storage prices block and file system storage per GB
+func (e *Estimator) storage(d *Deployment, estimate *Estimate) error {
+	for i := range d.BlockStorage {
+		req := &d.BlockStorage[i]
+
+		blockType := req.BlockType
+		if blockType == "" {
+			blockType = govultr.BlockTypeHighPerf
+		}
+
+		price, ok := e.prices.BlockStorageGB[blockType]
+		if !ok {
+			return fmt.Errorf("block storage %q: %w %q", req.Label, ErrUnknownPlan, blockType)
+		}
+
+		estimate.add(Item{Kind: "block storage", Label: req.Label, Plan: string(blockType), Quantity: req.SizeGB, Unit: "GB", UnitPrice: price})
+	}
+
+	for i := range d.VirtualFileSystemStorage {
+		req := &d.VirtualFileSystemStorage[i]
+		estimate.add(Item{
+			Kind: "file system", Label: req.Label, Plan: "vfs",
+			Quantity: req.StorageSize.SizeGB, Unit: "GB", UnitPrice: e.prices.VirtualFileSystemStorageGB,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/govultrmock"
+)
+
+func testMock() *govultrmock.MockClient {
+	mock := govultrmock.NewMockClient()
+
+	mock.Plan.ListFunc = func(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error) {
+		if options.Cursor == "" {
+			return []govultr.Plan{{ID: "vc2-1c-1gb", MonthlyCost: 5}}, &govultr.Meta{Links: &govultr.Links{Next: "next"}}, nil, nil
+		}
+		return []govultr.Plan{{ID: "vc2-2c-4gb", MonthlyCost: 20}}, &govultr.Meta{Links: &govultr.Links{}}, nil, nil
+	}
+	mock.Plan.ListBareMetalFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *http.Response, error) {
+		return []govultr.BareMetalPlan{{ID: "vbm-4c-32gb", MonthlyCost: 120}}, &govultr.Meta{Links: &govultr.Links{}}, nil, nil
+	}
+	mock.Database.ListPlansFunc = func(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *http.Response, error) {
+		return []govultr.DatabasePlan{{ID: "vultr-dbaas-startup-cc-1-55-2", MonthlyCost: 30}}, nil, nil, nil
+	}
+	mock.ObjectStorage.ListTiersFunc = func(ctx context.Context) ([]govultr.ObjectStorageTier, *http.Response, error) {
+		return []govultr.ObjectStorageTier{
+			{ID: 1, Slug: "tier_010k_5000m", Price: 18, Default: "yes"},
+			{ID: 2, Slug: "tier_015k_6000m", Price: 36},
+		}, nil, nil
+	}
+
+	return mock
+}
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestEstimator_Estimate(t *testing.T) {
+	mock := testMock()
+
+	estimate, err := New(mock.Client).Estimate(context.Background(), &Deployment{
+		Instances:        []govultr.InstanceCreateReq{{Label: "web", Plan: "vc2-1c-1gb"}, {Label: "app", Plan: "vc2-2c-4gb"}},
+		BareMetalServers: []govultr.BareMetalCreate{{Label: "metal", Plan: "vbm-4c-32gb"}},
+		BlockStorage: []govultr.BlockStorageCreate{
+			{Label: "fast", SizeGB: 100},
+			{Label: "slow", SizeGB: 1000, BlockType: govultr.BlockTypeStorageOpt},
+		},
+		Databases:                []govultr.DatabaseCreateReq{{Label: "db", Plan: "vultr-dbaas-startup-cc-1-55-2"}},
+		NodePools:                []govultr.NodePoolReq{{Label: "pool", Plan: "vc2-2c-4gb", NodeQuantity: 3}},
+		VirtualFileSystemStorage: []govultr.VirtualFileSystemStorageReq{{Label: "vfs", StorageSize: govultr.VirtualFileSystemStorageSize{SizeGB: 40}}},
+		LoadBalancers:            []govultr.LoadBalancerReq{{Label: "lb"}, {Label: "lb-ha", Nodes: 3}},
+		ObjectStorage:            []govultr.ObjectStorageReq{{Label: "default"}, {Label: "big", TierID: 2}},
+	})
+	if err != nil {
+		t.Fatalf("Estimate returned %+v", err)
+	}
+
+	expected := map[string]float64{
+		"web": 5, "app": 20, "metal": 120, "fast": 10, "slow": 25, "db": 30,
+		"pool": 60, "vfs": 4, "lb": 10, "lb-ha": 30, "default": 18, "big": 36,
+	}
+	if len(estimate.Items) != len(expected) {
+		t.Fatalf("Estimate returned %d items, expected %d", len(estimate.Items), len(expected))
+	}
+
+	var total float64
+	for _, item := range estimate.Items {
+		if !approx(item.Monthly, expected[item.Label]) {
+			t.Errorf("Estimate returned %+v, expected %.2f a month", item, expected[item.Label])
+		}
+		if !approx(item.Hourly, item.Monthly/HoursPerMonth) {
+			t.Errorf("Estimate returned %+v, expected the hourly price capped at %d hours", item, HoursPerMonth)
+		}
+		total += expected[item.Label]
+	}
+	if !approx(estimate.Monthly, total) || !approx(estimate.Hourly, total/HoursPerMonth) {
+		t.Errorf("Estimate returned %.2f a month and %.4f an hour, expected %.2f", estimate.Monthly, estimate.Hourly, total)
+	}
+
+	if n := len(mock.Plan.CallsTo("List")); n != 2 {
+		t.Errorf("Estimate listed plans %d times, expected every page once", n)
+	}
+
+	table := estimate.String()
+	if !strings.Contains(table, "node pool") || !strings.Contains(table, "3 nodes") || !strings.Contains(table, "total") {
+		t.Errorf("String returned\n%s", table)
+	}
+}
+
+func TestEstimator_EstimateLists(t *testing.T) {
+	mock := testMock()
+
+	if _, err := New(mock.Client).Estimate(context.Background(), &Deployment{
+		BlockStorage: []govultr.BlockStorageCreate{{Label: "fast", SizeGB: 100}},
+	}); err != nil {
+		t.Fatalf("Estimate returned %+v", err)
+	}
+
+	if n := len(mock.Plan.Calls()) + len(mock.Database.Calls()) + len(mock.ObjectStorage.Calls()); n != 0 {
+		t.Errorf("Estimate made %d calls, expected none for block storage", n)
+	}
+}
+
+func TestEstimator_EstimateErrors(t *testing.T) {
+	mock := testMock()
+	e := New(mock.Client, WithPrices(Prices{BlockStorageGB: map[govultr.BlockType]float64{govultr.BlockTypeStorageOpt: 0.02}}))
+
+	tests := map[string]*Deployment{
+		"plan":       {Instances: []govultr.InstanceCreateReq{{Label: "web", Plan: "nope"}}},
+		"bare metal": {BareMetalServers: []govultr.BareMetalCreate{{Label: "metal", Plan: "vc2-1c-1gb"}}},
+		"database":   {Databases: []govultr.DatabaseCreateReq{{Label: "db", Plan: "nope"}}},
+		"tier":       {ObjectStorage: []govultr.ObjectStorageReq{{Label: "obj", TierID: 9}}},
+		"block type": {BlockStorage: []govultr.BlockStorageCreate{{Label: "fast", SizeGB: 100}}},
+	}
+
+	for name, d := range tests {
+		if _, err := e.Estimate(context.Background(), d); !errors.Is(err, ErrUnknownPlan) {
+			t.Errorf("Estimate of an unknown %s returned %+v, expected %+v", name, err, ErrUnknownPlan)
+		}
+	}
+
+	failing := errors.New("list failed")
+	mock.Plan.ListFunc = func(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error) {
+		return nil, nil, nil, failing
+	}
+	if _, err := e.Estimate(context.Background(), tests["plan"]); !errors.Is(err, failing) {
+		t.Errorf("Estimate returned %+v, expected %+v", err, failing)
+	}
+}
+
+func TestEstimator_Compare(t *testing.T) {
+	mock := testMock()
+	mock.Billing.ListPendingChargesFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error) {
+		return []govultr.InvoiceItem{{Description: "web", Total: 2.5}, {Description: "db", Total: 7.5}}, nil, nil
+	}
+
+	estimate := &Estimate{Monthly: 67.2, Hourly: 0.1}
+	c, err := New(mock.Client).Compare(context.Background(), estimate)
+	if err != nil {
+		t.Fatalf("Compare returned %+v", err)
+	}
+
+	if len(c.Charges) != 2 || !approx(c.Pending, 10) || !approx(c.Ratio, 6.72) {
+		t.Errorf("Compare returned %+v, expected 10 pending", c)
+	}
+	if c.RemainingHours <= 0 || c.RemainingHours > HoursPerMonth || !approx(c.Projected, c.Pending+c.ThisMonth) {
+		t.Errorf("Compare returned %+v", c)
+	}
+
+	failing := errors.New("billing failed")
+	mock.Billing.ListPendingChargesFunc = func(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error) {
+		return nil, nil, failing
+	}
+	if _, err := New(mock.Client).Compare(context.Background(), estimate); !errors.Is(err, failing) {
+		t.Errorf("Compare returned %+v, expected %+v", err, failing)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	estimate := &Estimate{Monthly: 67.2, Hourly: 0.1}
+
+	// 24 hours before the end of a 30 day month
+	c := compare(estimate, nil, time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC))
+	if !approx(c.RemainingHours, 24) || !approx(c.ThisMonth, 2.4) || !approx(c.Projected, 2.4) || c.Ratio != 0 {
+		t.Errorf("compare returned %+v, expected 24 hours left", c)
+	}
+
+	// More hours left than billed in a month
+	c = compare(estimate, []govultr.InvoiceItem{{Total: 1}}, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC))
+	if !approx(c.RemainingHours, HoursPerMonth) || !approx(c.ThisMonth, 67.2) || !approx(c.Projected, 68.2) {
+		t.Errorf("compare returned %+v, expected the month capped at %d hours", c, HoursPerMonth)
+	}
+
+	if s := c.String(); !strings.Contains(s, "projected:        68.20") {
+		t.Errorf("String returned\n%s", s)
+	}
+}
@@ -151,7 +151,7 @@ type DBListOptions struct {
 // Database represents a Managed Database subscription
 type Database struct {
 	ID                     string               `json:"id"`
-	DateCreated            string               `json:"date_created"`
+	DateCreated            Timestamp            `json:"date_created"`
 	Plan                   string               `json:"plan"`
 	PlanDisk               int                  `json:"plan_disk"`
 	PlanRAM                int                  `json:"plan_ram"`
@@ -159,7 +159,7 @@ type Database struct {
 	PlanReplicas           *int                 `json:"plan_replicas,omitempty"`
 	PlanBrokers            int                  `json:"plan_brokers,omitempty"`
 	Region                 string               `json:"region"`
-	DatabaseEngine         string               `json:"database_engine"`
+	DatabaseEngine         DatabaseEngine       `json:"database_engine"`
 	DatabaseEngineVersion  string               `json:"database_engine_version"`
 	VPCID                  string               `json:"vpc_id"`
 	Status                 string               `json:"status"`
@@ -185,7 +185,7 @@ type Database struct {
 	MaintenanceTime        string               `json:"maintenance_time"`
 	BackupHour             *string              `json:"backup_hour,omitempty"`
 	BackupMinute           *string              `json:"backup_minute,omitempty"`
-	LatestBackup           string               `json:"latest_backup"`
+	LatestBackup           Timestamp            `json:"latest_backup"`
 	TrustedIPs             []string             `json:"trusted_ips"`
 	CACertificate          string               `json:"ca_certificate"`
 	MySQLSQLModes          []string             `json:"mysql_sql_modes,omitempty"`
@@ -227,26 +227,26 @@ type databaseBase struct {
 
 // DatabaseCreateReq struct used to create a database
 type DatabaseCreateReq struct {
-	DatabaseEngine         string   `json:"database_engine,omitempty"`
-	DatabaseEngineVersion  string   `json:"database_engine_version,omitempty"`
-	Region                 string   `json:"region,omitempty"`
-	Plan                   string   `json:"plan,omitempty"`
-	Label                  string   `json:"label,omitempty"`
-	Tag                    string   `json:"tag,omitempty"`
-	VPCID                  string   `json:"vpc_id,omitempty"`
-	MaintenanceDOW         string   `json:"maintenance_dow,omitempty"`
-	MaintenanceTime        string   `json:"maintenance_time,omitempty"`
-	BackupHour             *string  `json:"backup_hour,omitempty"`
-	BackupMinute           *string  `json:"backup_minute,omitempty"`
-	TrustedIPs             []string `json:"trusted_ips,omitempty"`
-	MySQLSQLModes          []string `json:"mysql_sql_modes,omitempty"`
-	MySQLRequirePrimaryKey *bool    `json:"mysql_require_primary_key,omitempty"`
-	MySQLSlowQueryLog      *bool    `json:"mysql_slow_query_log,omitempty"`
-	MySQLLongQueryTime     int      `json:"mysql_long_query_time,omitempty"`
-	EvictionPolicy         string   `json:"eviction_policy,omitempty"`
-	EnableKafkaREST        *bool    `json:"enable_kafka_rest,omitempty"`
-	EnableSchemaRegistry   *bool    `json:"enable_schema_registry,omitempty"`
-	EnableKafkaConnect     *bool    `json:"enable_kafka_connect,omitempty"`
+	DatabaseEngine         DatabaseEngine `json:"database_engine,omitempty"`
+	DatabaseEngineVersion  string         `json:"database_engine_version,omitempty"`
+	Region                 string         `json:"region,omitempty"`
+	Plan                   string         `json:"plan,omitempty"`
+	Label                  string         `json:"label,omitempty"`
+	Tag                    string         `json:"tag,omitempty"`
+	VPCID                  string         `json:"vpc_id,omitempty"`
+	MaintenanceDOW         string         `json:"maintenance_dow,omitempty"`
+	MaintenanceTime        string         `json:"maintenance_time,omitempty"`
+	BackupHour             *string        `json:"backup_hour,omitempty"`
+	BackupMinute           *string        `json:"backup_minute,omitempty"`
+	TrustedIPs             []string       `json:"trusted_ips,omitempty"`
+	MySQLSQLModes          []string       `json:"mysql_sql_modes,omitempty"`
+	MySQLRequirePrimaryKey *bool          `json:"mysql_require_primary_key,omitempty"`
+	MySQLSlowQueryLog      *bool          `json:"mysql_slow_query_log,omitempty"`
+	MySQLLongQueryTime     int            `json:"mysql_long_query_time,omitempty"`
+	EvictionPolicy         string         `json:"eviction_policy,omitempty"`
+	EnableKafkaREST        *bool          `json:"enable_kafka_rest,omitempty"`
+	EnableSchemaRegistry   *bool          `json:"enable_schema_registry,omitempty"`
+	EnableKafkaConnect     *bool          `json:"enable_kafka_connect,omitempty"`
 }
 
 // DatabaseUpdateReq struct used to update a database
@@ -540,13 +540,13 @@ type databaseMessage struct {
 
 // DatabaseAlert represents a service alert for a Managed Database cluster
 type DatabaseAlert struct {
-	Timestamp            string `json:"timestamp"`
-	MessageType          string `json:"message_type"`
-	Description          string `json:"description"`
-	Recommendation       string `json:"recommendation,omitempty"`
-	MaintenanceScheduled string `json:"maintenance_scheduled,omitempty"`
-	ResourceType         string `json:"resource_type,omitempty"`
-	TableCount           int    `json:"table_count,omitempty"`
+	Timestamp            Timestamp `json:"timestamp"`
+	MessageType          string    `json:"message_type"`
+	Description          string    `json:"description"`
+	Recommendation       string    `json:"recommendation,omitempty"`
+	MaintenanceScheduled string    `json:"maintenance_scheduled,omitempty"`
+	ResourceType         string    `json:"resource_type,omitempty"`
+	TableCount           int       `json:"table_count,omitempty"`
 }
 
 // databaseAlertsBase holds the API response for querying service alerts within a Managed Database
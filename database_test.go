@@ -1,7 +1,6 @@
 package govultr
 
 import (
-	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -11,36 +10,76 @@ func TestDatabaseServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/databases", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
-			"databases": [
+	mux.HandleFunc("/v2/databases", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"databases": [
+		{
+			"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
+			"date_created": "2022-05-09 10:13:31",
+			"plan": "vultr-dbaas-business-cc-2-80-4",
+			"plan_disk": 80,
+			"plan_ram": 4096,
+			"plan_vcpus": 2,
+			"plan_replicas": 1,
+			"region": "EWR",
+			"database_engine": "mysql",
+			"database_engine_version": "8",
+			"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
+			"status": "Running",
+			"label": "testy-mc-testerton-the-8th",
+			"tag": "bing bong",
+			"pending_charges": 12.15,
+			"dbname": "defaultdb",
+			"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
+			"public_host": "public-vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-pr.vultrdb.com",
+			"user": "vultradmin",
+			"password": "AVNS_8E9hjx1LdsiA5EZ",
+			"port": "16751",
+			"maintenance_dow": "sunday",
+			"maintenance_time": "02:00:00",
+			"backup_hour": "23",
+			"backup_minute": "54",
+			"latest_backup": "2023-03-13 00:59:07",
+			"trusted_ips": [],
+			"mysql_sql_modes": [
+				"ANSI",
+				"ERROR_FOR_DIVISION_BY_ZERO",
+				"NO_ENGINE_SUBSTITUTION",
+				"NO_ZERO_DATE",
+				"NO_ZERO_IN_DATE",
+				"STRICT_ALL_TABLES"
+			],
+			"mysql_require_primary_key": true,
+			"mysql_slow_query_log": false,
+			"cluster_time_zone": "America/New_York",
+			"read_replicas": [
 				{
-					"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-					"date_created": "2022-05-09 10:13:31",
-					"plan": "vultr-dbaas-business-cc-2-80-4",
+					"id": "daeb6d62-a6a2-458c-9f74-e053735d7f50",
+					"date_created": "2022-05-09 10:12:43",
+					"plan": "vultr-dbaas-startup-cc-2-80-4",
 					"plan_disk": 80,
 					"plan_ram": 4096,
 					"plan_vcpus": 2,
-					"plan_replicas": 1,
+					"plan_replicas": 0,
 					"region": "EWR",
 					"database_engine": "mysql",
 					"database_engine_version": "8",
 					"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
 					"status": "Running",
-					"label": "testy-mc-testerton-the-8th",
-					"tag": "bing bong",
+					"label": "testy-mc-testerton-the-7th",
+					"tag": "",
 					"pending_charges": 12.15,
 					"dbname": "defaultdb",
-					"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
-					"public_host": "public-vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-pr.vultrdb.com",
+					"host": "vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-prod-85e0.vultrdb.com",
+					"public_host": "public-vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-pr.vultrdb.com",
 					"user": "vultradmin",
-					"password": "AVNS_8E9hjx1LdsiA5EZ",
+					"password": "AVNS_UBen_MjqAwDd2BWFc-Y",
 					"port": "16751",
 					"maintenance_dow": "sunday",
 					"maintenance_time": "02:00:00",
 					"backup_hour": "23",
 					"backup_minute": "54",
-					"latest_backup": "2023-03-13 00:59:07",
+					"latest_backup": "2023-03-12 22:07:06",
 					"trusted_ips": [],
 					"mysql_sql_modes": [
 						"ANSI",
@@ -52,57 +91,15 @@ func TestDatabaseServiceHandler_List(t *testing.T) {
 					],
 					"mysql_require_primary_key": true,
 					"mysql_slow_query_log": false,
-					"cluster_time_zone": "America/New_York",
-					"read_replicas": [
-						{
-							"id": "daeb6d62-a6a2-458c-9f74-e053735d7f50",
-							"date_created": "2022-05-09 10:12:43",
-							"plan": "vultr-dbaas-startup-cc-2-80-4",
-							"plan_disk": 80,
-							"plan_ram": 4096,
-							"plan_vcpus": 2,
-							"plan_replicas": 0,
-							"region": "EWR",
-							"database_engine": "mysql",
-							"database_engine_version": "8",
-							"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
-							"status": "Running",
-							"label": "testy-mc-testerton-the-7th",
-							"tag": "",
-							"pending_charges": 12.15,
-							"dbname": "defaultdb",
-							"host": "vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-prod-85e0.vultrdb.com",
-							"public_host": "public-vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-pr.vultrdb.com",
-							"user": "vultradmin",
-							"password": "AVNS_UBen_MjqAwDd2BWFc-Y",
-							"port": "16751",
-							"maintenance_dow": "sunday",
-							"maintenance_time": "02:00:00",
-							"backup_hour": "23",
-							"backup_minute": "54",
-							"latest_backup": "2023-03-12 22:07:06",
-							"trusted_ips": [],
-							"mysql_sql_modes": [
-								"ANSI",
-								"ERROR_FOR_DIVISION_BY_ZERO",
-								"NO_ENGINE_SUBSTITUTION",
-								"NO_ZERO_DATE",
-								"NO_ZERO_IN_DATE",
-								"STRICT_ALL_TABLES"
-							],
-							"mysql_require_primary_key": true,
-							"mysql_slow_query_log": false,
-							"cluster_time_zone": "America/New_York"
-						}
-					]
+					"cluster_time_zone": "America/New_York"
 				}
-			],
-			"meta": {
-				"total": 1
-			}
-		}`
-		fmt.Fprint(writer, response)
-	})
+			]
+		}
+	],
+	"meta": {
+		"total": 1
+	}
+}`))
 
 	database, meta, _, err := client.Database.List(ctx, nil)
 	if err != nil {
@@ -208,50 +205,48 @@ func TestDatabaseServiceHandler_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/databases", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
-			"database": {
-				"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-				"date_created": "2022-05-09 10:13:31",
-				"plan": "vultr-dbaas-business-cc-2-80-4",
-				"plan_disk": 80,
-				"plan_ram": 4096,
-				"plan_vcpus": 2,
-				"plan_replicas": 1,
-				"region": "EWR",
-				"database_engine": "mysql",
-				"database_engine_version": "8",
-				"vpc_id": "",
-				"status": "Running",
-				"label": "testy-mc-testerton-the-8th",
-				"tag": "",
-				"dbname": "defaultdb",
-				"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
-				"user": "vultradmin",
-				"password": "AVNS_8E9hjx1LdsiA5EZ",
-				"port": "16751",
-				"maintenance_dow": "sunday",
-				"maintenance_time": "02:00:00",
-				"backup_hour": "23",
-				"backup_minute": "54",
-				"latest_backup": "2023-03-13 00:59:07",
-				"trusted_ips": [],
-				"mysql_sql_modes": [
-					"ANSI",
-					"ERROR_FOR_DIVISION_BY_ZERO",
-					"NO_ENGINE_SUBSTITUTION",
-					"NO_ZERO_DATE",
-					"NO_ZERO_IN_DATE",
-					"STRICT_ALL_TABLES"
-				],
-				"mysql_require_primary_key": true,
-				"mysql_slow_query_log": false,
-				"cluster_time_zone": "America/New_York",
-				"read_replicas": []
-			}
-		}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/databases", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"database": {
+		"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
+		"date_created": "2022-05-09 10:13:31",
+		"plan": "vultr-dbaas-business-cc-2-80-4",
+		"plan_disk": 80,
+		"plan_ram": 4096,
+		"plan_vcpus": 2,
+		"plan_replicas": 1,
+		"region": "EWR",
+		"database_engine": "mysql",
+		"database_engine_version": "8",
+		"vpc_id": "",
+		"status": "Running",
+		"label": "testy-mc-testerton-the-8th",
+		"tag": "",
+		"dbname": "defaultdb",
+		"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
+		"user": "vultradmin",
+		"password": "AVNS_8E9hjx1LdsiA5EZ",
+		"port": "16751",
+		"maintenance_dow": "sunday",
+		"maintenance_time": "02:00:00",
+		"backup_hour": "23",
+		"backup_minute": "54",
+		"latest_backup": "2023-03-13 00:59:07",
+		"trusted_ips": [],
+		"mysql_sql_modes": [
+			"ANSI",
+			"ERROR_FOR_DIVISION_BY_ZERO",
+			"NO_ENGINE_SUBSTITUTION",
+			"NO_ZERO_DATE",
+			"NO_ZERO_IN_DATE",
+			"STRICT_ALL_TABLES"
+		],
+		"mysql_require_primary_key": true,
+		"mysql_slow_query_log": false,
+		"cluster_time_zone": "America/New_York",
+		"read_replicas": []
+	}
+}`))
 
 	options := &DatabaseCreateReq{
 		DatabaseEngine:        "mysql",
@@ -319,35 +314,75 @@ func TestDatabaseServiceHandler_Get(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
-			"database": {
-				"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-				"date_created": "2022-05-09 10:13:31",
-				"plan": "vultr-dbaas-business-cc-2-80-4",
+	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"database": {
+		"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
+		"date_created": "2022-05-09 10:13:31",
+		"plan": "vultr-dbaas-business-cc-2-80-4",
+		"plan_disk": 80,
+		"plan_ram": 4096,
+		"plan_vcpus": 2,
+		"plan_replicas": 1,
+		"region": "EWR",
+		"database_engine": "mysql",
+		"database_engine_version": "8",
+		"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
+		"status": "Running",
+		"label": "testy-mc-testerton-the-8th",
+		"tag": "bing bong",
+		"pending_charges": 12.15,
+		"dbname": "defaultdb",
+		"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
+		"public_host": "public-vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-pr.vultrdb.com",
+		"user": "vultradmin",
+		"password": "AVNS_8E9hjx1LdsiA5EZ",
+		"port": "16751",
+		"maintenance_dow": "sunday",
+		"maintenance_time": "02:00:00",
+		"backup_hour": "23",
+		"backup_minute": "54",
+		"latest_backup": "2023-03-13 00:59:07",
+		"trusted_ips": [],
+		"mysql_sql_modes": [
+			"ANSI",
+			"ERROR_FOR_DIVISION_BY_ZERO",
+			"NO_ENGINE_SUBSTITUTION",
+			"NO_ZERO_DATE",
+			"NO_ZERO_IN_DATE",
+			"STRICT_ALL_TABLES"
+		],
+		"mysql_require_primary_key": true,
+		"mysql_slow_query_log": false,
+		"cluster_time_zone": "America/New_York",
+		"read_replicas": [
+			{
+				"id": "daeb6d62-a6a2-458c-9f74-e053735d7f50",
+				"date_created": "2022-05-09 10:12:43",
+				"plan": "vultr-dbaas-startup-cc-2-80-4",
 				"plan_disk": 80,
 				"plan_ram": 4096,
 				"plan_vcpus": 2,
-				"plan_replicas": 1,
+				"plan_replicas": 0,
 				"region": "EWR",
 				"database_engine": "mysql",
 				"database_engine_version": "8",
 				"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
 				"status": "Running",
-				"label": "testy-mc-testerton-the-8th",
-				"tag": "bing bong",
+				"label": "testy-mc-testerton-the-7th",
+				"tag": "",
 				"pending_charges": 12.15,
 				"dbname": "defaultdb",
-				"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
-				"public_host": "public-vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-pr.vultrdb.com",
+				"host": "vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-prod-85e0.vultrdb.com",
+				"public_host": "public-vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-pr.vultrdb.com",
 				"user": "vultradmin",
-				"password": "AVNS_8E9hjx1LdsiA5EZ",
+				"password": "AVNS_UBen_MjqAwDd2BWFc-Y",
 				"port": "16751",
 				"maintenance_dow": "sunday",
 				"maintenance_time": "02:00:00",
 				"backup_hour": "23",
 				"backup_minute": "54",
-				"latest_backup": "2023-03-13 00:59:07",
+				"latest_backup": "2023-03-12 22:07:06",
 				"trusted_ips": [],
 				"mysql_sql_modes": [
 					"ANSI",
@@ -359,53 +394,11 @@ func TestDatabaseServiceHandler_Get(t *testing.T) {
 				],
 				"mysql_require_primary_key": true,
 				"mysql_slow_query_log": false,
-				"cluster_time_zone": "America/New_York",
-				"read_replicas": [
-					{
-						"id": "daeb6d62-a6a2-458c-9f74-e053735d7f50",
-						"date_created": "2022-05-09 10:12:43",
-						"plan": "vultr-dbaas-startup-cc-2-80-4",
-						"plan_disk": 80,
-						"plan_ram": 4096,
-						"plan_vcpus": 2,
-						"plan_replicas": 0,
-						"region": "EWR",
-						"database_engine": "mysql",
-						"database_engine_version": "8",
-						"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
-						"status": "Running",
-						"label": "testy-mc-testerton-the-7th",
-						"tag": "",
-						"pending_charges": 12.15,
-						"dbname": "defaultdb",
-						"host": "vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-prod-85e0.vultrdb.com",
-						"public_host": "public-vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-pr.vultrdb.com",
-						"user": "vultradmin",
-						"password": "AVNS_UBen_MjqAwDd2BWFc-Y",
-						"port": "16751",
-						"maintenance_dow": "sunday",
-						"maintenance_time": "02:00:00",
-						"backup_hour": "23",
-						"backup_minute": "54",
-						"latest_backup": "2023-03-12 22:07:06",
-						"trusted_ips": [],
-						"mysql_sql_modes": [
-							"ANSI",
-							"ERROR_FOR_DIVISION_BY_ZERO",
-							"NO_ENGINE_SUBSTITUTION",
-							"NO_ZERO_DATE",
-							"NO_ZERO_IN_DATE",
-							"STRICT_ALL_TABLES"
-						],
-						"mysql_require_primary_key": true,
-						"mysql_slow_query_log": false,
-						"cluster_time_zone": "America/New_York"
-					}
-				]
+				"cluster_time_zone": "America/New_York"
 			}
-		}`
-		fmt.Fprint(writer, response)
-	})
+		]
+	}
+}`))
 
 	database, _, err := client.Database.Get(ctx, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5")
 	if err != nil {
@@ -501,35 +494,76 @@ func TestDatabaseServiceHandler_Update(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
-			"database": {
-				"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-				"date_created": "2022-05-09 10:13:31",
-				"plan": "vultr-dbaas-business-cc-2-80-4",
+	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"database": {
+		"id": "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
+		"date_created": "2022-05-09 10:13:31",
+		"plan": "vultr-dbaas-business-cc-2-80-4",
+		"plan_disk": 80,
+		"plan_ram": 4096,
+		"plan_vcpus": 2,
+		"plan_replicas": 1,
+		"region": "EWR",
+		"database_engine": "mysql",
+		"database_engine_version": "8",
+		"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
+		"status": "Running",
+		"label": "testy-mc-testerton-the-8th-part-2",
+		"tag": "bing bong updated",
+		"pending_charges": 12.15,
+		"dbname": "defaultdb",
+		"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
+		"public_host": "public-vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-pr.vultrdb.com",
+		"user": "vultradmin",
+		"password": "AVNS_8E9hjx1LdsiA5EZ",
+		"port": "16751",
+		"maintenance_dow": "sunday",
+		"maintenance_time": "02:00:00",
+		"backup_hour": "23",
+		"backup_minute": "54",
+		"latest_backup": "2023-03-13 00:59:07",
+		"trusted_ips": [],
+		"mysql_sql_modes": [
+			"ANSI",
+			"ERROR_FOR_DIVISION_BY_ZERO",
+			"NO_ENGINE_SUBSTITUTION",
+			"NO_ZERO_DATE",
+			"NO_ZERO_IN_DATE",
+			"STRICT_ALL_TABLES"
+		],
+		"mysql_require_primary_key": true,
+		"mysql_slow_query_log": true,
+		"mysql_long_query_time": 2,
+		"cluster_time_zone": "America/New_York",
+		"read_replicas": [
+			{
+				"id": "daeb6d62-a6a2-458c-9f74-e053735d7f50",
+				"date_created": "2022-05-09 10:12:43",
+				"plan": "vultr-dbaas-startup-cc-2-80-4",
 				"plan_disk": 80,
 				"plan_ram": 4096,
 				"plan_vcpus": 2,
-				"plan_replicas": 1,
+				"plan_replicas": 0,
 				"region": "EWR",
 				"database_engine": "mysql",
 				"database_engine_version": "8",
 				"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
 				"status": "Running",
-				"label": "testy-mc-testerton-the-8th-part-2",
-				"tag": "bing bong updated",
+				"label": "testy-mc-testerton-the-7th",
+				"tag": "",
 				"pending_charges": 12.15,
 				"dbname": "defaultdb",
-				"host": "vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-prod-85e0.vultrdb.com",
-				"public_host": "public-vultr-prod-2db1ff4d-9d78-4baa-b52e-ec2b166107bb-vultr-pr.vultrdb.com",
+				"host": "vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-prod-85e0.vultrdb.com",
+				"public_host": "public-vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-pr.vultrdb.com",
 				"user": "vultradmin",
-				"password": "AVNS_8E9hjx1LdsiA5EZ",
+				"password": "AVNS_UBen_MjqAwDd2BWFc-Y",
 				"port": "16751",
 				"maintenance_dow": "sunday",
 				"maintenance_time": "02:00:00",
 				"backup_hour": "23",
 				"backup_minute": "54",
-				"latest_backup": "2023-03-13 00:59:07",
+				"latest_backup": "2023-03-12 22:07:06",
 				"trusted_ips": [],
 				"mysql_sql_modes": [
 					"ANSI",
@@ -542,54 +576,11 @@ func TestDatabaseServiceHandler_Update(t *testing.T) {
 				"mysql_require_primary_key": true,
 				"mysql_slow_query_log": true,
 				"mysql_long_query_time": 2,
-				"cluster_time_zone": "America/New_York",
-				"read_replicas": [
-					{
-						"id": "daeb6d62-a6a2-458c-9f74-e053735d7f50",
-						"date_created": "2022-05-09 10:12:43",
-						"plan": "vultr-dbaas-startup-cc-2-80-4",
-						"plan_disk": 80,
-						"plan_ram": 4096,
-						"plan_vcpus": 2,
-						"plan_replicas": 0,
-						"region": "EWR",
-						"database_engine": "mysql",
-						"database_engine_version": "8",
-						"vpc_id": "d39bf0bf-e050-47d4-a291-5d6fc736f250",
-						"status": "Running",
-						"label": "testy-mc-testerton-the-7th",
-						"tag": "",
-						"pending_charges": 12.15,
-						"dbname": "defaultdb",
-						"host": "vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-prod-85e0.vultrdb.com",
-						"public_host": "public-vultr-prod-87086a7d-4bc8-47ca-aa88-f88138d82772-vultr-pr.vultrdb.com",
-						"user": "vultradmin",
-						"password": "AVNS_UBen_MjqAwDd2BWFc-Y",
-						"port": "16751",
-						"maintenance_dow": "sunday",
-						"maintenance_time": "02:00:00",
-						"backup_hour": "23",
-						"backup_minute": "54",
-						"latest_backup": "2023-03-12 22:07:06",
-						"trusted_ips": [],
-						"mysql_sql_modes": [
-							"ANSI",
-							"ERROR_FOR_DIVISION_BY_ZERO",
-							"NO_ENGINE_SUBSTITUTION",
-							"NO_ZERO_DATE",
-							"NO_ZERO_IN_DATE",
-							"STRICT_ALL_TABLES"
-						],
-						"mysql_require_primary_key": true,
-						"mysql_slow_query_log": true,
-						"mysql_long_query_time": 2,
-						"cluster_time_zone": "America/New_York"
-					}
-				]
+				"cluster_time_zone": "America/New_York"
 			}
-		}`
-		fmt.Fprint(writer, response)
-	})
+		]
+	}
+}`))
 
 	options := &DatabaseUpdateReq{
 		Label:              "testy-mc-testerton-the-8th-part-2",
@@ -694,9 +685,7 @@ func TestDatabaseServiceHandler_Delete(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	err := client.Database.Delete(ctx, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5")
 
@@ -32,9 +32,9 @@ type DomainServiceHandler struct {
 
 // Domain represents a Domain entry on Vultr
 type Domain struct {
-	Domain      string `json:"domain,omitempty"`
-	DateCreated string `json:"date_created,omitempty"`
-	DNSSec      string `json:"dns_sec,omitempty"`
+	Domain      string    `json:"domain,omitempty"`
+	DateCreated Timestamp `json:"date_created,omitempty"`
+	DNSSec      string    `json:"dns_sec,omitempty"`
 }
 
 // DomainReq is the struct to create a domain
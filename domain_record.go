@@ -25,30 +25,30 @@ type DomainRecordsServiceHandler struct {
 
 // DomainRecord represents a DNS record on Vultr
 type DomainRecord struct {
-	ID       string `json:"id,omitempty"`
-	Type     string `json:"type,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Data     string `json:"data,omitempty"`
-	Priority int    `json:"priority,omitempty"`
-	TTL      int    `json:"ttl,omitempty"`
+	ID       string     `json:"id,omitempty"`
+	Type     RecordType `json:"type,omitempty"`
+	Name     string     `json:"name,omitempty"`
+	Data     string     `json:"data,omitempty"`
+	Priority int        `json:"priority,omitempty"`
+	TTL      int        `json:"ttl,omitempty"`
 }
 
 // DomainRecordCreateReq struct to use for create domain record calls.
 type DomainRecordCreateReq struct {
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	Data     string `json:"data"`
-	TTL      int    `json:"ttl,omitempty"`
-	Priority *int   `json:"priority,omitempty"`
+	Name     string     `json:"name"`
+	Type     RecordType `json:"type"`
+	Data     string     `json:"data"`
+	TTL      int        `json:"ttl,omitempty"`
+	Priority *int       `json:"priority,omitempty"`
 }
 
 // DomainRecordUpdateReq struct to use for update domain record calls.
 type DomainRecordUpdateReq struct {
-	Name     *string `json:"name,omitempty"`
-	Type     string  `json:"type,omitempty"`
-	Data     string  `json:"data,omitempty"`
-	TTL      int     `json:"ttl,omitempty"`
-	Priority *int    `json:"priority,omitempty"`
+	Name     *string    `json:"name,omitempty"`
+	Type     RecordType `json:"type,omitempty"`
+	Data     string     `json:"data,omitempty"`
+	TTL      int        `json:"ttl,omitempty"`
+	Priority *int       `json:"priority,omitempty"`
 }
 
 type domainRecordsBase struct {
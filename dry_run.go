@@ -0,0 +1,174 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Response body returned for planned requests. It decodes without an error,
+// but methods that unwrap the resource from a response envelope are left
+// with a nil resource.
+const dryRunResponseBody = "{}"
+
+// DryRunHeader is set on the responses returned for planned requests
+const DryRunHeader = "X-Govultr-Dry-Run"
+
+// PlannedAction is a request that was captured in dry-run mode instead of
+// being sent
+type PlannedAction struct {
+	// Service method that made the request, e.g. "Instance.Update"
+	Operation string `json:"operation,omitempty"`
+
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+
+	// Request body with secrets redacted, see RedactBody
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// DryRunPlan collects the actions captured while a client is in dry-run
+// mode. It is safe for concurrent use.
+type DryRunPlan struct {
+	mu      sync.Mutex
+	actions []PlannedAction
+}
+
+// NewDryRunPlan returns an empty DryRunPlan
+func NewDryRunPlan() *DryRunPlan {
+	return &DryRunPlan{}
+}
+
+// Actions returns the planned actions in the order they were captured
+func (p *DryRunPlan) Actions() []PlannedAction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedAction(nil), p.actions...)
+}
+
+// Reset removes every planned action
+func (p *DryRunPlan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.actions = nil
+}
+
+// MarshalJSON encodes the plan as {"actions": [...]}
+func (p *DryRunPlan) MarshalJSON() ([]byte, error) {
+	actions := p.Actions()
+	if actions == nil {
+		actions = []PlannedAction{}
+	}
+
+	return json.Marshal(struct {
+		Actions []PlannedAction `json:"actions"`
+	}{actions})
+}
+
+// WriteJSON writes the plan as indented JSON, e.g. to be checked in for review
+func (p *DryRunPlan) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func (p *DryRunPlan) add(action PlannedAction) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.actions = append(p.actions, action)
+}
+
+// SetDryRun puts the client in dry-run mode. Every request that changes
+// something, i.e. anything but GET, HEAD and OPTIONS, is captured in plan
+// instead of being sent, and the calling service method returns without an
+// error. Nothing is created or changed, so methods that return a resource,
+// e.g. Instance.Create, return a nil one: check it before using it. Read only
+// requests are still sent. Passing nil turns dry-run mode off.
+func (c *Client) SetDryRun(plan *DryRunPlan) {
+	c.dryRun = plan
+}
+
+// DryRun returns the plan requests are captured in, or nil when the client
+// is not in dry-run mode
+func (c *Client) DryRun() *DryRunPlan {
+	return c.dryRun
+}
+
+// DryRunMiddleware captures mutating requests in the client's plan when it
+// is in dry-run mode, see SetDryRun
+func (c *Client) DryRunMiddleware(next Handler) Handler {
+	return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		plan := c.dryRun
+		if plan == nil || !isMutating(req.Method) {
+			return next.RoundTrip(req)
+		}
+
+		action := PlannedAction{
+			Operation: OperationFromContext(req.Context()),
+			Method:    req.Method,
+			Path:      req.URL.Path,
+			Query:     req.URL.RawQuery,
+		}
+
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := io.ReadAll(req.Body)
+			_ = req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+
+			action.Body = plannedBody(body)
+		}
+
+		plan.add(action)
+
+		return &http.Response{
+			Status:        "202 Accepted",
+			StatusCode:    http.StatusAccepted,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}, DryRunHeader: {"true"}},
+			Body:          io.NopCloser(strings.NewReader(dryRunResponseBody)),
+			ContentLength: int64(len(dryRunResponseBody)),
+			Request:       req,
+		}, nil
+	})
+}
+
+// isMutating reports whether requests with method change anything
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	return true
+}
+
+// plannedBody returns a redacted request body as JSON. Bodies that aren't
+// JSON are kept as a string.
+func plannedBody(body []byte) json.RawMessage {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+
+	if json.Valid(body) {
+		return RedactBody(body)
+	}
+
+	encoded, _ := json.Marshal(string(body))
+	return encoded
+}
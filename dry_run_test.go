@@ -0,0 +1,122 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_SetDryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			t.Errorf("%s %s was sent, expected it to be planned", request.Method, request.URL.Path)
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"instance":{"id":"14b3e7d6-ffb5-4994-8502-57fcd9db3b33","label":"web"}}`)
+	})
+	mux.HandleFunc("/v2/firewalls/", func(writer http.ResponseWriter, request *http.Request) {
+		t.Errorf("%s %s was sent, expected it to be planned", request.Method, request.URL.Path)
+	})
+
+	plan := NewDryRunPlan()
+	client.SetDryRun(plan)
+
+	if client.DryRun() != plan {
+		t.Errorf("Client.DryRun returned %+v, expected the plan", client.DryRun())
+	}
+
+	instance, _, err := client.Instance.Get(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+	if err != nil || instance.Label != "web" {
+		t.Errorf("Instance.Get returned %+v, %+v, expected the instance to be fetched", instance, err)
+	}
+
+	updated, resp, err := client.Instance.Update(ctx, instance.ID, &InstanceUpdateReq{Label: "api", UserData: "c2VjcmV0"})
+	if err != nil {
+		t.Fatalf("Instance.Update returned %+v", err)
+	}
+
+	if updated != nil || resp.Header.Get(DryRunHeader) != "true" {
+		t.Errorf("Instance.Update returned %+v, expected a nil dry-run result", updated)
+	}
+
+	created, _, err := client.SSHKey.Create(ctx, &SSHKeyReq{Name: "deploy", SSHKey: "ssh-ed25519 AAAA"})
+	if err != nil || created != nil {
+		t.Errorf("SSHKey.Create returned %+v, %+v, expected a nil key and no error", created, err)
+	}
+
+	if err := client.FirewallRule.Delete(ctx, "ab1c2d3e", 4); err != nil {
+		t.Fatalf("FirewallRule.Delete returned %+v", err)
+	}
+
+	expected := []PlannedAction{
+		{
+			Operation: "Instance.Update",
+			Method:    http.MethodPatch,
+			Path:      "/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+			Body:      json.RawMessage(`{"ddos_protection":null,"label":"api","tags":null,"user_data":"[REDACTED]"}`),
+		},
+		{
+			Operation: "SSHKey.Create",
+			Method:    http.MethodPost,
+			Path:      "/v2/ssh-keys",
+			Body:      json.RawMessage(`{"name":"deploy","ssh_key":"ssh-ed25519 AAAA"}`),
+		},
+		{
+			Operation: "FirewallRule.Delete",
+			Method:    http.MethodDelete,
+			Path:      "/v2/firewalls/ab1c2d3e/rules/4",
+		},
+	}
+
+	if actions := plan.Actions(); !reflect.DeepEqual(actions, expected) {
+		t.Errorf("Plan.Actions returned %+v, expected %+v", actions, expected)
+	}
+
+	client.SetDryRun(nil)
+	if client.DryRun() != nil {
+		t.Errorf("Client.DryRun returned %+v, expected nil", client.DryRun())
+	}
+}
+
+func TestPlan_WriteJSON(t *testing.T) {
+	plan := NewDryRunPlan()
+
+	var buf bytes.Buffer
+	if err := plan.WriteJSON(&buf); err != nil {
+		t.Fatalf("Plan.WriteJSON returned %+v", err)
+	}
+
+	if buf.String() != "{\n  \"actions\": []\n}\n" {
+		t.Errorf("Plan.WriteJSON wrote %q, expected no actions", buf.String())
+	}
+
+	plan.add(PlannedAction{Method: http.MethodPost, Path: "/v2/ssh-keys", Body: plannedBody([]byte(`{"name":"key"}`))})
+	plan.add(PlannedAction{Method: http.MethodPost, Path: "/v2/iso", Body: plannedBody([]byte("not json"))})
+
+	buf.Reset()
+	if err := plan.WriteJSON(&buf); err != nil {
+		t.Fatalf("Plan.WriteJSON returned %+v", err)
+	}
+
+	var decoded struct {
+		Actions []PlannedAction `json:"actions"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Plan.WriteJSON wrote invalid JSON %s: %+v", buf.String(), err)
+	}
+
+	if len(decoded.Actions) != 2 || string(decoded.Actions[1].Body) != `"not json"` {
+		t.Errorf("Plan.WriteJSON wrote %s, expected both actions", buf.String())
+	}
+
+	plan.Reset()
+	if actions := plan.Actions(); len(actions) != 0 {
+		t.Errorf("Plan.Actions returned %+v after Reset, expected none", actions)
+	}
+}
@@ -0,0 +1,221 @@
+package govultr
+
+import "strings"
+
+// Protocol is the protocol of a firewall rule
+type Protocol string
+
+// Firewall rule protocols
+const (
+	ProtocolICMP Protocol = "icmp"
+	ProtocolTCP  Protocol = "tcp"
+	ProtocolUDP  Protocol = "udp"
+	ProtocolGRE  Protocol = "gre"
+	ProtocolESP  Protocol = "esp"
+	ProtocolAH   Protocol = "ah"
+)
+
+// IsValid reports whether p is a known protocol, in any case
+func (p Protocol) IsValid() bool {
+	switch Protocol(strings.ToLower(string(p))) {
+	case ProtocolICMP, ProtocolTCP, ProtocolUDP, ProtocolGRE, ProtocolESP, ProtocolAH:
+		return true
+	}
+	return false
+}
+
+// IPType is the IP version of a firewall rule
+type IPType string
+
+// IP versions
+const (
+	IPTypeV4 IPType = "v4"
+	IPTypeV6 IPType = "v6"
+)
+
+// IsValid reports whether t is a known IP version
+func (t IPType) IsValid() bool {
+	return t == IPTypeV4 || t == IPTypeV6
+}
+
+// FirewallAction is what a firewall rule does with matching traffic
+type FirewallAction string
+
+// Firewall rule actions
+const (
+	FirewallActionAccept FirewallAction = "accept"
+	FirewallActionDrop   FirewallAction = "drop"
+)
+
+// IsValid reports whether a is a known firewall action
+func (a FirewallAction) IsValid() bool {
+	return a == FirewallActionAccept || a == FirewallActionDrop
+}
+
+// LBProtocol is the protocol of a load balancer forwarding rule or health check
+type LBProtocol string
+
+// Load balancer protocols
+const (
+	LBProtocolHTTP  LBProtocol = "http"
+	LBProtocolHTTPS LBProtocol = "https"
+	LBProtocolTCP   LBProtocol = "tcp"
+)
+
+// IsValid reports whether p is a known load balancer protocol
+func (p LBProtocol) IsValid() bool {
+	switch p {
+	case LBProtocolHTTP, LBProtocolHTTPS, LBProtocolTCP:
+		return true
+	}
+	return false
+}
+
+// BalancingAlgorithm is how a load balancer spreads requests over its instances
+type BalancingAlgorithm string
+
+// Load balancer algorithms
+const (
+	BalancingRoundRobin BalancingAlgorithm = "roundrobin"
+	BalancingLeastConn  BalancingAlgorithm = "leastconn"
+)
+
+// IsValid reports whether a is a known balancing algorithm
+func (a BalancingAlgorithm) IsValid() bool {
+	return a == BalancingRoundRobin || a == BalancingLeastConn
+}
+
+// BackupScheduleType is how often automatic backups are taken
+type BackupScheduleType string
+
+// Backup schedules
+const (
+	BackupScheduleDaily        BackupScheduleType = "daily"
+	BackupScheduleWeekly       BackupScheduleType = "weekly"
+	BackupScheduleMonthly      BackupScheduleType = "monthly"
+	BackupScheduleDailyAltEven BackupScheduleType = "daily_alt_even"
+	BackupScheduleDailyAltOdd  BackupScheduleType = "daily_alt_odd"
+)
+
+// IsValid reports whether t is a known backup schedule
+func (t BackupScheduleType) IsValid() bool {
+	switch t {
+	case BackupScheduleDaily, BackupScheduleWeekly, BackupScheduleMonthly, BackupScheduleDailyAltEven, BackupScheduleDailyAltOdd:
+		return true
+	}
+	return false
+}
+
+// RecordType is the type of a DNS record
+type RecordType string
+
+// DNS record types
+const (
+	RecordTypeA     RecordType = "A"
+	RecordTypeAAAA  RecordType = "AAAA"
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeNS    RecordType = "NS"
+	RecordTypeMX    RecordType = "MX"
+	RecordTypeSRV   RecordType = "SRV"
+	RecordTypeTXT   RecordType = "TXT"
+	RecordTypeCAA   RecordType = "CAA"
+	RecordTypeSSHFP RecordType = "SSHFP"
+)
+
+// IsValid reports whether t is a known DNS record type
+func (t RecordType) IsValid() bool {
+	switch t {
+	case RecordTypeA, RecordTypeAAAA, RecordTypeCNAME, RecordTypeNS, RecordTypeMX,
+		RecordTypeSRV, RecordTypeTXT, RecordTypeCAA, RecordTypeSSHFP:
+		return true
+	}
+	return false
+}
+
+// InstanceStatus is the subscription status of an instance
+type InstanceStatus string
+
+// Instance statuses
+const (
+	InstanceStatusActive    InstanceStatus = "active"
+	InstanceStatusPending   InstanceStatus = "pending"
+	InstanceStatusSuspended InstanceStatus = "suspended"
+	InstanceStatusResizing  InstanceStatus = "resizing"
+)
+
+// IsValid reports whether s is a known instance status
+func (s InstanceStatus) IsValid() bool {
+	switch s {
+	case InstanceStatusActive, InstanceStatusPending, InstanceStatusSuspended, InstanceStatusResizing:
+		return true
+	}
+	return false
+}
+
+// PowerStatus is whether an instance is powered on
+type PowerStatus string
+
+// Instance power statuses
+const (
+	PowerStatusRunning PowerStatus = "running"
+	PowerStatusStopped PowerStatus = "stopped"
+)
+
+// IsValid reports whether s is a known power status
+func (s PowerStatus) IsValid() bool {
+	return s == PowerStatusRunning || s == PowerStatusStopped
+}
+
+// ServerStatus is the state of the server behind an instance
+type ServerStatus string
+
+// Instance server statuses
+const (
+	ServerStatusNone              ServerStatus = "none"
+	ServerStatusLocked            ServerStatus = "locked"
+	ServerStatusInstallingBooting ServerStatus = "installingbooting"
+	ServerStatusOK                ServerStatus = "ok"
+)
+
+// IsValid reports whether s is a known server status
+func (s ServerStatus) IsValid() bool {
+	switch s {
+	case ServerStatusNone, ServerStatusLocked, ServerStatusInstallingBooting, ServerStatusOK:
+		return true
+	}
+	return false
+}
+
+// DatabaseEngine is the engine of a managed database
+type DatabaseEngine string
+
+// Managed database engines
+const (
+	EngineMySQL      DatabaseEngine = "mysql"
+	EnginePostgreSQL DatabaseEngine = "pg"
+	EngineValkey     DatabaseEngine = "valkey"
+	EngineKafka      DatabaseEngine = "kafka"
+)
+
+// IsValid reports whether e is a known database engine
+func (e DatabaseEngine) IsValid() bool {
+	switch e {
+	case EngineMySQL, EnginePostgreSQL, EngineValkey, EngineKafka:
+		return true
+	}
+	return false
+}
+
+// BlockType is the storage type of a block storage volume
+type BlockType string
+
+// Block storage types
+const (
+	BlockTypeHighPerf   BlockType = "high_perf"
+	BlockTypeStorageOpt BlockType = "storage_opt"
+)
+
+// IsValid reports whether t is a known block storage type
+func (t BlockType) IsValid() bool {
+	return t == BlockTypeHighPerf || t == BlockTypeStorageOpt
+}
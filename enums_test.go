@@ -0,0 +1,64 @@
+package govultr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnums_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+		got   bool
+	}{
+		{"protocol", true, ProtocolTCP.IsValid()},
+		{"unknown protocol", false, Protocol("tpc").IsValid()},
+		{"uppercase protocol", true, Protocol("UDP").IsValid()},
+		{"ip type", true, IPTypeV6.IsValid()},
+		{"unknown ip type", false, IPType("ipv4").IsValid()},
+		{"firewall action", true, FirewallActionAccept.IsValid()},
+		{"lb protocol", true, LBProtocolHTTPS.IsValid()},
+		{"firewall protocol on a load balancer", false, LBProtocol(ProtocolUDP).IsValid()},
+		{"balancing algorithm", true, BalancingLeastConn.IsValid()},
+		{"backup schedule", true, BackupScheduleDailyAltOdd.IsValid()},
+		{"unknown backup schedule", false, BackupScheduleType("hourly").IsValid()},
+		{"record type", true, RecordTypeCAA.IsValid()},
+		{"lower case record type", false, RecordType("cname").IsValid()},
+		{"instance status", true, InstanceStatusPending.IsValid()},
+		{"power status", true, PowerStatusStopped.IsValid()},
+		{"server status", true, ServerStatusInstallingBooting.IsValid()},
+		{"database engine", true, EnginePostgreSQL.IsValid()},
+		{"unknown database engine", false, DatabaseEngine("postgres").IsValid()},
+		{"block type", true, BlockTypeStorageOpt.IsValid()},
+		{"empty", false, BlockType("").IsValid()},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.valid {
+			t.Errorf("%s: IsValid returned %v, expected %v", tt.name, tt.got, tt.valid)
+		}
+	}
+}
+
+func TestEnums_JSON(t *testing.T) {
+	req := &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolTCP, Subnet: "0.0.0.0", Port: "22"}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"ip_type":"v4","protocol":"tcp","subnet":"0.0.0.0","subnet_size":0,"port":"22"}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal returned %s, expected %s", data, expected)
+	}
+
+	instance := &Instance{}
+	if err := json.Unmarshal([]byte(`{"status":"active","power_status":"running","server_status":"ok"}`), instance); err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceStatusActive || instance.PowerStatus != PowerStatusRunning || instance.ServerStatus != ServerStatusOK {
+		t.Errorf("json.Unmarshal returned %+v, expected an active running instance", instance)
+	}
+}
@@ -0,0 +1,128 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError represents a non successful response returned by the Vultr API
+type APIError struct {
+	// HTTP status code of the response
+	StatusCode int
+
+	// Method and path of the request that produced the error
+	Method string
+	Path   string
+
+	// Message and Status are parsed from the Vultr error body when present
+	Message string `json:"error"`
+	Status  int    `json:"status"`
+
+	// Raw response body
+	Body []byte
+
+	// Response that produced the error. The body has already been read and
+	// is available through Body.
+	Response *http.Response
+}
+
+// Error returns the raw response body so existing string checks keep working.
+// When the body is empty a short description of the failed request is used.
+func (e *APIError) Error() string {
+	if body := strings.TrimSpace(string(e.Body)); body != "" {
+		return body
+	}
+
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// newAPIError builds an APIError from a response whose body has already been read
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+		Response:   resp,
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.Path = resp.Request.URL.Path
+		}
+	}
+
+	// Not every error body is JSON, the raw body is always kept regardless
+	_ = json.Unmarshal(body, apiErr)
+
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	return apiErr
+}
+
+// RetryError is returned once the client has given up retrying a request. Err
+// holds the last error seen, which is an *APIError when the API responded.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+// Error returns the number of attempts along with the last error
+func (e *RetryError) Error() string {
+	var apiErr *APIError
+	if errors.As(e.Err, &apiErr) {
+		return fmt.Sprintf("gave up after %d attempts, last error: %#v", e.Attempts, apiErr.Error())
+	}
+
+	return fmt.Sprintf("gave up after %d attempts, last error : %s", e.Attempts, e.Err.Error())
+}
+
+// Unwrap returns the last error seen before giving up
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// IsBadRequest reports whether err is an API error with a 400 status code
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether err is an API error with a 401 status code
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an API error with a 403 status code
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsNotFound reports whether err is an API error with a 404 status code
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an API error with a 409 status code
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an API error with a 429 status code
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsServerError reports whether err is an API error with a 5xx status code
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == code
+}
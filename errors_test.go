@@ -0,0 +1,132 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIError_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/missing", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"Invalid instance-id.","status":404}`)
+	})
+
+	_, _, err := client.Instance.Get(ctx, "missing")
+	if err == nil {
+		t.Fatal("Instance.Get expected an error")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Instance.Get returned %T, expected *APIError", err)
+	}
+
+	expected := &APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     http.MethodGet,
+		Path:       "/v2/instances/missing",
+		Message:    "Invalid instance-id.",
+		Status:     http.StatusNotFound,
+	}
+
+	if apiErr.StatusCode != expected.StatusCode || apiErr.Method != expected.Method || apiErr.Path != expected.Path ||
+		apiErr.Message != expected.Message || apiErr.Status != expected.Status {
+		t.Errorf("Instance.Get returned %+v, expected %+v", apiErr, expected)
+	}
+
+	if apiErr.Error() != `{"error":"Invalid instance-id.","status":404}` {
+		t.Errorf("APIError.Error returned %s, expected the raw body", apiErr.Error())
+	}
+
+	if !IsNotFound(err) {
+		t.Error("IsNotFound returned false, expected true")
+	}
+
+	if IsConflict(err) || IsRateLimited(err) || IsServerError(err) {
+		t.Error("expected only IsNotFound to match a 404")
+	}
+}
+
+func TestAPIError_EmptyBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusConflict)
+	})
+
+	err := client.Domain.Delete(ctx, "example.com")
+	if !IsConflict(err) {
+		t.Fatalf("Domain.Delete returned %+v, expected a conflict", err)
+	}
+
+	expected := "DELETE /v2/domains/example.com: 409 Conflict"
+	if err.Error() != expected {
+		t.Errorf("APIError.Error returned %s, expected %s", err.Error(), expected)
+	}
+}
+
+func TestRetryError_WrapsAPIError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(10 * time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		writer.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(writer, `{"error":"Rate limit exceeded","status":429}`)
+	})
+
+	_, _, err := client.Account.Get(ctx)
+	if err == nil {
+		t.Fatal("Account.Get expected an error")
+	}
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("Account.Get returned %T, expected *RetryError", err)
+	}
+
+	if retryErr.Attempts != attempts {
+		t.Errorf("RetryError.Attempts = %d, expected %d", retryErr.Attempts, attempts)
+	}
+
+	if !IsRateLimited(err) {
+		t.Error("IsRateLimited returned false, expected true")
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr); apiErr.Message != "Rate limit exceeded" || apiErr.Path != "/v2/account" {
+		t.Errorf("RetryError wrapped %+v, expected the last API response", apiErr)
+	}
+}
+
+func TestRetryError_WrapsTransportError(t *testing.T) {
+	c := NewClient(&http.Client{Transport: errRoundTripper{}})
+	c.SetRateLimit(10 * time.Millisecond)
+
+	req, _ := c.NewRequest(ctx, http.MethodGet, "/", nil)
+	_, err := c.DoWithContext(ctx, req, nil)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("DoWithContext returned %T, expected *RetryError", err)
+	}
+
+	if retryErr.Err == nil || retryErr.Attempts != retryLimit+1 {
+		t.Errorf("DoWithContext returned %+v, expected the transport error after %d attempts", retryErr, retryLimit+1)
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("DoWithContext returned %+v, expected no API error for a transport failure", apiErr)
+	}
+}
@@ -25,13 +25,13 @@ type FireWallGroupServiceHandler struct {
 
 // FirewallGroup represents a Vultr firewall group
 type FirewallGroup struct {
-	ID            string `json:"id"`
-	Description   string `json:"description"`
-	DateCreated   string `json:"date_created"`
-	DateModified  string `json:"date_modified"`
-	InstanceCount int    `json:"instance_count"`
-	RuleCount     int    `json:"rule_count"`
-	MaxRuleCount  int    `json:"max_rule_count"`
+	ID            string    `json:"id"`
+	Description   string    `json:"description"`
+	DateCreated   Timestamp `json:"date_created"`
+	DateModified  Timestamp `json:"date_modified"`
+	InstanceCount int       `json:"instance_count"`
+	RuleCount     int       `json:"rule_count"`
+	MaxRuleCount  int       `json:"max_rule_count"`
 }
 
 // FirewallGroupReq struct is used to create and update a Firewall Group.
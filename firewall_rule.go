@@ -24,26 +24,26 @@ type FireWallRuleServiceHandler struct {
 
 // FirewallRule represents a Vultr firewall rule
 type FirewallRule struct {
-	ID         int    `json:"id"`
-	Action     string `json:"action"`
-	IPType     string `json:"ip_type"`
-	Protocol   string `json:"protocol"`
-	Port       string `json:"port"`
-	Subnet     string `json:"subnet"`
-	SubnetSize int    `json:"subnet_size"`
-	Source     string `json:"source"`
-	Notes      string `json:"notes"`
+	ID         int            `json:"id"`
+	Action     FirewallAction `json:"action"`
+	IPType     IPType         `json:"ip_type"`
+	Protocol   Protocol       `json:"protocol"`
+	Port       string         `json:"port"`
+	Subnet     string         `json:"subnet"`
+	SubnetSize int            `json:"subnet_size"`
+	Source     string         `json:"source"`
+	Notes      string         `json:"notes"`
 }
 
 // FirewallRuleReq struct used to create a FirewallRule.
 type FirewallRuleReq struct {
-	IPType     string `json:"ip_type"`
-	Protocol   string `json:"protocol"`
-	Subnet     string `json:"subnet"`
-	SubnetSize int    `json:"subnet_size"`
-	Port       string `json:"port,omitempty"`
-	Source     string `json:"source,omitempty"`
-	Notes      string `json:"notes,omitempty"`
+	IPType     IPType   `json:"ip_type"`
+	Protocol   Protocol `json:"protocol"`
+	Subnet     string   `json:"subnet"`
+	SubnetSize int      `json:"subnet_size"`
+	Port       string   `json:"port,omitempty"`
+	Source     string   `json:"source,omitempty"`
+	Notes      string   `json:"notes,omitempty"`
 }
 
 type firewallRulesBase struct {
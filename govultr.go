@@ -6,20 +6,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
 	"net/url"
-	"strings"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 )
 
 const (
-	version     = "3.31.2"
+	version     = "3.33.0"
 	defaultBase = "https://api.vultr.com"
 	userAgent   = "govultr/" + version
 	rateLimit   = 500 * time.Millisecond
@@ -75,11 +75,32 @@ type Client struct {
 	User                     UserService
 	VirtualFileSystemStorage VirtualFileSystemStorageService
 	VPC                      VPCService
-	// Deprecated: VPC2 is no longer supported
-	VPC2 VPC2Service
 
 	// Optional function called after every successful request made to the Vultr API
 	onRequestCompleted RequestCompletionCallback
+
+	// Optional client side rate limiter applied to every request attempt
+	rateLimiter *RateLimiter
+
+	// Decides which failed requests RetryMiddleware retries
+	retryPolicy RetryPolicy
+
+	// Plan mutating requests are captured in instead of being sent, nil
+	// unless the client is in dry-run mode
+	dryRun *DryRunPlan
+
+	// Middleware chain wrapped around every request, outermost first
+	middleware []Middleware
+
+	// Optional source of the bearer token sent with every request
+	tokenSource TokenSource
+
+	// Request bodies are sent without calling their Validate method when set
+	skipValidation bool
+
+	// Optional structured logger, bodies are only logged when logBodies is set
+	logger    *slog.Logger
+	logBodies bool
 }
 
 // RequestCompletionCallback defines the type of the request callback function
@@ -88,22 +109,7 @@ type RequestCompletionCallback func(*http.Request, *http.Response)
 // NewClient returns a Vultr API Client
 func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
-		httpClient = &http.Client{
-			Transport: &http.Transport{
-				DialContext: (&net.Dialer{
-					Timeout:   90 * time.Second,
-					KeepAlive: 90 * time.Second,
-					DualStack: true,
-				}).DialContext,
-				MaxIdleConns:          100,
-				IdleConnTimeout:       90 * time.Second,
-				TLSHandshakeTimeout:   30 * time.Second,
-				ExpectContinueTimeout: 1 * time.Second,
-				MaxIdleConnsPerHost:   -1,
-				DisableKeepAlives:     true,
-			},
-			Timeout: 60 * time.Second,
-		}
+		httpClient = defaultHTTPClient()
 	}
 
 	baseURL, _ := url.Parse(defaultBase)
@@ -119,6 +125,15 @@ func NewClient(httpClient *http.Client) *Client {
 	client.client.ErrorHandler = client.vultrErrorHandler
 	client.SetRetryLimit(retryLimit)
 	client.SetRateLimit(rateLimit)
+	client.SetRetryPolicy(nil)
+	client.middleware = []Middleware{
+		ErrorMiddleware,
+		client.DryRunMiddleware,
+		client.RetryMiddleware,
+		client.UserAgentMiddleware,
+		client.AuthMiddleware,
+		client.LoggingMiddleware,
+	}
 
 	client.Account = &AccountServiceHandler{client}
 	client.Application = &ApplicationServiceHandler{client}
@@ -154,20 +169,42 @@ func NewClient(httpClient *http.Client) *Client {
 	client.User = &UserServiceHandler{client}
 	client.VirtualFileSystemStorage = &VirtualFileSystemStorageServiceHandler{client}
 	client.VPC = &VPCServiceHandler{client}
-	client.VPC2 = &VPC2ServiceHandler{client}
 
 	return client
 }
 
-// NewRequest creates an API Request
+// defaultHTTPClient returns the HTTP client used when none is given
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   90 * time.Second,
+				KeepAlive: 90 * time.Second,
+			}).DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   30 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			MaxIdleConnsPerHost:   -1,
+			DisableKeepAlives:     true,
+		},
+		Timeout: 60 * time.Second,
+	}
+}
+
+// NewRequest creates an API request
 func (c *Client) NewRequest(ctx context.Context, method, uri string, body interface{}) (*http.Request, error) {
+	if err := c.validate(body); err != nil {
+		return nil, err
+	}
+
 	resolvedURL, err := c.BaseURL.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
 
-	buf := new(bytes.Buffer)
-	if body != nil {
+	buf := &bytes.Buffer{}
+	if !isNilInterface(body) {
 		if err2 := json.NewEncoder(buf).Encode(body); err2 != nil {
 			return nil, err2
 		}
@@ -185,50 +222,52 @@ func (c *Client) NewRequest(ctx context.Context, method, uri string, body interf
 	return req, nil
 }
 
-// DoWithContext sends an API Request and returns back the response. The API response is checked  to see if it was
-// a successful call. A successful call is then checked to see if we need to unmarshal since some resources
-// have their own implements of unmarshal.
+// DoWithContext sends an API request through the client's middleware chain
+// and returns back the response. The API response is checked to see if it was
+// a successful call. A successful call is then checked to see if we need to
+// unmarshal since some resources have their own implements of unmarshal.
 func (c *Client) DoWithContext(ctx context.Context, r *http.Request, data interface{}) (*http.Response, error) {
-	rreq, err := retryablehttp.FromRequest(r)
-	if err != nil {
-		return nil, err
+	if OperationFromContext(ctx) == "" {
+		if operation := callerOperation(); operation != "" {
+			ctx = WithOperation(ctx, operation)
+		}
 	}
 
-	rreq = rreq.WithContext(ctx)
-
-	res, errDo := c.client.Do(rreq)
+	res, err := c.handler().RoundTrip(r.WithContext(ctx))
 
 	if c.onRequestCompleted != nil {
 		c.onRequestCompleted(r, res)
 	}
 
-	if errDo != nil {
-		return nil, errDo
+	if err != nil {
+		return res, err
 	}
 
-	defer func() {
-		if rerr := res.Body.Close(); err == nil {
-			err = rerr
-		}
-	}()
-
 	body, err := io.ReadAll(res.Body)
+	if cerr := res.Body.Close(); err == nil {
+		err = cerr
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	res.Body = io.NopCloser(bytes.NewBuffer(body))
 
-	if res.StatusCode >= http.StatusOK && res.StatusCode <= http.StatusNoContent {
-		if data != nil {
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNonAuthoritativeInfo, http.StatusPartialContent:
+		if res.ContentLength == 0 || isNilInterface(data) {
+			return res, nil
+		}
+
+		switch res.Header.Get("Content-Type") { //nolint:gocritic
+		case "application/json":
 			if err := json.Unmarshal(body, data); err != nil {
 				return nil, err
 			}
 		}
-		return res, nil
 	}
 
-	return res, errors.New(string(body))
+	return res, nil
 }
 
 // SetBaseURL Overrides the default BaseUrl
@@ -250,6 +289,30 @@ func (c *Client) SetRateLimit(t time.Duration) {
 	c.client.RetryWaitMax = t
 }
 
+// SetRateLimiter attaches a client side rate limiter that every request,
+// including retries, has to wait on before it is sent. Passing nil removes it.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	httpClient := *c.client.HTTPClient
+
+	base := httpClient.Transport
+	if t, ok := base.(*rateLimitTransport); ok {
+		base = t.base
+	}
+
+	httpClient.Transport = base
+	if limiter != nil {
+		httpClient.Transport = &rateLimitTransport{limiter: limiter, base: base}
+	}
+
+	c.client.HTTPClient = &httpClient
+	c.rateLimiter = limiter
+}
+
+// RateLimiter returns the client side rate limiter, nil if none is set
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
+
 // SetUserAgent Overrides the default UserAgent
 func (c *Client) SetUserAgent(ua string) {
 	c.UserAgent = ua
@@ -268,7 +331,7 @@ func (c *Client) SetRetryLimit(n int) {
 func (c *Client) vultrErrorHandler(resp *http.Response, err error, numTries int) (*http.Response, error) {
 	if resp == nil {
 		if err != nil {
-			return nil, fmt.Errorf("gave up after %d attempts, last error : %s", numTries, err.Error())
+			return nil, &RetryError{Attempts: numTries, Err: err}
 		}
 		return nil, fmt.Errorf("gave up after %d attempts, last error unavailable (resp == nil)", numTries)
 	}
@@ -277,7 +340,22 @@ func (c *Client) vultrErrorHandler(resp *http.Response, err error, numTries int)
 	if err != nil {
 		return nil, fmt.Errorf("gave up after %d attempts, last error unavailable (error reading response body: %v)", numTries, err)
 	}
-	return nil, fmt.Errorf("gave up after %d attempts, last error: %#v", numTries, strings.TrimSpace(string(buf)))
+	return nil, &RetryError{Attempts: numTries, Err: newAPIError(resp, buf)}
+}
+
+func isNilInterface(i interface{}) bool {
+	v := reflect.ValueOf(i)
+
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Func, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
 }
 
 // BoolToBoolPtr helper function that returns a pointer from your bool value
@@ -0,0 +1,82 @@
+// Package govultrmock provides mocks of the govultr service interfaces for
+// unit testing code that uses govultr.
+//
+// Every mock has a Func field per method of its interface. A method calls its
+// Func, or returns ErrNotMocked when the Func is nil, and records the call:
+//
+//	mock := govultrmock.NewMockClient()
+//	mock.Instance.GetFunc = func(ctx context.Context, id string) (*govultr.Instance, *http.Response, error) {
+//		return &govultr.Instance{ID: id, Status: "active"}, nil, nil
+//	}
+//
+//	err := codeUnderTest(mock.Client)
+//
+//	calls := mock.Instance.CallsTo("Get")
+//
+// The mocks are generated from the govultr package with go generate.
+package govultrmock
+
+//go:generate go run ./internal/mockgen -src .. -out mocks_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotMocked is returned by a mock method whose Func is not set
+var ErrNotMocked = errors.New("govultrmock: method not mocked")
+
+// Call is a call made to a mock method and the arguments it was called with
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// calls records the calls made to a mock
+type calls struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (c *calls) record(method string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every call made to the mock in order
+func (c *calls) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns the calls made to method of the mock in order
+func (c *calls) CallsTo(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matched []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			matched = append(matched, call)
+		}
+	}
+
+	return matched
+}
+
+// ResetCalls forgets the calls made to the mock
+func (c *calls) ResetCalls() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = nil
+}
+
+func notMocked(service, method string) error {
+	return fmt.Errorf("%w: %s.%s", ErrNotMocked, service, method)
+}
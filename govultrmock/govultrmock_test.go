@@ -0,0 +1,92 @@
+package govultrmock
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func TestNewMockClient(t *testing.T) {
+	mock := NewMockClient()
+
+	if mock.Client.Instance != mock.Instance || mock.Client.VPC != mock.VPC || mock.Client.Database != mock.Database {
+		t.Error("NewMockClient returned a client that is not wired with the mocks")
+	}
+
+	mock.Instance.GetFunc = func(_ context.Context, instanceID string) (*govultr.Instance, *http.Response, error) {
+		return &govultr.Instance{ID: instanceID, Status: "active"}, nil, nil
+	}
+
+	instance, _, err := mock.Client.Instance.Get(context.Background(), "instance-1")
+	if err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	expected := &govultr.Instance{ID: "instance-1", Status: "active"}
+	if !reflect.DeepEqual(instance, expected) {
+		t.Errorf("Instance.Get returned %+v, expected %+v", instance, expected)
+	}
+}
+
+func TestMock_NotMocked(t *testing.T) {
+	mock := NewMockClient()
+
+	instances, _, _, err := mock.Client.Instance.List(context.Background(), nil)
+	if !errors.Is(err, ErrNotMocked) || instances != nil {
+		t.Errorf("Instance.List returned %+v, %+v, expected ErrNotMocked", instances, err)
+	}
+
+	if err.Error() != "govultrmock: method not mocked: InstanceService.List" {
+		t.Errorf("Instance.List returned %q, expected the service and method in the error", err.Error())
+	}
+}
+
+func TestMock_Calls(t *testing.T) {
+	mock := NewMockClient()
+	mock.VPC.DeleteFunc = func(context.Context, string) error { return nil }
+
+	ctx := context.Background()
+	_ = mock.Client.VPC.Delete(ctx, "vpc-1")
+	_ = mock.Client.VPC.Delete(ctx, "vpc-2")
+	_, _, _ = mock.Client.VPC.Get(ctx, "vpc-3")
+
+	if calls := mock.VPC.Calls(); len(calls) != 3 {
+		t.Errorf("VPC.Calls returned %+v, expected 3 calls", calls)
+	}
+
+	expected := []Call{{Method: "Delete", Args: []any{ctx, "vpc-1"}}, {Method: "Delete", Args: []any{ctx, "vpc-2"}}}
+	if calls := mock.VPC.CallsTo("Delete"); !reflect.DeepEqual(calls, expected) {
+		t.Errorf("VPC.CallsTo returned %+v, expected %+v", calls, expected)
+	}
+
+	mock.VPC.ResetCalls()
+	if calls := mock.VPC.Calls(); len(calls) != 0 {
+		t.Errorf("VPC.Calls returned %+v after ResetCalls, expected none", calls)
+	}
+}
+
+func TestMock_Waiter(t *testing.T) {
+	mock := NewMockClient()
+
+	statuses := []govultr.InstanceStatus{"pending", "pending", "active"}
+	mock.Instance.GetFunc = func(_ context.Context, instanceID string) (*govultr.Instance, *http.Response, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return &govultr.Instance{ID: instanceID, Status: status, PowerStatus: "running", ServerStatus: "ok"}, nil, nil
+	}
+
+	opts := &govultr.WaitOptions{PollInterval: time.Millisecond, Timeout: time.Second}
+	instance, err := mock.WaitForInstanceActive(context.Background(), "instance-1", opts)
+	if err != nil {
+		t.Fatalf("WaitForInstanceActive returned %+v", err)
+	}
+
+	if instance.Status != "active" || len(mock.Instance.CallsTo("Get")) != 3 {
+		t.Errorf("WaitForInstanceActive returned %+v after %d polls, expected active after 3", instance, len(mock.Instance.CallsTo("Get")))
+	}
+}
@@ -0,0 +1,358 @@
+// Command mockgen generates the govultrmock mocks from the service interfaces
+// of the govultr Client. Run it with go generate in the govultrmock directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const govultrImport = "github.com/vultr/govultr/v3"
+
+func main() {
+	src := flag.String("src", "..", "directory of the govultr package")
+	out := flag.String("out", "mocks_gen.go", "file the mocks are written to")
+	flag.Parse()
+
+	code, err := generate(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, code, 0o600); err != nil { //nolint:mnd
+		log.Fatal(err)
+	}
+}
+
+// service is a service field of the Client and the interface it's typed as
+type service struct {
+	field string
+	name  string
+	iface *ast.InterfaceType
+}
+
+// pkg is the parsed govultr package
+type pkg struct {
+	fset       *token.FileSet
+	types      map[string]bool
+	interfaces map[string]*ast.InterfaceType
+	imports    map[string]string
+	client     *ast.StructType
+}
+
+// generate returns the formatted source of the mocks for the govultr package
+// in dir
+func generate(dir string) ([]byte, error) {
+	p, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := p.services()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{pkg: p, imports: map[string]bool{}}
+	for _, svc := range services {
+		g.writeMock(svc)
+	}
+	g.writeClient(services)
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by mockgen from the govultr service interfaces. DO NOT EDIT.\n\n")
+	out.WriteString("package govultrmock\n\nimport (\n")
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	slices.Sort(imports)
+	for _, imp := range imports {
+		fmt.Fprintf(&out, "\t%q\n", imp)
+	}
+	fmt.Fprintf(&out, "\n\t%q\n)\n", govultrImport)
+	out.Write(g.buf.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func parsePackage(dir string) (*pkg, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{
+		fset:       token.NewFileSet(),
+		types:      map[string]bool{},
+		interfaces: map[string]*ast.InterfaceType{},
+		imports:    map[string]string{},
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(p.fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		p.addFile(f)
+	}
+
+	if p.client == nil {
+		return nil, fmt.Errorf("no Client struct in %s", dir)
+	}
+
+	return p, nil
+}
+
+func (p *pkg) addFile(f *ast.File) {
+	for _, imp := range f.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		name := path.Base(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		p.imports[name] = importPath
+	}
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			p.types[typeSpec.Name.Name] = true
+
+			switch t := typeSpec.Type.(type) {
+			case *ast.InterfaceType:
+				p.interfaces[typeSpec.Name.Name] = t
+			case *ast.StructType:
+				if typeSpec.Name.Name == "Client" {
+					p.client = t
+				}
+			}
+		}
+	}
+}
+
+// services returns the service fields of the Client in declaration order
+func (p *pkg) services() ([]service, error) {
+	var services []service
+	for _, field := range p.client.Fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || !strings.HasSuffix(ident.Name, "Service") {
+			continue
+		}
+
+		iface, ok := p.interfaces[ident.Name]
+		if !ok {
+			return nil, fmt.Errorf("no interface %s for Client.%s", ident.Name, field.Names[0].Name)
+		}
+
+		for _, name := range field.Names {
+			services = append(services, service{field: name.Name, name: ident.Name, iface: iface})
+		}
+	}
+
+	return services, nil
+}
+
+type generator struct {
+	*pkg
+	buf     bytes.Buffer
+	imports map[string]bool
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// method is a rendered interface method
+type method struct {
+	name    string
+	params  string
+	results string
+	args    []string
+	zero    []string
+}
+
+func (g *generator) writeMock(svc service) {
+	var methods []method
+	for _, field := range svc.iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			continue
+		}
+		methods = append(methods, g.method(field.Names[0].Name, fn))
+	}
+
+	g.printf("\n// %s is a mock of govultr.%s. Each method calls the function in\n", svc.name, svc.name)
+	g.printf("// its Func field, and returns ErrNotMocked when that is nil.\n")
+	g.printf("type %s struct {\n\tcalls\n", svc.name)
+	for _, m := range methods {
+		g.printf("\n\t// %sFunc is called by %s\n\t%sFunc func(%s) %s\n", m.name, m.name, m.name, m.params, m.results)
+	}
+	g.printf("}\n\nvar _ govultr.%s = (*%s)(nil)\n", svc.name, svc.name)
+
+	for _, m := range methods {
+		g.printf("\n// %s records the call and calls %sFunc\n", m.name, m.name)
+		g.printf("func (m *%s) %s(%s) %s {\n", svc.name, m.name, m.params, m.results)
+		g.printf("\tm.record(%q%s)\n", m.name, prefixed(m.args))
+		g.printf("\tif m.%sFunc == nil {\n", m.name)
+		for i, zero := range m.zero {
+			if zero != "" {
+				g.printf("\t\tvar r%d %s\n", i, zero)
+			}
+		}
+		g.printf("\t\treturn %s\n\t}\n\n", zeroReturn(svc.name, m))
+		g.printf("\treturn m.%sFunc(%s)\n}\n", m.name, strings.Join(m.args, ", "))
+	}
+}
+
+// zeroReturn returns what a method returns when its Func is nil
+func zeroReturn(service string, m method) string {
+	values := make([]string, len(m.zero))
+	for i, zero := range m.zero {
+		values[i] = fmt.Sprintf("r%d", i)
+		if zero == "" {
+			values[i] = fmt.Sprintf("notMocked(%q, %q)", service, m.name)
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func (g *generator) method(name string, fn *ast.FuncType) method {
+	m := method{name: name}
+
+	var params []string
+	for _, field := range fn.Params.List {
+		typ := g.expr(field.Type)
+
+		names := make([]string, 0, len(field.Names))
+		for _, ident := range field.Names {
+			names = append(names, ident.Name)
+		}
+		if len(names) == 0 {
+			names = append(names, "_")
+		}
+
+		for i, n := range names {
+			if n == "_" {
+				names[i] = fmt.Sprintf("arg%d", len(m.args))
+			}
+			arg := names[i]
+			if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+				arg += "..."
+			}
+			m.args = append(m.args, arg)
+		}
+
+		params = append(params, strings.Join(names, ", ")+" "+typ)
+	}
+	m.params = strings.Join(params, ", ")
+
+	var results []string
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			typ := g.expr(field.Type)
+			for range max(len(field.Names), 1) {
+				results = append(results, typ)
+				if typ == "error" {
+					m.zero = append(m.zero, "")
+				} else {
+					m.zero = append(m.zero, typ)
+				}
+			}
+		}
+	}
+
+	switch len(results) {
+	case 0:
+	case 1:
+		m.results = results[0]
+	default:
+		m.results = "(" + strings.Join(results, ", ") + ")"
+	}
+
+	return m
+}
+
+// expr renders a type expression from the govultr package, qualifying the
+// types declared in it and recording the imports it uses
+func (g *generator) expr(e ast.Expr) string {
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok {
+				g.imports[g.pkg.imports[x.Name]] = true
+			}
+			return false
+		case *ast.Ident:
+			if g.types[n.Name] {
+				n.Name = "govultr." + n.Name
+			}
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, g.fset, e)
+
+	return buf.String()
+}
+
+func (g *generator) writeClient(services []service) {
+	g.printf("\n// MockClient is a govultr.Client with every service replaced by a mock.\n")
+	g.printf("// The mock fields shadow the service interfaces of the embedded Client, so\n")
+	g.printf("// they can be configured directly.\n")
+	g.printf("type MockClient struct {\n\t*govultr.Client\n\n")
+	for _, svc := range services {
+		g.printf("\t%s *%s\n", svc.field, svc.name)
+	}
+	g.printf("}\n")
+
+	g.printf("\n// NewMockClient returns a MockClient with a mock for every service\n")
+	g.printf("func NewMockClient() *MockClient {\n\tm := &MockClient{\n\t\tClient: govultr.NewClient(nil),\n")
+	for _, svc := range services {
+		g.printf("\t\t%s: &%s{},\n", svc.field, svc.name)
+	}
+	g.printf("\t}\n\n")
+	for _, svc := range services {
+		g.printf("\tm.Client.%s = m.%s\n", svc.field, svc.field)
+	}
+	g.printf("\n\treturn m\n}\n")
+}
+
+// prefixed returns args as extra arguments of a call
+func prefixed(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	trimmed := make([]string, len(args))
+	for i, arg := range args {
+		trimmed[i] = strings.TrimSuffix(arg, "...")
+	}
+
+	return ", " + strings.Join(trimmed, ", ")
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	code, err := generate("../../..")
+	if err != nil {
+		t.Fatalf("generate returned %+v", err)
+	}
+
+	current, err := os.ReadFile("../../mocks_gen.go")
+	if err != nil {
+		t.Fatalf("reading mocks_gen.go returned %+v", err)
+	}
+
+	if !bytes.Equal(code, current) {
+		t.Error("mocks_gen.go is out of date, run go generate in govultrmock")
+	}
+}
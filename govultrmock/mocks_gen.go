@@ -0,0 +1,6592 @@
+// Code generated by mockgen from the govultr service interfaces. DO NOT EDIT.
+
+package govultrmock
+
+import (
+	"context"
+	"iter"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// AccountService is a mock of govultr.AccountService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type AccountService struct {
+	calls
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context) (*govultr.Account, *http.Response, error)
+
+	// GetBandwidthFunc is called by GetBandwidth
+	GetBandwidthFunc func(ctx context.Context) (*govultr.AccountBandwidth, *http.Response, error)
+}
+
+var _ govultr.AccountService = (*AccountService)(nil)
+
+// Get records the call and calls GetFunc
+func (m *AccountService) Get(ctx context.Context) (*govultr.Account, *http.Response, error) {
+	m.record("Get", ctx)
+	if m.GetFunc == nil {
+		var r0 *govultr.Account
+		var r1 *http.Response
+		return r0, r1, notMocked("AccountService", "Get")
+	}
+
+	return m.GetFunc(ctx)
+}
+
+// GetBandwidth records the call and calls GetBandwidthFunc
+func (m *AccountService) GetBandwidth(ctx context.Context) (*govultr.AccountBandwidth, *http.Response, error) {
+	m.record("GetBandwidth", ctx)
+	if m.GetBandwidthFunc == nil {
+		var r0 *govultr.AccountBandwidth
+		var r1 *http.Response
+		return r0, r1, notMocked("AccountService", "GetBandwidth")
+	}
+
+	return m.GetBandwidthFunc(ctx)
+}
+
+// ApplicationService is a mock of govultr.ApplicationService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type ApplicationService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Application, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.ApplicationService = (*ApplicationService)(nil)
+
+// List records the call and calls ListFunc
+func (m *ApplicationService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Application, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Application
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ApplicationService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// BackupService is a mock of govultr.BackupService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type BackupService struct {
+	calls
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, backupID string) (*govultr.Backup, *http.Response, error)
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Backup, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.BackupService = (*BackupService)(nil)
+
+// Get records the call and calls GetFunc
+func (m *BackupService) Get(ctx context.Context, backupID string) (*govultr.Backup, *http.Response, error) {
+	m.record("Get", ctx, backupID)
+	if m.GetFunc == nil {
+		var r0 *govultr.Backup
+		var r1 *http.Response
+		return r0, r1, notMocked("BackupService", "Get")
+	}
+
+	return m.GetFunc(ctx, backupID)
+}
+
+// List records the call and calls ListFunc
+func (m *BackupService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Backup, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Backup
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BackupService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// BareMetalServerService is a mock of govultr.BareMetalServerService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type BareMetalServerService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, bmCreate *govultr.BareMetalCreate) (*govultr.BareMetalServer, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, serverID string) (*govultr.BareMetalServer, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, serverID string, bmReq *govultr.BareMetalUpdate) (*govultr.BareMetalServer, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, serverID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *http.Response, error)
+
+	// GetBandwidthFunc is called by GetBandwidth
+	GetBandwidthFunc func(ctx context.Context, serverID string) (*govultr.Bandwidth, *http.Response, error)
+
+	// GetUserDataFunc is called by GetUserData
+	GetUserDataFunc func(ctx context.Context, serverID string) (*govultr.UserData, *http.Response, error)
+
+	// GetVNCUrlFunc is called by GetVNCUrl
+	GetVNCUrlFunc func(ctx context.Context, serverID string) (*govultr.VNCUrl, *http.Response, error)
+
+	// ListIPv4sFunc is called by ListIPv4s
+	ListIPv4sFunc func(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *http.Response, error)
+
+	// ListIPv6sFunc is called by ListIPv6s
+	ListIPv6sFunc func(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *http.Response, error)
+
+	// HaltFunc is called by Halt
+	HaltFunc func(ctx context.Context, serverID string) error
+
+	// RebootFunc is called by Reboot
+	RebootFunc func(ctx context.Context, serverID string) error
+
+	// StartFunc is called by Start
+	StartFunc func(ctx context.Context, serverID string) error
+
+	// ReinstallFunc is called by Reinstall
+	ReinstallFunc func(ctx context.Context, serverID string) (*govultr.BareMetalServer, *http.Response, error)
+
+	// MassStartFunc is called by MassStart
+	MassStartFunc func(ctx context.Context, serverList []string) error
+
+	// MassHaltFunc is called by MassHalt
+	MassHaltFunc func(ctx context.Context, serverList []string) error
+
+	// MassRebootFunc is called by MassReboot
+	MassRebootFunc func(ctx context.Context, serverList []string) error
+
+	// GetUpgradesFunc is called by GetUpgrades
+	GetUpgradesFunc func(ctx context.Context, serverID string) (*govultr.Upgrades, *http.Response, error)
+
+	// ListVPCInfoFunc is called by ListVPCInfo
+	ListVPCInfoFunc func(ctx context.Context, serverID string) ([]govultr.VPCInfo, *http.Response, error)
+
+	// AttachVPCFunc is called by AttachVPC
+	AttachVPCFunc func(ctx context.Context, serverID, vpcID string) error
+
+	// DetachVPCFunc is called by DetachVPC
+	DetachVPCFunc func(ctx context.Context, serverID, vpcID string) error
+}
+
+var _ govultr.BareMetalServerService = (*BareMetalServerService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *BareMetalServerService) Create(ctx context.Context, bmCreate *govultr.BareMetalCreate) (*govultr.BareMetalServer, *http.Response, error) {
+	m.record("Create", ctx, bmCreate)
+	if m.CreateFunc == nil {
+		var r0 *govultr.BareMetalServer
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "Create")
+	}
+
+	return m.CreateFunc(ctx, bmCreate)
+}
+
+// Get records the call and calls GetFunc
+func (m *BareMetalServerService) Get(ctx context.Context, serverID string) (*govultr.BareMetalServer, *http.Response, error) {
+	m.record("Get", ctx, serverID)
+	if m.GetFunc == nil {
+		var r0 *govultr.BareMetalServer
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "Get")
+	}
+
+	return m.GetFunc(ctx, serverID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *BareMetalServerService) Update(ctx context.Context, serverID string, bmReq *govultr.BareMetalUpdate) (*govultr.BareMetalServer, *http.Response, error) {
+	m.record("Update", ctx, serverID, bmReq)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.BareMetalServer
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, serverID, bmReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *BareMetalServerService) Delete(ctx context.Context, serverID string) error {
+	m.record("Delete", ctx, serverID)
+	if m.DeleteFunc == nil {
+		return notMocked("BareMetalServerService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, serverID)
+}
+
+// List records the call and calls ListFunc
+func (m *BareMetalServerService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.BareMetalServer
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BareMetalServerService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// GetBandwidth records the call and calls GetBandwidthFunc
+func (m *BareMetalServerService) GetBandwidth(ctx context.Context, serverID string) (*govultr.Bandwidth, *http.Response, error) {
+	m.record("GetBandwidth", ctx, serverID)
+	if m.GetBandwidthFunc == nil {
+		var r0 *govultr.Bandwidth
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "GetBandwidth")
+	}
+
+	return m.GetBandwidthFunc(ctx, serverID)
+}
+
+// GetUserData records the call and calls GetUserDataFunc
+func (m *BareMetalServerService) GetUserData(ctx context.Context, serverID string) (*govultr.UserData, *http.Response, error) {
+	m.record("GetUserData", ctx, serverID)
+	if m.GetUserDataFunc == nil {
+		var r0 *govultr.UserData
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "GetUserData")
+	}
+
+	return m.GetUserDataFunc(ctx, serverID)
+}
+
+// GetVNCUrl records the call and calls GetVNCUrlFunc
+func (m *BareMetalServerService) GetVNCUrl(ctx context.Context, serverID string) (*govultr.VNCUrl, *http.Response, error) {
+	m.record("GetVNCUrl", ctx, serverID)
+	if m.GetVNCUrlFunc == nil {
+		var r0 *govultr.VNCUrl
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "GetVNCUrl")
+	}
+
+	return m.GetVNCUrlFunc(ctx, serverID)
+}
+
+// ListIPv4s records the call and calls ListIPv4sFunc
+func (m *BareMetalServerService) ListIPv4s(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *http.Response, error) {
+	m.record("ListIPv4s", ctx, serverID, options)
+	if m.ListIPv4sFunc == nil {
+		var r0 []govultr.IPv4
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BareMetalServerService", "ListIPv4s")
+	}
+
+	return m.ListIPv4sFunc(ctx, serverID, options)
+}
+
+// ListIPv6s records the call and calls ListIPv6sFunc
+func (m *BareMetalServerService) ListIPv6s(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *http.Response, error) {
+	m.record("ListIPv6s", ctx, serverID, options)
+	if m.ListIPv6sFunc == nil {
+		var r0 []govultr.IPv6
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BareMetalServerService", "ListIPv6s")
+	}
+
+	return m.ListIPv6sFunc(ctx, serverID, options)
+}
+
+// Halt records the call and calls HaltFunc
+func (m *BareMetalServerService) Halt(ctx context.Context, serverID string) error {
+	m.record("Halt", ctx, serverID)
+	if m.HaltFunc == nil {
+		return notMocked("BareMetalServerService", "Halt")
+	}
+
+	return m.HaltFunc(ctx, serverID)
+}
+
+// Reboot records the call and calls RebootFunc
+func (m *BareMetalServerService) Reboot(ctx context.Context, serverID string) error {
+	m.record("Reboot", ctx, serverID)
+	if m.RebootFunc == nil {
+		return notMocked("BareMetalServerService", "Reboot")
+	}
+
+	return m.RebootFunc(ctx, serverID)
+}
+
+// Start records the call and calls StartFunc
+func (m *BareMetalServerService) Start(ctx context.Context, serverID string) error {
+	m.record("Start", ctx, serverID)
+	if m.StartFunc == nil {
+		return notMocked("BareMetalServerService", "Start")
+	}
+
+	return m.StartFunc(ctx, serverID)
+}
+
+// Reinstall records the call and calls ReinstallFunc
+func (m *BareMetalServerService) Reinstall(ctx context.Context, serverID string) (*govultr.BareMetalServer, *http.Response, error) {
+	m.record("Reinstall", ctx, serverID)
+	if m.ReinstallFunc == nil {
+		var r0 *govultr.BareMetalServer
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "Reinstall")
+	}
+
+	return m.ReinstallFunc(ctx, serverID)
+}
+
+// MassStart records the call and calls MassStartFunc
+func (m *BareMetalServerService) MassStart(ctx context.Context, serverList []string) error {
+	m.record("MassStart", ctx, serverList)
+	if m.MassStartFunc == nil {
+		return notMocked("BareMetalServerService", "MassStart")
+	}
+
+	return m.MassStartFunc(ctx, serverList)
+}
+
+// MassHalt records the call and calls MassHaltFunc
+func (m *BareMetalServerService) MassHalt(ctx context.Context, serverList []string) error {
+	m.record("MassHalt", ctx, serverList)
+	if m.MassHaltFunc == nil {
+		return notMocked("BareMetalServerService", "MassHalt")
+	}
+
+	return m.MassHaltFunc(ctx, serverList)
+}
+
+// MassReboot records the call and calls MassRebootFunc
+func (m *BareMetalServerService) MassReboot(ctx context.Context, serverList []string) error {
+	m.record("MassReboot", ctx, serverList)
+	if m.MassRebootFunc == nil {
+		return notMocked("BareMetalServerService", "MassReboot")
+	}
+
+	return m.MassRebootFunc(ctx, serverList)
+}
+
+// GetUpgrades records the call and calls GetUpgradesFunc
+func (m *BareMetalServerService) GetUpgrades(ctx context.Context, serverID string) (*govultr.Upgrades, *http.Response, error) {
+	m.record("GetUpgrades", ctx, serverID)
+	if m.GetUpgradesFunc == nil {
+		var r0 *govultr.Upgrades
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "GetUpgrades")
+	}
+
+	return m.GetUpgradesFunc(ctx, serverID)
+}
+
+// ListVPCInfo records the call and calls ListVPCInfoFunc
+func (m *BareMetalServerService) ListVPCInfo(ctx context.Context, serverID string) ([]govultr.VPCInfo, *http.Response, error) {
+	m.record("ListVPCInfo", ctx, serverID)
+	if m.ListVPCInfoFunc == nil {
+		var r0 []govultr.VPCInfo
+		var r1 *http.Response
+		return r0, r1, notMocked("BareMetalServerService", "ListVPCInfo")
+	}
+
+	return m.ListVPCInfoFunc(ctx, serverID)
+}
+
+// AttachVPC records the call and calls AttachVPCFunc
+func (m *BareMetalServerService) AttachVPC(ctx context.Context, serverID, vpcID string) error {
+	m.record("AttachVPC", ctx, serverID, vpcID)
+	if m.AttachVPCFunc == nil {
+		return notMocked("BareMetalServerService", "AttachVPC")
+	}
+
+	return m.AttachVPCFunc(ctx, serverID, vpcID)
+}
+
+// DetachVPC records the call and calls DetachVPCFunc
+func (m *BareMetalServerService) DetachVPC(ctx context.Context, serverID, vpcID string) error {
+	m.record("DetachVPC", ctx, serverID, vpcID)
+	if m.DetachVPCFunc == nil {
+		return notMocked("BareMetalServerService", "DetachVPC")
+	}
+
+	return m.DetachVPCFunc(ctx, serverID, vpcID)
+}
+
+// BillingService is a mock of govultr.BillingService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type BillingService struct {
+	calls
+
+	// ListHistoryFunc is called by ListHistory
+	ListHistoryFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.History, *govultr.Meta, *http.Response, error)
+
+	// ListInvoicesFunc is called by ListInvoices
+	ListInvoicesFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Invoice, *govultr.Meta, *http.Response, error)
+
+	// GetInvoiceFunc is called by GetInvoice
+	GetInvoiceFunc func(ctx context.Context, invoiceID string) (*govultr.Invoice, *http.Response, error)
+
+	// ListInvoiceItemsFunc is called by ListInvoiceItems
+	ListInvoiceItemsFunc func(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *http.Response, error)
+
+	// ListPendingChargesFunc is called by ListPendingCharges
+	ListPendingChargesFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error)
+}
+
+var _ govultr.BillingService = (*BillingService)(nil)
+
+// ListHistory records the call and calls ListHistoryFunc
+func (m *BillingService) ListHistory(ctx context.Context, options *govultr.ListOptions) ([]govultr.History, *govultr.Meta, *http.Response, error) {
+	m.record("ListHistory", ctx, options)
+	if m.ListHistoryFunc == nil {
+		var r0 []govultr.History
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BillingService", "ListHistory")
+	}
+
+	return m.ListHistoryFunc(ctx, options)
+}
+
+// ListInvoices records the call and calls ListInvoicesFunc
+func (m *BillingService) ListInvoices(ctx context.Context, options *govultr.ListOptions) ([]govultr.Invoice, *govultr.Meta, *http.Response, error) {
+	m.record("ListInvoices", ctx, options)
+	if m.ListInvoicesFunc == nil {
+		var r0 []govultr.Invoice
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BillingService", "ListInvoices")
+	}
+
+	return m.ListInvoicesFunc(ctx, options)
+}
+
+// GetInvoice records the call and calls GetInvoiceFunc
+func (m *BillingService) GetInvoice(ctx context.Context, invoiceID string) (*govultr.Invoice, *http.Response, error) {
+	m.record("GetInvoice", ctx, invoiceID)
+	if m.GetInvoiceFunc == nil {
+		var r0 *govultr.Invoice
+		var r1 *http.Response
+		return r0, r1, notMocked("BillingService", "GetInvoice")
+	}
+
+	return m.GetInvoiceFunc(ctx, invoiceID)
+}
+
+// ListInvoiceItems records the call and calls ListInvoiceItemsFunc
+func (m *BillingService) ListInvoiceItems(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *http.Response, error) {
+	m.record("ListInvoiceItems", ctx, invoiceID, options)
+	if m.ListInvoiceItemsFunc == nil {
+		var r0 []govultr.InvoiceItem
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BillingService", "ListInvoiceItems")
+	}
+
+	return m.ListInvoiceItemsFunc(ctx, invoiceID, options)
+}
+
+// ListPendingCharges records the call and calls ListPendingChargesFunc
+func (m *BillingService) ListPendingCharges(ctx context.Context, options *govultr.ListOptions) ([]govultr.InvoiceItem, *http.Response, error) {
+	m.record("ListPendingCharges", ctx, options)
+	if m.ListPendingChargesFunc == nil {
+		var r0 []govultr.InvoiceItem
+		var r1 *http.Response
+		return r0, r1, notMocked("BillingService", "ListPendingCharges")
+	}
+
+	return m.ListPendingChargesFunc(ctx, options)
+}
+
+// BlockStorageService is a mock of govultr.BlockStorageService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type BlockStorageService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, blockReq *govultr.BlockStorageCreate) (*govultr.BlockStorage, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, blockID string) (*govultr.BlockStorage, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, blockID string, blockReq *govultr.BlockStorageUpdate) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, blockID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *http.Response, error)
+
+	// AttachFunc is called by Attach
+	AttachFunc func(ctx context.Context, blockID string, attach *govultr.BlockStorageAttach) error
+
+	// DetachFunc is called by Detach
+	DetachFunc func(ctx context.Context, blockID string, detach *govultr.BlockStorageDetach) error
+
+	// ListSnapshotsFunc is called by ListSnapshots
+	ListSnapshotsFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorageSnapshot, *govultr.Meta, *http.Response, error)
+
+	// GetSnapshotFunc is called by GetSnapshot
+	GetSnapshotFunc func(ctx context.Context, snapshotID string) (*govultr.BlockStorageSnapshot, *http.Response, error)
+
+	// CreateSnapshotFunc is called by CreateSnapshot
+	CreateSnapshotFunc func(ctx context.Context, snapshotReq *govultr.BlockStorageSnapshotReq) (*govultr.BlockStorageSnapshot, *http.Response, error)
+
+	// UpdateSnapshotFunc is called by UpdateSnapshot
+	UpdateSnapshotFunc func(ctx context.Context, snapshotID string, snapshotReq *govultr.BlockStorageSnapshotReq) error
+
+	// DeleteSnapshotFunc is called by DeleteSnapshot
+	DeleteSnapshotFunc func(ctx context.Context, snapshotID string) error
+}
+
+var _ govultr.BlockStorageService = (*BlockStorageService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *BlockStorageService) Create(ctx context.Context, blockReq *govultr.BlockStorageCreate) (*govultr.BlockStorage, *http.Response, error) {
+	m.record("Create", ctx, blockReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.BlockStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("BlockStorageService", "Create")
+	}
+
+	return m.CreateFunc(ctx, blockReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *BlockStorageService) Get(ctx context.Context, blockID string) (*govultr.BlockStorage, *http.Response, error) {
+	m.record("Get", ctx, blockID)
+	if m.GetFunc == nil {
+		var r0 *govultr.BlockStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("BlockStorageService", "Get")
+	}
+
+	return m.GetFunc(ctx, blockID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *BlockStorageService) Update(ctx context.Context, blockID string, blockReq *govultr.BlockStorageUpdate) error {
+	m.record("Update", ctx, blockID, blockReq)
+	if m.UpdateFunc == nil {
+		return notMocked("BlockStorageService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, blockID, blockReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *BlockStorageService) Delete(ctx context.Context, blockID string) error {
+	m.record("Delete", ctx, blockID)
+	if m.DeleteFunc == nil {
+		return notMocked("BlockStorageService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, blockID)
+}
+
+// List records the call and calls ListFunc
+func (m *BlockStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.BlockStorage
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BlockStorageService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Attach records the call and calls AttachFunc
+func (m *BlockStorageService) Attach(ctx context.Context, blockID string, attach *govultr.BlockStorageAttach) error {
+	m.record("Attach", ctx, blockID, attach)
+	if m.AttachFunc == nil {
+		return notMocked("BlockStorageService", "Attach")
+	}
+
+	return m.AttachFunc(ctx, blockID, attach)
+}
+
+// Detach records the call and calls DetachFunc
+func (m *BlockStorageService) Detach(ctx context.Context, blockID string, detach *govultr.BlockStorageDetach) error {
+	m.record("Detach", ctx, blockID, detach)
+	if m.DetachFunc == nil {
+		return notMocked("BlockStorageService", "Detach")
+	}
+
+	return m.DetachFunc(ctx, blockID, detach)
+}
+
+// ListSnapshots records the call and calls ListSnapshotsFunc
+func (m *BlockStorageService) ListSnapshots(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorageSnapshot, *govultr.Meta, *http.Response, error) {
+	m.record("ListSnapshots", ctx, options)
+	if m.ListSnapshotsFunc == nil {
+		var r0 []govultr.BlockStorageSnapshot
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("BlockStorageService", "ListSnapshots")
+	}
+
+	return m.ListSnapshotsFunc(ctx, options)
+}
+
+// GetSnapshot records the call and calls GetSnapshotFunc
+func (m *BlockStorageService) GetSnapshot(ctx context.Context, snapshotID string) (*govultr.BlockStorageSnapshot, *http.Response, error) {
+	m.record("GetSnapshot", ctx, snapshotID)
+	if m.GetSnapshotFunc == nil {
+		var r0 *govultr.BlockStorageSnapshot
+		var r1 *http.Response
+		return r0, r1, notMocked("BlockStorageService", "GetSnapshot")
+	}
+
+	return m.GetSnapshotFunc(ctx, snapshotID)
+}
+
+// CreateSnapshot records the call and calls CreateSnapshotFunc
+func (m *BlockStorageService) CreateSnapshot(ctx context.Context, snapshotReq *govultr.BlockStorageSnapshotReq) (*govultr.BlockStorageSnapshot, *http.Response, error) {
+	m.record("CreateSnapshot", ctx, snapshotReq)
+	if m.CreateSnapshotFunc == nil {
+		var r0 *govultr.BlockStorageSnapshot
+		var r1 *http.Response
+		return r0, r1, notMocked("BlockStorageService", "CreateSnapshot")
+	}
+
+	return m.CreateSnapshotFunc(ctx, snapshotReq)
+}
+
+// UpdateSnapshot records the call and calls UpdateSnapshotFunc
+func (m *BlockStorageService) UpdateSnapshot(ctx context.Context, snapshotID string, snapshotReq *govultr.BlockStorageSnapshotReq) error {
+	m.record("UpdateSnapshot", ctx, snapshotID, snapshotReq)
+	if m.UpdateSnapshotFunc == nil {
+		return notMocked("BlockStorageService", "UpdateSnapshot")
+	}
+
+	return m.UpdateSnapshotFunc(ctx, snapshotID, snapshotReq)
+}
+
+// DeleteSnapshot records the call and calls DeleteSnapshotFunc
+func (m *BlockStorageService) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	m.record("DeleteSnapshot", ctx, snapshotID)
+	if m.DeleteSnapshotFunc == nil {
+		return notMocked("BlockStorageService", "DeleteSnapshot")
+	}
+
+	return m.DeleteSnapshotFunc(ctx, snapshotID)
+}
+
+// CDNService is a mock of govultr.CDNService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type CDNService struct {
+	calls
+
+	// ListPullZonesFunc is called by ListPullZones
+	ListPullZonesFunc func(ctx context.Context) ([]govultr.CDNZone, *govultr.Meta, *http.Response, error)
+
+	// GetPullZoneFunc is called by GetPullZone
+	GetPullZoneFunc func(ctx context.Context, zoneID string) (*govultr.CDNZone, *http.Response, error)
+
+	// CreatePullZoneFunc is called by CreatePullZone
+	CreatePullZoneFunc func(ctx context.Context, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error)
+
+	// UpdatePullZoneFunc is called by UpdatePullZone
+	UpdatePullZoneFunc func(ctx context.Context, zoneID string, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error)
+
+	// DeletePullZoneFunc is called by DeletePullZone
+	DeletePullZoneFunc func(ctx context.Context, zoneID string) error
+
+	// PurgePullZoneFunc is called by PurgePullZone
+	PurgePullZoneFunc func(ctx context.Context, zoneID string) error
+
+	// ListPushZonesFunc is called by ListPushZones
+	ListPushZonesFunc func(ctx context.Context) ([]govultr.CDNZone, *govultr.Meta, *http.Response, error)
+
+	// GetPushZoneFunc is called by GetPushZone
+	GetPushZoneFunc func(ctx context.Context, zoneID string) (*govultr.CDNZone, *http.Response, error)
+
+	// CreatePushZoneFunc is called by CreatePushZone
+	CreatePushZoneFunc func(ctx context.Context, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error)
+
+	// UpdatePushZoneFunc is called by UpdatePushZone
+	UpdatePushZoneFunc func(ctx context.Context, zoneID string, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error)
+
+	// DeletePushZoneFunc is called by DeletePushZone
+	DeletePushZoneFunc func(ctx context.Context, zoneID string) error
+
+	// ListPushZoneFilesFunc is called by ListPushZoneFiles
+	ListPushZoneFilesFunc func(ctx context.Context, zoneID string) (*govultr.CDNZoneFileData, *http.Response, error)
+
+	// GetPushZoneFileFunc is called by GetPushZoneFile
+	GetPushZoneFileFunc func(ctx context.Context, zoneID, fileName string) (*govultr.CDNZoneFile, *http.Response, error)
+
+	// DeletePushZoneFileFunc is called by DeletePushZoneFile
+	DeletePushZoneFileFunc func(ctx context.Context, zoneID, fileName string) error
+
+	// CreatePushZoneFileEndpointFunc is called by CreatePushZoneFileEndpoint
+	CreatePushZoneFileEndpointFunc func(ctx context.Context, zoneID string, endpointReq *govultr.CDNZoneEndpointReq) (*govultr.CDNZoneEndpoint, *http.Response, error)
+}
+
+var _ govultr.CDNService = (*CDNService)(nil)
+
+// ListPullZones records the call and calls ListPullZonesFunc
+func (m *CDNService) ListPullZones(ctx context.Context) ([]govultr.CDNZone, *govultr.Meta, *http.Response, error) {
+	m.record("ListPullZones", ctx)
+	if m.ListPullZonesFunc == nil {
+		var r0 []govultr.CDNZone
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("CDNService", "ListPullZones")
+	}
+
+	return m.ListPullZonesFunc(ctx)
+}
+
+// GetPullZone records the call and calls GetPullZoneFunc
+func (m *CDNService) GetPullZone(ctx context.Context, zoneID string) (*govultr.CDNZone, *http.Response, error) {
+	m.record("GetPullZone", ctx, zoneID)
+	if m.GetPullZoneFunc == nil {
+		var r0 *govultr.CDNZone
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "GetPullZone")
+	}
+
+	return m.GetPullZoneFunc(ctx, zoneID)
+}
+
+// CreatePullZone records the call and calls CreatePullZoneFunc
+func (m *CDNService) CreatePullZone(ctx context.Context, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error) {
+	m.record("CreatePullZone", ctx, zoneReq)
+	if m.CreatePullZoneFunc == nil {
+		var r0 *govultr.CDNZone
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "CreatePullZone")
+	}
+
+	return m.CreatePullZoneFunc(ctx, zoneReq)
+}
+
+// UpdatePullZone records the call and calls UpdatePullZoneFunc
+func (m *CDNService) UpdatePullZone(ctx context.Context, zoneID string, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error) {
+	m.record("UpdatePullZone", ctx, zoneID, zoneReq)
+	if m.UpdatePullZoneFunc == nil {
+		var r0 *govultr.CDNZone
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "UpdatePullZone")
+	}
+
+	return m.UpdatePullZoneFunc(ctx, zoneID, zoneReq)
+}
+
+// DeletePullZone records the call and calls DeletePullZoneFunc
+func (m *CDNService) DeletePullZone(ctx context.Context, zoneID string) error {
+	m.record("DeletePullZone", ctx, zoneID)
+	if m.DeletePullZoneFunc == nil {
+		return notMocked("CDNService", "DeletePullZone")
+	}
+
+	return m.DeletePullZoneFunc(ctx, zoneID)
+}
+
+// PurgePullZone records the call and calls PurgePullZoneFunc
+func (m *CDNService) PurgePullZone(ctx context.Context, zoneID string) error {
+	m.record("PurgePullZone", ctx, zoneID)
+	if m.PurgePullZoneFunc == nil {
+		return notMocked("CDNService", "PurgePullZone")
+	}
+
+	return m.PurgePullZoneFunc(ctx, zoneID)
+}
+
+// ListPushZones records the call and calls ListPushZonesFunc
+func (m *CDNService) ListPushZones(ctx context.Context) ([]govultr.CDNZone, *govultr.Meta, *http.Response, error) {
+	m.record("ListPushZones", ctx)
+	if m.ListPushZonesFunc == nil {
+		var r0 []govultr.CDNZone
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("CDNService", "ListPushZones")
+	}
+
+	return m.ListPushZonesFunc(ctx)
+}
+
+// GetPushZone records the call and calls GetPushZoneFunc
+func (m *CDNService) GetPushZone(ctx context.Context, zoneID string) (*govultr.CDNZone, *http.Response, error) {
+	m.record("GetPushZone", ctx, zoneID)
+	if m.GetPushZoneFunc == nil {
+		var r0 *govultr.CDNZone
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "GetPushZone")
+	}
+
+	return m.GetPushZoneFunc(ctx, zoneID)
+}
+
+// CreatePushZone records the call and calls CreatePushZoneFunc
+func (m *CDNService) CreatePushZone(ctx context.Context, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error) {
+	m.record("CreatePushZone", ctx, zoneReq)
+	if m.CreatePushZoneFunc == nil {
+		var r0 *govultr.CDNZone
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "CreatePushZone")
+	}
+
+	return m.CreatePushZoneFunc(ctx, zoneReq)
+}
+
+// UpdatePushZone records the call and calls UpdatePushZoneFunc
+func (m *CDNService) UpdatePushZone(ctx context.Context, zoneID string, zoneReq *govultr.CDNZoneReq) (*govultr.CDNZone, *http.Response, error) {
+	m.record("UpdatePushZone", ctx, zoneID, zoneReq)
+	if m.UpdatePushZoneFunc == nil {
+		var r0 *govultr.CDNZone
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "UpdatePushZone")
+	}
+
+	return m.UpdatePushZoneFunc(ctx, zoneID, zoneReq)
+}
+
+// DeletePushZone records the call and calls DeletePushZoneFunc
+func (m *CDNService) DeletePushZone(ctx context.Context, zoneID string) error {
+	m.record("DeletePushZone", ctx, zoneID)
+	if m.DeletePushZoneFunc == nil {
+		return notMocked("CDNService", "DeletePushZone")
+	}
+
+	return m.DeletePushZoneFunc(ctx, zoneID)
+}
+
+// ListPushZoneFiles records the call and calls ListPushZoneFilesFunc
+func (m *CDNService) ListPushZoneFiles(ctx context.Context, zoneID string) (*govultr.CDNZoneFileData, *http.Response, error) {
+	m.record("ListPushZoneFiles", ctx, zoneID)
+	if m.ListPushZoneFilesFunc == nil {
+		var r0 *govultr.CDNZoneFileData
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "ListPushZoneFiles")
+	}
+
+	return m.ListPushZoneFilesFunc(ctx, zoneID)
+}
+
+// GetPushZoneFile records the call and calls GetPushZoneFileFunc
+func (m *CDNService) GetPushZoneFile(ctx context.Context, zoneID, fileName string) (*govultr.CDNZoneFile, *http.Response, error) {
+	m.record("GetPushZoneFile", ctx, zoneID, fileName)
+	if m.GetPushZoneFileFunc == nil {
+		var r0 *govultr.CDNZoneFile
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "GetPushZoneFile")
+	}
+
+	return m.GetPushZoneFileFunc(ctx, zoneID, fileName)
+}
+
+// DeletePushZoneFile records the call and calls DeletePushZoneFileFunc
+func (m *CDNService) DeletePushZoneFile(ctx context.Context, zoneID, fileName string) error {
+	m.record("DeletePushZoneFile", ctx, zoneID, fileName)
+	if m.DeletePushZoneFileFunc == nil {
+		return notMocked("CDNService", "DeletePushZoneFile")
+	}
+
+	return m.DeletePushZoneFileFunc(ctx, zoneID, fileName)
+}
+
+// CreatePushZoneFileEndpoint records the call and calls CreatePushZoneFileEndpointFunc
+func (m *CDNService) CreatePushZoneFileEndpoint(ctx context.Context, zoneID string, endpointReq *govultr.CDNZoneEndpointReq) (*govultr.CDNZoneEndpoint, *http.Response, error) {
+	m.record("CreatePushZoneFileEndpoint", ctx, zoneID, endpointReq)
+	if m.CreatePushZoneFileEndpointFunc == nil {
+		var r0 *govultr.CDNZoneEndpoint
+		var r1 *http.Response
+		return r0, r1, notMocked("CDNService", "CreatePushZoneFileEndpoint")
+	}
+
+	return m.CreatePushZoneFileEndpointFunc(ctx, zoneID, endpointReq)
+}
+
+// ContainerRegistryService is a mock of govultr.ContainerRegistryService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type ContainerRegistryService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, createReq *govultr.ContainerRegistryReq) (*govultr.ContainerRegistry, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, vcrID string) (*govultr.ContainerRegistry, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, vcrID string, updateReq *govultr.ContainerRegistryUpdateReq) (*govultr.ContainerRegistry, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, vcrID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ContainerRegistry, *govultr.Meta, *http.Response, error)
+
+	// ListRepositoriesFunc is called by ListRepositories
+	ListRepositoriesFunc func(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryRepo, *govultr.Meta, *http.Response, error)
+
+	// GetRepositoryFunc is called by GetRepository
+	GetRepositoryFunc func(ctx context.Context, vcrID, imageName string) (*govultr.ContainerRegistryRepo, *http.Response, error)
+
+	// UpdateRepositoryFunc is called by UpdateRepository
+	UpdateRepositoryFunc func(ctx context.Context, vcrID, imageName string, updateReq *govultr.ContainerRegistryRepoUpdateReq) (*govultr.ContainerRegistryRepo, *http.Response, error)
+
+	// DeleteRepositoryFunc is called by DeleteRepository
+	DeleteRepositoryFunc func(ctx context.Context, vcrID, imageName string) error
+
+	// ListReplicationsFunc is called by ListReplications
+	ListReplicationsFunc func(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryReplication, *govultr.Meta, *http.Response, error)
+
+	// CreateReplicationFunc is called by CreateReplication
+	CreateReplicationFunc func(ctx context.Context, vcrID, regionID string) (*govultr.ContainerRegistryReplication, *http.Response, error)
+
+	// GetReplicationFunc is called by GetReplication
+	GetReplicationFunc func(ctx context.Context, vcrID, regionID string) (*govultr.ContainerRegistryReplication, *http.Response, error)
+
+	// DeleteReplicationFunc is called by DeleteReplication
+	DeleteReplicationFunc func(ctx context.Context, vcrID, regionID string) error
+
+	// UpdateRetentionScheduleFunc is called by UpdateRetentionSchedule
+	UpdateRetentionScheduleFunc func(ctx context.Context, vcrID, schedule string) (*govultr.ContainerRegistryRetentionSchedule, *http.Response, error)
+
+	// ExecuteRetentionFunc is called by ExecuteRetention
+	ExecuteRetentionFunc func(ctx context.Context, vcrID string, dryRun bool) (*govultr.ContainerRegistryRetentionExecution, *http.Response, error)
+
+	// ListRetentionRulesFunc is called by ListRetentionRules
+	ListRetentionRulesFunc func(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryRetentionRule, *govultr.Meta, *http.Response, error)
+
+	// GetRetentionRuleFunc is called by GetRetentionRule
+	GetRetentionRuleFunc func(ctx context.Context, vcrID string, ruleID int) (*govultr.ContainerRegistryRetentionRule, *http.Response, error)
+
+	// CreateRetentionRuleFunc is called by CreateRetentionRule
+	CreateRetentionRuleFunc func(ctx context.Context, vcrID string, ruleReq *govultr.ContainerRegistryRetentionRuleReq) (*govultr.ContainerRegistryRetentionRule, *http.Response, error)
+
+	// UpdateRetentionRuleFunc is called by UpdateRetentionRule
+	UpdateRetentionRuleFunc func(ctx context.Context, vcrID string, ruleID int, disabled bool) (*govultr.ContainerRegistryRetentionRule, *http.Response, error)
+
+	// DeleteRetentionRuleFunc is called by DeleteRetentionRule
+	DeleteRetentionRuleFunc func(ctx context.Context, vcrID string, ruleID int) error
+
+	// ListRobotsFunc is called by ListRobots
+	ListRobotsFunc func(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryRobot, *govultr.Meta, *http.Response, error)
+
+	// GetRobotFunc is called by GetRobot
+	GetRobotFunc func(ctx context.Context, vcrID, robotName string) (*govultr.ContainerRegistryRobot, *http.Response, error)
+
+	// UpdateRobotFunc is called by UpdateRobot
+	UpdateRobotFunc func(ctx context.Context, vcrID, robotName string, updateReq *govultr.ContainerRegistryRobotReq) (*govultr.ContainerRegistryRobot, *http.Response, error)
+
+	// DeleteRobotFunc is called by DeleteRobot
+	DeleteRobotFunc func(ctx context.Context, vcrID, robotName string) error
+
+	// ListArtifactsFunc is called by ListArtifacts
+	ListArtifactsFunc func(ctx context.Context, vcrID, imageName string, options *govultr.ListOptions) ([]govultr.ContainerRegistryArtifact, *govultr.Meta, *http.Response, error)
+
+	// GetArtifactFunc is called by GetArtifact
+	GetArtifactFunc func(ctx context.Context, vcrID, imageName, artifactDigest string) (*govultr.ContainerRegistryArtifact, *http.Response, error)
+
+	// DeleteArtifactFunc is called by DeleteArtifact
+	DeleteArtifactFunc func(ctx context.Context, vcrID, imageName, artifactDigest string) error
+
+	// CreateDockerCredentialsFunc is called by CreateDockerCredentials
+	CreateDockerCredentialsFunc func(ctx context.Context, vcrID string, createOptions *govultr.DockerCredentialsOpt) (*govultr.ContainerRegistryDockerCredentials, *http.Response, error)
+
+	// ListRegionsFunc is called by ListRegions
+	ListRegionsFunc func(ctx context.Context) ([]govultr.ContainerRegistryRegion, *govultr.Meta, *http.Response, error)
+
+	// ListPlansFunc is called by ListPlans
+	ListPlansFunc func(ctx context.Context) (*govultr.ContainerRegistryPlans, *http.Response, error)
+}
+
+var _ govultr.ContainerRegistryService = (*ContainerRegistryService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *ContainerRegistryService) Create(ctx context.Context, createReq *govultr.ContainerRegistryReq) (*govultr.ContainerRegistry, *http.Response, error) {
+	m.record("Create", ctx, createReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.ContainerRegistry
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "Create")
+	}
+
+	return m.CreateFunc(ctx, createReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *ContainerRegistryService) Get(ctx context.Context, vcrID string) (*govultr.ContainerRegistry, *http.Response, error) {
+	m.record("Get", ctx, vcrID)
+	if m.GetFunc == nil {
+		var r0 *govultr.ContainerRegistry
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "Get")
+	}
+
+	return m.GetFunc(ctx, vcrID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *ContainerRegistryService) Update(ctx context.Context, vcrID string, updateReq *govultr.ContainerRegistryUpdateReq) (*govultr.ContainerRegistry, *http.Response, error) {
+	m.record("Update", ctx, vcrID, updateReq)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.ContainerRegistry
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, vcrID, updateReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *ContainerRegistryService) Delete(ctx context.Context, vcrID string) error {
+	m.record("Delete", ctx, vcrID)
+	if m.DeleteFunc == nil {
+		return notMocked("ContainerRegistryService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, vcrID)
+}
+
+// List records the call and calls ListFunc
+func (m *ContainerRegistryService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ContainerRegistry, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.ContainerRegistry
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// ListRepositories records the call and calls ListRepositoriesFunc
+func (m *ContainerRegistryService) ListRepositories(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryRepo, *govultr.Meta, *http.Response, error) {
+	m.record("ListRepositories", ctx, vcrID, options)
+	if m.ListRepositoriesFunc == nil {
+		var r0 []govultr.ContainerRegistryRepo
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "ListRepositories")
+	}
+
+	return m.ListRepositoriesFunc(ctx, vcrID, options)
+}
+
+// GetRepository records the call and calls GetRepositoryFunc
+func (m *ContainerRegistryService) GetRepository(ctx context.Context, vcrID, imageName string) (*govultr.ContainerRegistryRepo, *http.Response, error) {
+	m.record("GetRepository", ctx, vcrID, imageName)
+	if m.GetRepositoryFunc == nil {
+		var r0 *govultr.ContainerRegistryRepo
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "GetRepository")
+	}
+
+	return m.GetRepositoryFunc(ctx, vcrID, imageName)
+}
+
+// UpdateRepository records the call and calls UpdateRepositoryFunc
+func (m *ContainerRegistryService) UpdateRepository(ctx context.Context, vcrID, imageName string, updateReq *govultr.ContainerRegistryRepoUpdateReq) (*govultr.ContainerRegistryRepo, *http.Response, error) {
+	m.record("UpdateRepository", ctx, vcrID, imageName, updateReq)
+	if m.UpdateRepositoryFunc == nil {
+		var r0 *govultr.ContainerRegistryRepo
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "UpdateRepository")
+	}
+
+	return m.UpdateRepositoryFunc(ctx, vcrID, imageName, updateReq)
+}
+
+// DeleteRepository records the call and calls DeleteRepositoryFunc
+func (m *ContainerRegistryService) DeleteRepository(ctx context.Context, vcrID, imageName string) error {
+	m.record("DeleteRepository", ctx, vcrID, imageName)
+	if m.DeleteRepositoryFunc == nil {
+		return notMocked("ContainerRegistryService", "DeleteRepository")
+	}
+
+	return m.DeleteRepositoryFunc(ctx, vcrID, imageName)
+}
+
+// ListReplications records the call and calls ListReplicationsFunc
+func (m *ContainerRegistryService) ListReplications(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryReplication, *govultr.Meta, *http.Response, error) {
+	m.record("ListReplications", ctx, vcrID, options)
+	if m.ListReplicationsFunc == nil {
+		var r0 []govultr.ContainerRegistryReplication
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "ListReplications")
+	}
+
+	return m.ListReplicationsFunc(ctx, vcrID, options)
+}
+
+// CreateReplication records the call and calls CreateReplicationFunc
+func (m *ContainerRegistryService) CreateReplication(ctx context.Context, vcrID, regionID string) (*govultr.ContainerRegistryReplication, *http.Response, error) {
+	m.record("CreateReplication", ctx, vcrID, regionID)
+	if m.CreateReplicationFunc == nil {
+		var r0 *govultr.ContainerRegistryReplication
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "CreateReplication")
+	}
+
+	return m.CreateReplicationFunc(ctx, vcrID, regionID)
+}
+
+// GetReplication records the call and calls GetReplicationFunc
+func (m *ContainerRegistryService) GetReplication(ctx context.Context, vcrID, regionID string) (*govultr.ContainerRegistryReplication, *http.Response, error) {
+	m.record("GetReplication", ctx, vcrID, regionID)
+	if m.GetReplicationFunc == nil {
+		var r0 *govultr.ContainerRegistryReplication
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "GetReplication")
+	}
+
+	return m.GetReplicationFunc(ctx, vcrID, regionID)
+}
+
+// DeleteReplication records the call and calls DeleteReplicationFunc
+func (m *ContainerRegistryService) DeleteReplication(ctx context.Context, vcrID, regionID string) error {
+	m.record("DeleteReplication", ctx, vcrID, regionID)
+	if m.DeleteReplicationFunc == nil {
+		return notMocked("ContainerRegistryService", "DeleteReplication")
+	}
+
+	return m.DeleteReplicationFunc(ctx, vcrID, regionID)
+}
+
+// UpdateRetentionSchedule records the call and calls UpdateRetentionScheduleFunc
+func (m *ContainerRegistryService) UpdateRetentionSchedule(ctx context.Context, vcrID, schedule string) (*govultr.ContainerRegistryRetentionSchedule, *http.Response, error) {
+	m.record("UpdateRetentionSchedule", ctx, vcrID, schedule)
+	if m.UpdateRetentionScheduleFunc == nil {
+		var r0 *govultr.ContainerRegistryRetentionSchedule
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "UpdateRetentionSchedule")
+	}
+
+	return m.UpdateRetentionScheduleFunc(ctx, vcrID, schedule)
+}
+
+// ExecuteRetention records the call and calls ExecuteRetentionFunc
+func (m *ContainerRegistryService) ExecuteRetention(ctx context.Context, vcrID string, dryRun bool) (*govultr.ContainerRegistryRetentionExecution, *http.Response, error) {
+	m.record("ExecuteRetention", ctx, vcrID, dryRun)
+	if m.ExecuteRetentionFunc == nil {
+		var r0 *govultr.ContainerRegistryRetentionExecution
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "ExecuteRetention")
+	}
+
+	return m.ExecuteRetentionFunc(ctx, vcrID, dryRun)
+}
+
+// ListRetentionRules records the call and calls ListRetentionRulesFunc
+func (m *ContainerRegistryService) ListRetentionRules(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryRetentionRule, *govultr.Meta, *http.Response, error) {
+	m.record("ListRetentionRules", ctx, vcrID, options)
+	if m.ListRetentionRulesFunc == nil {
+		var r0 []govultr.ContainerRegistryRetentionRule
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "ListRetentionRules")
+	}
+
+	return m.ListRetentionRulesFunc(ctx, vcrID, options)
+}
+
+// GetRetentionRule records the call and calls GetRetentionRuleFunc
+func (m *ContainerRegistryService) GetRetentionRule(ctx context.Context, vcrID string, ruleID int) (*govultr.ContainerRegistryRetentionRule, *http.Response, error) {
+	m.record("GetRetentionRule", ctx, vcrID, ruleID)
+	if m.GetRetentionRuleFunc == nil {
+		var r0 *govultr.ContainerRegistryRetentionRule
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "GetRetentionRule")
+	}
+
+	return m.GetRetentionRuleFunc(ctx, vcrID, ruleID)
+}
+
+// CreateRetentionRule records the call and calls CreateRetentionRuleFunc
+func (m *ContainerRegistryService) CreateRetentionRule(ctx context.Context, vcrID string, ruleReq *govultr.ContainerRegistryRetentionRuleReq) (*govultr.ContainerRegistryRetentionRule, *http.Response, error) {
+	m.record("CreateRetentionRule", ctx, vcrID, ruleReq)
+	if m.CreateRetentionRuleFunc == nil {
+		var r0 *govultr.ContainerRegistryRetentionRule
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "CreateRetentionRule")
+	}
+
+	return m.CreateRetentionRuleFunc(ctx, vcrID, ruleReq)
+}
+
+// UpdateRetentionRule records the call and calls UpdateRetentionRuleFunc
+func (m *ContainerRegistryService) UpdateRetentionRule(ctx context.Context, vcrID string, ruleID int, disabled bool) (*govultr.ContainerRegistryRetentionRule, *http.Response, error) {
+	m.record("UpdateRetentionRule", ctx, vcrID, ruleID, disabled)
+	if m.UpdateRetentionRuleFunc == nil {
+		var r0 *govultr.ContainerRegistryRetentionRule
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "UpdateRetentionRule")
+	}
+
+	return m.UpdateRetentionRuleFunc(ctx, vcrID, ruleID, disabled)
+}
+
+// DeleteRetentionRule records the call and calls DeleteRetentionRuleFunc
+func (m *ContainerRegistryService) DeleteRetentionRule(ctx context.Context, vcrID string, ruleID int) error {
+	m.record("DeleteRetentionRule", ctx, vcrID, ruleID)
+	if m.DeleteRetentionRuleFunc == nil {
+		return notMocked("ContainerRegistryService", "DeleteRetentionRule")
+	}
+
+	return m.DeleteRetentionRuleFunc(ctx, vcrID, ruleID)
+}
+
+// ListRobots records the call and calls ListRobotsFunc
+func (m *ContainerRegistryService) ListRobots(ctx context.Context, vcrID string, options *govultr.ListOptions) ([]govultr.ContainerRegistryRobot, *govultr.Meta, *http.Response, error) {
+	m.record("ListRobots", ctx, vcrID, options)
+	if m.ListRobotsFunc == nil {
+		var r0 []govultr.ContainerRegistryRobot
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "ListRobots")
+	}
+
+	return m.ListRobotsFunc(ctx, vcrID, options)
+}
+
+// GetRobot records the call and calls GetRobotFunc
+func (m *ContainerRegistryService) GetRobot(ctx context.Context, vcrID, robotName string) (*govultr.ContainerRegistryRobot, *http.Response, error) {
+	m.record("GetRobot", ctx, vcrID, robotName)
+	if m.GetRobotFunc == nil {
+		var r0 *govultr.ContainerRegistryRobot
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "GetRobot")
+	}
+
+	return m.GetRobotFunc(ctx, vcrID, robotName)
+}
+
+// UpdateRobot records the call and calls UpdateRobotFunc
+func (m *ContainerRegistryService) UpdateRobot(ctx context.Context, vcrID, robotName string, updateReq *govultr.ContainerRegistryRobotReq) (*govultr.ContainerRegistryRobot, *http.Response, error) {
+	m.record("UpdateRobot", ctx, vcrID, robotName, updateReq)
+	if m.UpdateRobotFunc == nil {
+		var r0 *govultr.ContainerRegistryRobot
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "UpdateRobot")
+	}
+
+	return m.UpdateRobotFunc(ctx, vcrID, robotName, updateReq)
+}
+
+// DeleteRobot records the call and calls DeleteRobotFunc
+func (m *ContainerRegistryService) DeleteRobot(ctx context.Context, vcrID, robotName string) error {
+	m.record("DeleteRobot", ctx, vcrID, robotName)
+	if m.DeleteRobotFunc == nil {
+		return notMocked("ContainerRegistryService", "DeleteRobot")
+	}
+
+	return m.DeleteRobotFunc(ctx, vcrID, robotName)
+}
+
+// ListArtifacts records the call and calls ListArtifactsFunc
+func (m *ContainerRegistryService) ListArtifacts(ctx context.Context, vcrID, imageName string, options *govultr.ListOptions) ([]govultr.ContainerRegistryArtifact, *govultr.Meta, *http.Response, error) {
+	m.record("ListArtifacts", ctx, vcrID, imageName, options)
+	if m.ListArtifactsFunc == nil {
+		var r0 []govultr.ContainerRegistryArtifact
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "ListArtifacts")
+	}
+
+	return m.ListArtifactsFunc(ctx, vcrID, imageName, options)
+}
+
+// GetArtifact records the call and calls GetArtifactFunc
+func (m *ContainerRegistryService) GetArtifact(ctx context.Context, vcrID, imageName, artifactDigest string) (*govultr.ContainerRegistryArtifact, *http.Response, error) {
+	m.record("GetArtifact", ctx, vcrID, imageName, artifactDigest)
+	if m.GetArtifactFunc == nil {
+		var r0 *govultr.ContainerRegistryArtifact
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "GetArtifact")
+	}
+
+	return m.GetArtifactFunc(ctx, vcrID, imageName, artifactDigest)
+}
+
+// DeleteArtifact records the call and calls DeleteArtifactFunc
+func (m *ContainerRegistryService) DeleteArtifact(ctx context.Context, vcrID, imageName, artifactDigest string) error {
+	m.record("DeleteArtifact", ctx, vcrID, imageName, artifactDigest)
+	if m.DeleteArtifactFunc == nil {
+		return notMocked("ContainerRegistryService", "DeleteArtifact")
+	}
+
+	return m.DeleteArtifactFunc(ctx, vcrID, imageName, artifactDigest)
+}
+
+// CreateDockerCredentials records the call and calls CreateDockerCredentialsFunc
+func (m *ContainerRegistryService) CreateDockerCredentials(ctx context.Context, vcrID string, createOptions *govultr.DockerCredentialsOpt) (*govultr.ContainerRegistryDockerCredentials, *http.Response, error) {
+	m.record("CreateDockerCredentials", ctx, vcrID, createOptions)
+	if m.CreateDockerCredentialsFunc == nil {
+		var r0 *govultr.ContainerRegistryDockerCredentials
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "CreateDockerCredentials")
+	}
+
+	return m.CreateDockerCredentialsFunc(ctx, vcrID, createOptions)
+}
+
+// ListRegions records the call and calls ListRegionsFunc
+func (m *ContainerRegistryService) ListRegions(ctx context.Context) ([]govultr.ContainerRegistryRegion, *govultr.Meta, *http.Response, error) {
+	m.record("ListRegions", ctx)
+	if m.ListRegionsFunc == nil {
+		var r0 []govultr.ContainerRegistryRegion
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ContainerRegistryService", "ListRegions")
+	}
+
+	return m.ListRegionsFunc(ctx)
+}
+
+// ListPlans records the call and calls ListPlansFunc
+func (m *ContainerRegistryService) ListPlans(ctx context.Context) (*govultr.ContainerRegistryPlans, *http.Response, error) {
+	m.record("ListPlans", ctx)
+	if m.ListPlansFunc == nil {
+		var r0 *govultr.ContainerRegistryPlans
+		var r1 *http.Response
+		return r0, r1, notMocked("ContainerRegistryService", "ListPlans")
+	}
+
+	return m.ListPlansFunc(ctx)
+}
+
+// DatabaseService is a mock of govultr.DatabaseService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type DatabaseService struct {
+	calls
+
+	// ListPlansFunc is called by ListPlans
+	ListPlansFunc func(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *http.Response, error)
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *http.Response, error)
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, databaseReq *govultr.DatabaseCreateReq) (*govultr.Database, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, databaseID string) (*govultr.Database, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, databaseID string, databaseReq *govultr.DatabaseUpdateReq) (*govultr.Database, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, databaseID string) error
+
+	// GetUsageFunc is called by GetUsage
+	GetUsageFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseUsage, *http.Response, error)
+
+	// ListUsersFunc is called by ListUsers
+	ListUsersFunc func(ctx context.Context, databaseID string) ([]govultr.DatabaseUser, *govultr.Meta, *http.Response, error)
+
+	// CreateUserFunc is called by CreateUser
+	CreateUserFunc func(ctx context.Context, databaseID string, databaseUserReq *govultr.DatabaseUserCreateReq) (*govultr.DatabaseUser, *http.Response, error)
+
+	// GetUserFunc is called by GetUser
+	GetUserFunc func(ctx context.Context, databaseID string, username string) (*govultr.DatabaseUser, *http.Response, error)
+
+	// UpdateUserFunc is called by UpdateUser
+	UpdateUserFunc func(ctx context.Context, databaseID string, username string, databaseUserReq *govultr.DatabaseUserUpdateReq) (*govultr.DatabaseUser, *http.Response, error)
+
+	// DeleteUserFunc is called by DeleteUser
+	DeleteUserFunc func(ctx context.Context, databaseID string, username string) error
+
+	// UpdateUserACLFunc is called by UpdateUserACL
+	UpdateUserACLFunc func(ctx context.Context, databaseID string, username string, databaseUserACLReq *govultr.DatabaseUserACLReq) (*govultr.DatabaseUser, *http.Response, error)
+
+	// ListDBsFunc is called by ListDBs
+	ListDBsFunc func(ctx context.Context, databaseID string) ([]govultr.DatabaseDB, *govultr.Meta, *http.Response, error)
+
+	// CreateDBFunc is called by CreateDB
+	CreateDBFunc func(ctx context.Context, databaseID string, databaseDBReq *govultr.DatabaseDBCreateReq) (*govultr.DatabaseDB, *http.Response, error)
+
+	// GetDBFunc is called by GetDB
+	GetDBFunc func(ctx context.Context, databaseID string, dbname string) (*govultr.DatabaseDB, *http.Response, error)
+
+	// DeleteDBFunc is called by DeleteDB
+	DeleteDBFunc func(ctx context.Context, databaseID string, dbname string) error
+
+	// ListTopicsFunc is called by ListTopics
+	ListTopicsFunc func(ctx context.Context, databaseID string) ([]govultr.DatabaseTopic, *govultr.Meta, *http.Response, error)
+
+	// CreateTopicFunc is called by CreateTopic
+	CreateTopicFunc func(ctx context.Context, databaseID string, databaseTopicReq *govultr.DatabaseTopicCreateReq) (*govultr.DatabaseTopic, *http.Response, error)
+
+	// GetTopicFunc is called by GetTopic
+	GetTopicFunc func(ctx context.Context, databaseID string, topicName string) (*govultr.DatabaseTopic, *http.Response, error)
+
+	// UpdateTopicFunc is called by UpdateTopic
+	UpdateTopicFunc func(ctx context.Context, databaseID string, topicName string, databaseTopicReq *govultr.DatabaseTopicUpdateReq) (*govultr.DatabaseTopic, *http.Response, error)
+
+	// DeleteTopicFunc is called by DeleteTopic
+	DeleteTopicFunc func(ctx context.Context, databaseID string, topicName string) error
+
+	// ListQuotasFunc is called by ListQuotas
+	ListQuotasFunc func(ctx context.Context, databaseID string) ([]govultr.DatabaseQuota, *govultr.Meta, *http.Response, error)
+
+	// CreateQuotaFunc is called by CreateQuota
+	CreateQuotaFunc func(ctx context.Context, databaseID string, databaseQuotaReq *govultr.DatabaseQuotaCreateReq) (*govultr.DatabaseQuota, *http.Response, error)
+
+	// GetQuotaFunc is called by GetQuota
+	GetQuotaFunc func(ctx context.Context, databaseID string, clientID, username string) (*govultr.DatabaseQuota, *http.Response, error)
+
+	// UpdateQuotaFunc is called by UpdateQuota
+	UpdateQuotaFunc func(ctx context.Context, databaseID string, clientID, username string, databaseQuotaReq *govultr.DatabaseQuotaUpdateReq) (*govultr.DatabaseQuota, *http.Response, error)
+
+	// DeleteQuotaFunc is called by DeleteQuota
+	DeleteQuotaFunc func(ctx context.Context, databaseID string, clientID, username string) error
+
+	// ListMaintenanceUpdatesFunc is called by ListMaintenanceUpdates
+	ListMaintenanceUpdatesFunc func(ctx context.Context, databaseID string) ([]string, *http.Response, error)
+
+	// StartMaintenanceFunc is called by StartMaintenance
+	StartMaintenanceFunc func(ctx context.Context, databaseID string) (string, *http.Response, error)
+
+	// ListAvailableConnectorsFunc is called by ListAvailableConnectors
+	ListAvailableConnectorsFunc func(ctx context.Context, databaseID string) ([]govultr.DatabaseAvailableConnector, *http.Response, error)
+
+	// GetConnectorConfigurationSchemaFunc is called by GetConnectorConfigurationSchema
+	GetConnectorConfigurationSchemaFunc func(ctx context.Context, databaseID string, connectorClass string) ([]govultr.DatabaseConnectorConfigurationOption, *http.Response, error)
+
+	// ListConnectorsFunc is called by ListConnectors
+	ListConnectorsFunc func(ctx context.Context, databaseID string) ([]govultr.DatabaseConnector, *govultr.Meta, *http.Response, error)
+
+	// CreateConnectorFunc is called by CreateConnector
+	CreateConnectorFunc func(ctx context.Context, databaseID string, databaseConnectorReq *govultr.DatabaseConnectorCreateReq) (*govultr.DatabaseConnector, *http.Response, error)
+
+	// GetConnectorFunc is called by GetConnector
+	GetConnectorFunc func(ctx context.Context, databaseID string, connectorName string) (*govultr.DatabaseConnector, *http.Response, error)
+
+	// UpdateConnectorFunc is called by UpdateConnector
+	UpdateConnectorFunc func(ctx context.Context, databaseID string, connectorName string, databaseConnectorReq *govultr.DatabaseConnectorUpdateReq) (*govultr.DatabaseConnector, *http.Response, error)
+
+	// DeleteConnectorFunc is called by DeleteConnector
+	DeleteConnectorFunc func(ctx context.Context, databaseID string, connectorName string) error
+
+	// GetConnectorStatusFunc is called by GetConnectorStatus
+	GetConnectorStatusFunc func(ctx context.Context, databaseID string, connectorName string) (*govultr.DatabaseConnectorStatus, *http.Response, error)
+
+	// RestartConnectorFunc is called by RestartConnector
+	RestartConnectorFunc func(ctx context.Context, databaseID string, connectorName string) error
+
+	// PauseConnectorFunc is called by PauseConnector
+	PauseConnectorFunc func(ctx context.Context, databaseID string, connectorName string) error
+
+	// ResumeConnectorFunc is called by ResumeConnector
+	ResumeConnectorFunc func(ctx context.Context, databaseID string, connectorName string) error
+
+	// RestartConnectorTaskFunc is called by RestartConnectorTask
+	RestartConnectorTaskFunc func(ctx context.Context, databaseID string, connectorName string, taskID int) error
+
+	// ListServiceAlertsFunc is called by ListServiceAlerts
+	ListServiceAlertsFunc func(ctx context.Context, databaseID string, databaseAlertsReq *govultr.DatabaseListAlertsReq) ([]govultr.DatabaseAlert, *http.Response, error)
+
+	// GetMigrationStatusFunc is called by GetMigrationStatus
+	GetMigrationStatusFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseMigration, *http.Response, error)
+
+	// StartMigrationFunc is called by StartMigration
+	StartMigrationFunc func(ctx context.Context, databaseID string, databaseMigrationReq *govultr.DatabaseMigrationStartReq) (*govultr.DatabaseMigration, *http.Response, error)
+
+	// DetachMigrationFunc is called by DetachMigration
+	DetachMigrationFunc func(ctx context.Context, databaseID string) error
+
+	// AddReadOnlyReplicaFunc is called by AddReadOnlyReplica
+	AddReadOnlyReplicaFunc func(ctx context.Context, databaseID string, databaseReplicaReq *govultr.DatabaseAddReplicaReq) (*govultr.Database, *http.Response, error)
+
+	// PromoteReadReplicaFunc is called by PromoteReadReplica
+	PromoteReadReplicaFunc func(ctx context.Context, databaseID string) error
+
+	// GetBackupInformationFunc is called by GetBackupInformation
+	GetBackupInformationFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseBackups, *http.Response, error)
+
+	// RestoreFromBackupFunc is called by RestoreFromBackup
+	RestoreFromBackupFunc func(ctx context.Context, databaseID string, databaseRestoreReq *govultr.DatabaseBackupRestoreReq) (*govultr.Database, *http.Response, error)
+
+	// ForkFunc is called by Fork
+	ForkFunc func(ctx context.Context, databaseID string, databaseForkReq *govultr.DatabaseForkReq) (*govultr.Database, *http.Response, error)
+
+	// ListConnectionPoolsFunc is called by ListConnectionPools
+	ListConnectionPoolsFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseConnections, []govultr.DatabaseConnectionPool, *govultr.Meta, *http.Response, error)
+
+	// CreateConnectionPoolFunc is called by CreateConnectionPool
+	CreateConnectionPoolFunc func(ctx context.Context, databaseID string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolCreateReq) (*govultr.DatabaseConnectionPool, *http.Response, error)
+
+	// GetConnectionPoolFunc is called by GetConnectionPool
+	GetConnectionPoolFunc func(ctx context.Context, databaseID string, poolName string) (*govultr.DatabaseConnectionPool, *http.Response, error)
+
+	// UpdateConnectionPoolFunc is called by UpdateConnectionPool
+	UpdateConnectionPoolFunc func(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolUpdateReq) (*govultr.DatabaseConnectionPool, *http.Response, error)
+
+	// DeleteConnectionPoolFunc is called by DeleteConnectionPool
+	DeleteConnectionPoolFunc func(ctx context.Context, databaseID string, poolName string) error
+
+	// ListAdvancedOptionsFunc is called by ListAdvancedOptions
+	ListAdvancedOptionsFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// UpdateAdvancedOptionsFunc is called by UpdateAdvancedOptions
+	UpdateAdvancedOptionsFunc func(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *govultr.DatabaseAdvancedOptions) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// ListKafkaRESTAdvancedOptionsFunc is called by ListKafkaRESTAdvancedOptions
+	ListKafkaRESTAdvancedOptionsFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseKafkaRESTAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// UpdateKafkaRESTAdvancedOptionsFunc is called by UpdateKafkaRESTAdvancedOptions
+	UpdateKafkaRESTAdvancedOptionsFunc func(ctx context.Context, databaseID string, databaseKafkaRESTAdvancedOptionsReq *govultr.DatabaseKafkaRESTAdvancedOptions) (*govultr.DatabaseKafkaRESTAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// ListSchemaRegistryAdvancedOptionsFunc is called by ListSchemaRegistryAdvancedOptions
+	ListSchemaRegistryAdvancedOptionsFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseSchemaRegistryAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// UpdateSchemaRegistryAdvancedOptionsFunc is called by UpdateSchemaRegistryAdvancedOptions
+	UpdateSchemaRegistryAdvancedOptionsFunc func(ctx context.Context, databaseID string, databaseSchemaRegistryAdvancedOptionsReq *govultr.DatabaseSchemaRegistryAdvancedOptions) (*govultr.DatabaseSchemaRegistryAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// ListKafkaConnectAdvancedOptionsFunc is called by ListKafkaConnectAdvancedOptions
+	ListKafkaConnectAdvancedOptionsFunc func(ctx context.Context, databaseID string) (*govultr.DatabaseKafkaConnectAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// UpdateKafkaConnectAdvancedOptionsFunc is called by UpdateKafkaConnectAdvancedOptions
+	UpdateKafkaConnectAdvancedOptionsFunc func(ctx context.Context, databaseID string, databaseKafkaConnectAdvancedOptionsReq *govultr.DatabaseKafkaConnectAdvancedOptions) (*govultr.DatabaseKafkaConnectAdvancedOptions, []govultr.AvailableOption, *http.Response, error)
+
+	// ListAvailableVersionsFunc is called by ListAvailableVersions
+	ListAvailableVersionsFunc func(ctx context.Context, databaseID string) ([]string, *http.Response, error)
+
+	// StartVersionUpgradeFunc is called by StartVersionUpgrade
+	StartVersionUpgradeFunc func(ctx context.Context, databaseID string, databaseVersionUpgradeReq *govultr.DatabaseVersionUpgradeReq) (string, *http.Response, error)
+}
+
+var _ govultr.DatabaseService = (*DatabaseService)(nil)
+
+// ListPlans records the call and calls ListPlansFunc
+func (m *DatabaseService) ListPlans(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *http.Response, error) {
+	m.record("ListPlans", ctx, options)
+	if m.ListPlansFunc == nil {
+		var r0 []govultr.DatabasePlan
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListPlans")
+	}
+
+	return m.ListPlansFunc(ctx, options)
+}
+
+// List records the call and calls ListFunc
+func (m *DatabaseService) List(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Database
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Create records the call and calls CreateFunc
+func (m *DatabaseService) Create(ctx context.Context, databaseReq *govultr.DatabaseCreateReq) (*govultr.Database, *http.Response, error) {
+	m.record("Create", ctx, databaseReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.Database
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "Create")
+	}
+
+	return m.CreateFunc(ctx, databaseReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *DatabaseService) Get(ctx context.Context, databaseID string) (*govultr.Database, *http.Response, error) {
+	m.record("Get", ctx, databaseID)
+	if m.GetFunc == nil {
+		var r0 *govultr.Database
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "Get")
+	}
+
+	return m.GetFunc(ctx, databaseID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *DatabaseService) Update(ctx context.Context, databaseID string, databaseReq *govultr.DatabaseUpdateReq) (*govultr.Database, *http.Response, error) {
+	m.record("Update", ctx, databaseID, databaseReq)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.Database
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, databaseID, databaseReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *DatabaseService) Delete(ctx context.Context, databaseID string) error {
+	m.record("Delete", ctx, databaseID)
+	if m.DeleteFunc == nil {
+		return notMocked("DatabaseService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, databaseID)
+}
+
+// GetUsage records the call and calls GetUsageFunc
+func (m *DatabaseService) GetUsage(ctx context.Context, databaseID string) (*govultr.DatabaseUsage, *http.Response, error) {
+	m.record("GetUsage", ctx, databaseID)
+	if m.GetUsageFunc == nil {
+		var r0 *govultr.DatabaseUsage
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetUsage")
+	}
+
+	return m.GetUsageFunc(ctx, databaseID)
+}
+
+// ListUsers records the call and calls ListUsersFunc
+func (m *DatabaseService) ListUsers(ctx context.Context, databaseID string) ([]govultr.DatabaseUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListUsers", ctx, databaseID)
+	if m.ListUsersFunc == nil {
+		var r0 []govultr.DatabaseUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListUsers")
+	}
+
+	return m.ListUsersFunc(ctx, databaseID)
+}
+
+// CreateUser records the call and calls CreateUserFunc
+func (m *DatabaseService) CreateUser(ctx context.Context, databaseID string, databaseUserReq *govultr.DatabaseUserCreateReq) (*govultr.DatabaseUser, *http.Response, error) {
+	m.record("CreateUser", ctx, databaseID, databaseUserReq)
+	if m.CreateUserFunc == nil {
+		var r0 *govultr.DatabaseUser
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "CreateUser")
+	}
+
+	return m.CreateUserFunc(ctx, databaseID, databaseUserReq)
+}
+
+// GetUser records the call and calls GetUserFunc
+func (m *DatabaseService) GetUser(ctx context.Context, databaseID string, username string) (*govultr.DatabaseUser, *http.Response, error) {
+	m.record("GetUser", ctx, databaseID, username)
+	if m.GetUserFunc == nil {
+		var r0 *govultr.DatabaseUser
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetUser")
+	}
+
+	return m.GetUserFunc(ctx, databaseID, username)
+}
+
+// UpdateUser records the call and calls UpdateUserFunc
+func (m *DatabaseService) UpdateUser(ctx context.Context, databaseID string, username string, databaseUserReq *govultr.DatabaseUserUpdateReq) (*govultr.DatabaseUser, *http.Response, error) {
+	m.record("UpdateUser", ctx, databaseID, username, databaseUserReq)
+	if m.UpdateUserFunc == nil {
+		var r0 *govultr.DatabaseUser
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "UpdateUser")
+	}
+
+	return m.UpdateUserFunc(ctx, databaseID, username, databaseUserReq)
+}
+
+// DeleteUser records the call and calls DeleteUserFunc
+func (m *DatabaseService) DeleteUser(ctx context.Context, databaseID string, username string) error {
+	m.record("DeleteUser", ctx, databaseID, username)
+	if m.DeleteUserFunc == nil {
+		return notMocked("DatabaseService", "DeleteUser")
+	}
+
+	return m.DeleteUserFunc(ctx, databaseID, username)
+}
+
+// UpdateUserACL records the call and calls UpdateUserACLFunc
+func (m *DatabaseService) UpdateUserACL(ctx context.Context, databaseID string, username string, databaseUserACLReq *govultr.DatabaseUserACLReq) (*govultr.DatabaseUser, *http.Response, error) {
+	m.record("UpdateUserACL", ctx, databaseID, username, databaseUserACLReq)
+	if m.UpdateUserACLFunc == nil {
+		var r0 *govultr.DatabaseUser
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "UpdateUserACL")
+	}
+
+	return m.UpdateUserACLFunc(ctx, databaseID, username, databaseUserACLReq)
+}
+
+// ListDBs records the call and calls ListDBsFunc
+func (m *DatabaseService) ListDBs(ctx context.Context, databaseID string) ([]govultr.DatabaseDB, *govultr.Meta, *http.Response, error) {
+	m.record("ListDBs", ctx, databaseID)
+	if m.ListDBsFunc == nil {
+		var r0 []govultr.DatabaseDB
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListDBs")
+	}
+
+	return m.ListDBsFunc(ctx, databaseID)
+}
+
+// CreateDB records the call and calls CreateDBFunc
+func (m *DatabaseService) CreateDB(ctx context.Context, databaseID string, databaseDBReq *govultr.DatabaseDBCreateReq) (*govultr.DatabaseDB, *http.Response, error) {
+	m.record("CreateDB", ctx, databaseID, databaseDBReq)
+	if m.CreateDBFunc == nil {
+		var r0 *govultr.DatabaseDB
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "CreateDB")
+	}
+
+	return m.CreateDBFunc(ctx, databaseID, databaseDBReq)
+}
+
+// GetDB records the call and calls GetDBFunc
+func (m *DatabaseService) GetDB(ctx context.Context, databaseID string, dbname string) (*govultr.DatabaseDB, *http.Response, error) {
+	m.record("GetDB", ctx, databaseID, dbname)
+	if m.GetDBFunc == nil {
+		var r0 *govultr.DatabaseDB
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetDB")
+	}
+
+	return m.GetDBFunc(ctx, databaseID, dbname)
+}
+
+// DeleteDB records the call and calls DeleteDBFunc
+func (m *DatabaseService) DeleteDB(ctx context.Context, databaseID string, dbname string) error {
+	m.record("DeleteDB", ctx, databaseID, dbname)
+	if m.DeleteDBFunc == nil {
+		return notMocked("DatabaseService", "DeleteDB")
+	}
+
+	return m.DeleteDBFunc(ctx, databaseID, dbname)
+}
+
+// ListTopics records the call and calls ListTopicsFunc
+func (m *DatabaseService) ListTopics(ctx context.Context, databaseID string) ([]govultr.DatabaseTopic, *govultr.Meta, *http.Response, error) {
+	m.record("ListTopics", ctx, databaseID)
+	if m.ListTopicsFunc == nil {
+		var r0 []govultr.DatabaseTopic
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListTopics")
+	}
+
+	return m.ListTopicsFunc(ctx, databaseID)
+}
+
+// CreateTopic records the call and calls CreateTopicFunc
+func (m *DatabaseService) CreateTopic(ctx context.Context, databaseID string, databaseTopicReq *govultr.DatabaseTopicCreateReq) (*govultr.DatabaseTopic, *http.Response, error) {
+	m.record("CreateTopic", ctx, databaseID, databaseTopicReq)
+	if m.CreateTopicFunc == nil {
+		var r0 *govultr.DatabaseTopic
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "CreateTopic")
+	}
+
+	return m.CreateTopicFunc(ctx, databaseID, databaseTopicReq)
+}
+
+// GetTopic records the call and calls GetTopicFunc
+func (m *DatabaseService) GetTopic(ctx context.Context, databaseID string, topicName string) (*govultr.DatabaseTopic, *http.Response, error) {
+	m.record("GetTopic", ctx, databaseID, topicName)
+	if m.GetTopicFunc == nil {
+		var r0 *govultr.DatabaseTopic
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetTopic")
+	}
+
+	return m.GetTopicFunc(ctx, databaseID, topicName)
+}
+
+// UpdateTopic records the call and calls UpdateTopicFunc
+func (m *DatabaseService) UpdateTopic(ctx context.Context, databaseID string, topicName string, databaseTopicReq *govultr.DatabaseTopicUpdateReq) (*govultr.DatabaseTopic, *http.Response, error) {
+	m.record("UpdateTopic", ctx, databaseID, topicName, databaseTopicReq)
+	if m.UpdateTopicFunc == nil {
+		var r0 *govultr.DatabaseTopic
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "UpdateTopic")
+	}
+
+	return m.UpdateTopicFunc(ctx, databaseID, topicName, databaseTopicReq)
+}
+
+// DeleteTopic records the call and calls DeleteTopicFunc
+func (m *DatabaseService) DeleteTopic(ctx context.Context, databaseID string, topicName string) error {
+	m.record("DeleteTopic", ctx, databaseID, topicName)
+	if m.DeleteTopicFunc == nil {
+		return notMocked("DatabaseService", "DeleteTopic")
+	}
+
+	return m.DeleteTopicFunc(ctx, databaseID, topicName)
+}
+
+// ListQuotas records the call and calls ListQuotasFunc
+func (m *DatabaseService) ListQuotas(ctx context.Context, databaseID string) ([]govultr.DatabaseQuota, *govultr.Meta, *http.Response, error) {
+	m.record("ListQuotas", ctx, databaseID)
+	if m.ListQuotasFunc == nil {
+		var r0 []govultr.DatabaseQuota
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListQuotas")
+	}
+
+	return m.ListQuotasFunc(ctx, databaseID)
+}
+
+// CreateQuota records the call and calls CreateQuotaFunc
+func (m *DatabaseService) CreateQuota(ctx context.Context, databaseID string, databaseQuotaReq *govultr.DatabaseQuotaCreateReq) (*govultr.DatabaseQuota, *http.Response, error) {
+	m.record("CreateQuota", ctx, databaseID, databaseQuotaReq)
+	if m.CreateQuotaFunc == nil {
+		var r0 *govultr.DatabaseQuota
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "CreateQuota")
+	}
+
+	return m.CreateQuotaFunc(ctx, databaseID, databaseQuotaReq)
+}
+
+// GetQuota records the call and calls GetQuotaFunc
+func (m *DatabaseService) GetQuota(ctx context.Context, databaseID string, clientID, username string) (*govultr.DatabaseQuota, *http.Response, error) {
+	m.record("GetQuota", ctx, databaseID, clientID, username)
+	if m.GetQuotaFunc == nil {
+		var r0 *govultr.DatabaseQuota
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetQuota")
+	}
+
+	return m.GetQuotaFunc(ctx, databaseID, clientID, username)
+}
+
+// UpdateQuota records the call and calls UpdateQuotaFunc
+func (m *DatabaseService) UpdateQuota(ctx context.Context, databaseID string, clientID, username string, databaseQuotaReq *govultr.DatabaseQuotaUpdateReq) (*govultr.DatabaseQuota, *http.Response, error) {
+	m.record("UpdateQuota", ctx, databaseID, clientID, username, databaseQuotaReq)
+	if m.UpdateQuotaFunc == nil {
+		var r0 *govultr.DatabaseQuota
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "UpdateQuota")
+	}
+
+	return m.UpdateQuotaFunc(ctx, databaseID, clientID, username, databaseQuotaReq)
+}
+
+// DeleteQuota records the call and calls DeleteQuotaFunc
+func (m *DatabaseService) DeleteQuota(ctx context.Context, databaseID string, clientID, username string) error {
+	m.record("DeleteQuota", ctx, databaseID, clientID, username)
+	if m.DeleteQuotaFunc == nil {
+		return notMocked("DatabaseService", "DeleteQuota")
+	}
+
+	return m.DeleteQuotaFunc(ctx, databaseID, clientID, username)
+}
+
+// ListMaintenanceUpdates records the call and calls ListMaintenanceUpdatesFunc
+func (m *DatabaseService) ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *http.Response, error) {
+	m.record("ListMaintenanceUpdates", ctx, databaseID)
+	if m.ListMaintenanceUpdatesFunc == nil {
+		var r0 []string
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "ListMaintenanceUpdates")
+	}
+
+	return m.ListMaintenanceUpdatesFunc(ctx, databaseID)
+}
+
+// StartMaintenance records the call and calls StartMaintenanceFunc
+func (m *DatabaseService) StartMaintenance(ctx context.Context, databaseID string) (string, *http.Response, error) {
+	m.record("StartMaintenance", ctx, databaseID)
+	if m.StartMaintenanceFunc == nil {
+		var r0 string
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "StartMaintenance")
+	}
+
+	return m.StartMaintenanceFunc(ctx, databaseID)
+}
+
+// ListAvailableConnectors records the call and calls ListAvailableConnectorsFunc
+func (m *DatabaseService) ListAvailableConnectors(ctx context.Context, databaseID string) ([]govultr.DatabaseAvailableConnector, *http.Response, error) {
+	m.record("ListAvailableConnectors", ctx, databaseID)
+	if m.ListAvailableConnectorsFunc == nil {
+		var r0 []govultr.DatabaseAvailableConnector
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "ListAvailableConnectors")
+	}
+
+	return m.ListAvailableConnectorsFunc(ctx, databaseID)
+}
+
+// GetConnectorConfigurationSchema records the call and calls GetConnectorConfigurationSchemaFunc
+func (m *DatabaseService) GetConnectorConfigurationSchema(ctx context.Context, databaseID string, connectorClass string) ([]govultr.DatabaseConnectorConfigurationOption, *http.Response, error) {
+	m.record("GetConnectorConfigurationSchema", ctx, databaseID, connectorClass)
+	if m.GetConnectorConfigurationSchemaFunc == nil {
+		var r0 []govultr.DatabaseConnectorConfigurationOption
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetConnectorConfigurationSchema")
+	}
+
+	return m.GetConnectorConfigurationSchemaFunc(ctx, databaseID, connectorClass)
+}
+
+// ListConnectors records the call and calls ListConnectorsFunc
+func (m *DatabaseService) ListConnectors(ctx context.Context, databaseID string) ([]govultr.DatabaseConnector, *govultr.Meta, *http.Response, error) {
+	m.record("ListConnectors", ctx, databaseID)
+	if m.ListConnectorsFunc == nil {
+		var r0 []govultr.DatabaseConnector
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListConnectors")
+	}
+
+	return m.ListConnectorsFunc(ctx, databaseID)
+}
+
+// CreateConnector records the call and calls CreateConnectorFunc
+func (m *DatabaseService) CreateConnector(ctx context.Context, databaseID string, databaseConnectorReq *govultr.DatabaseConnectorCreateReq) (*govultr.DatabaseConnector, *http.Response, error) {
+	m.record("CreateConnector", ctx, databaseID, databaseConnectorReq)
+	if m.CreateConnectorFunc == nil {
+		var r0 *govultr.DatabaseConnector
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "CreateConnector")
+	}
+
+	return m.CreateConnectorFunc(ctx, databaseID, databaseConnectorReq)
+}
+
+// GetConnector records the call and calls GetConnectorFunc
+func (m *DatabaseService) GetConnector(ctx context.Context, databaseID string, connectorName string) (*govultr.DatabaseConnector, *http.Response, error) {
+	m.record("GetConnector", ctx, databaseID, connectorName)
+	if m.GetConnectorFunc == nil {
+		var r0 *govultr.DatabaseConnector
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetConnector")
+	}
+
+	return m.GetConnectorFunc(ctx, databaseID, connectorName)
+}
+
+// UpdateConnector records the call and calls UpdateConnectorFunc
+func (m *DatabaseService) UpdateConnector(ctx context.Context, databaseID string, connectorName string, databaseConnectorReq *govultr.DatabaseConnectorUpdateReq) (*govultr.DatabaseConnector, *http.Response, error) {
+	m.record("UpdateConnector", ctx, databaseID, connectorName, databaseConnectorReq)
+	if m.UpdateConnectorFunc == nil {
+		var r0 *govultr.DatabaseConnector
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "UpdateConnector")
+	}
+
+	return m.UpdateConnectorFunc(ctx, databaseID, connectorName, databaseConnectorReq)
+}
+
+// DeleteConnector records the call and calls DeleteConnectorFunc
+func (m *DatabaseService) DeleteConnector(ctx context.Context, databaseID string, connectorName string) error {
+	m.record("DeleteConnector", ctx, databaseID, connectorName)
+	if m.DeleteConnectorFunc == nil {
+		return notMocked("DatabaseService", "DeleteConnector")
+	}
+
+	return m.DeleteConnectorFunc(ctx, databaseID, connectorName)
+}
+
+// GetConnectorStatus records the call and calls GetConnectorStatusFunc
+func (m *DatabaseService) GetConnectorStatus(ctx context.Context, databaseID string, connectorName string) (*govultr.DatabaseConnectorStatus, *http.Response, error) {
+	m.record("GetConnectorStatus", ctx, databaseID, connectorName)
+	if m.GetConnectorStatusFunc == nil {
+		var r0 *govultr.DatabaseConnectorStatus
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetConnectorStatus")
+	}
+
+	return m.GetConnectorStatusFunc(ctx, databaseID, connectorName)
+}
+
+// RestartConnector records the call and calls RestartConnectorFunc
+func (m *DatabaseService) RestartConnector(ctx context.Context, databaseID string, connectorName string) error {
+	m.record("RestartConnector", ctx, databaseID, connectorName)
+	if m.RestartConnectorFunc == nil {
+		return notMocked("DatabaseService", "RestartConnector")
+	}
+
+	return m.RestartConnectorFunc(ctx, databaseID, connectorName)
+}
+
+// PauseConnector records the call and calls PauseConnectorFunc
+func (m *DatabaseService) PauseConnector(ctx context.Context, databaseID string, connectorName string) error {
+	m.record("PauseConnector", ctx, databaseID, connectorName)
+	if m.PauseConnectorFunc == nil {
+		return notMocked("DatabaseService", "PauseConnector")
+	}
+
+	return m.PauseConnectorFunc(ctx, databaseID, connectorName)
+}
+
+// ResumeConnector records the call and calls ResumeConnectorFunc
+func (m *DatabaseService) ResumeConnector(ctx context.Context, databaseID string, connectorName string) error {
+	m.record("ResumeConnector", ctx, databaseID, connectorName)
+	if m.ResumeConnectorFunc == nil {
+		return notMocked("DatabaseService", "ResumeConnector")
+	}
+
+	return m.ResumeConnectorFunc(ctx, databaseID, connectorName)
+}
+
+// RestartConnectorTask records the call and calls RestartConnectorTaskFunc
+func (m *DatabaseService) RestartConnectorTask(ctx context.Context, databaseID string, connectorName string, taskID int) error {
+	m.record("RestartConnectorTask", ctx, databaseID, connectorName, taskID)
+	if m.RestartConnectorTaskFunc == nil {
+		return notMocked("DatabaseService", "RestartConnectorTask")
+	}
+
+	return m.RestartConnectorTaskFunc(ctx, databaseID, connectorName, taskID)
+}
+
+// ListServiceAlerts records the call and calls ListServiceAlertsFunc
+func (m *DatabaseService) ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *govultr.DatabaseListAlertsReq) ([]govultr.DatabaseAlert, *http.Response, error) {
+	m.record("ListServiceAlerts", ctx, databaseID, databaseAlertsReq)
+	if m.ListServiceAlertsFunc == nil {
+		var r0 []govultr.DatabaseAlert
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "ListServiceAlerts")
+	}
+
+	return m.ListServiceAlertsFunc(ctx, databaseID, databaseAlertsReq)
+}
+
+// GetMigrationStatus records the call and calls GetMigrationStatusFunc
+func (m *DatabaseService) GetMigrationStatus(ctx context.Context, databaseID string) (*govultr.DatabaseMigration, *http.Response, error) {
+	m.record("GetMigrationStatus", ctx, databaseID)
+	if m.GetMigrationStatusFunc == nil {
+		var r0 *govultr.DatabaseMigration
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetMigrationStatus")
+	}
+
+	return m.GetMigrationStatusFunc(ctx, databaseID)
+}
+
+// StartMigration records the call and calls StartMigrationFunc
+func (m *DatabaseService) StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *govultr.DatabaseMigrationStartReq) (*govultr.DatabaseMigration, *http.Response, error) {
+	m.record("StartMigration", ctx, databaseID, databaseMigrationReq)
+	if m.StartMigrationFunc == nil {
+		var r0 *govultr.DatabaseMigration
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "StartMigration")
+	}
+
+	return m.StartMigrationFunc(ctx, databaseID, databaseMigrationReq)
+}
+
+// DetachMigration records the call and calls DetachMigrationFunc
+func (m *DatabaseService) DetachMigration(ctx context.Context, databaseID string) error {
+	m.record("DetachMigration", ctx, databaseID)
+	if m.DetachMigrationFunc == nil {
+		return notMocked("DatabaseService", "DetachMigration")
+	}
+
+	return m.DetachMigrationFunc(ctx, databaseID)
+}
+
+// AddReadOnlyReplica records the call and calls AddReadOnlyReplicaFunc
+func (m *DatabaseService) AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *govultr.DatabaseAddReplicaReq) (*govultr.Database, *http.Response, error) {
+	m.record("AddReadOnlyReplica", ctx, databaseID, databaseReplicaReq)
+	if m.AddReadOnlyReplicaFunc == nil {
+		var r0 *govultr.Database
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "AddReadOnlyReplica")
+	}
+
+	return m.AddReadOnlyReplicaFunc(ctx, databaseID, databaseReplicaReq)
+}
+
+// PromoteReadReplica records the call and calls PromoteReadReplicaFunc
+func (m *DatabaseService) PromoteReadReplica(ctx context.Context, databaseID string) error {
+	m.record("PromoteReadReplica", ctx, databaseID)
+	if m.PromoteReadReplicaFunc == nil {
+		return notMocked("DatabaseService", "PromoteReadReplica")
+	}
+
+	return m.PromoteReadReplicaFunc(ctx, databaseID)
+}
+
+// GetBackupInformation records the call and calls GetBackupInformationFunc
+func (m *DatabaseService) GetBackupInformation(ctx context.Context, databaseID string) (*govultr.DatabaseBackups, *http.Response, error) {
+	m.record("GetBackupInformation", ctx, databaseID)
+	if m.GetBackupInformationFunc == nil {
+		var r0 *govultr.DatabaseBackups
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetBackupInformation")
+	}
+
+	return m.GetBackupInformationFunc(ctx, databaseID)
+}
+
+// RestoreFromBackup records the call and calls RestoreFromBackupFunc
+func (m *DatabaseService) RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *govultr.DatabaseBackupRestoreReq) (*govultr.Database, *http.Response, error) {
+	m.record("RestoreFromBackup", ctx, databaseID, databaseRestoreReq)
+	if m.RestoreFromBackupFunc == nil {
+		var r0 *govultr.Database
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "RestoreFromBackup")
+	}
+
+	return m.RestoreFromBackupFunc(ctx, databaseID, databaseRestoreReq)
+}
+
+// Fork records the call and calls ForkFunc
+func (m *DatabaseService) Fork(ctx context.Context, databaseID string, databaseForkReq *govultr.DatabaseForkReq) (*govultr.Database, *http.Response, error) {
+	m.record("Fork", ctx, databaseID, databaseForkReq)
+	if m.ForkFunc == nil {
+		var r0 *govultr.Database
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "Fork")
+	}
+
+	return m.ForkFunc(ctx, databaseID, databaseForkReq)
+}
+
+// ListConnectionPools records the call and calls ListConnectionPoolsFunc
+func (m *DatabaseService) ListConnectionPools(ctx context.Context, databaseID string) (*govultr.DatabaseConnections, []govultr.DatabaseConnectionPool, *govultr.Meta, *http.Response, error) {
+	m.record("ListConnectionPools", ctx, databaseID)
+	if m.ListConnectionPoolsFunc == nil {
+		var r0 *govultr.DatabaseConnections
+		var r1 []govultr.DatabaseConnectionPool
+		var r2 *govultr.Meta
+		var r3 *http.Response
+		return r0, r1, r2, r3, notMocked("DatabaseService", "ListConnectionPools")
+	}
+
+	return m.ListConnectionPoolsFunc(ctx, databaseID)
+}
+
+// CreateConnectionPool records the call and calls CreateConnectionPoolFunc
+func (m *DatabaseService) CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolCreateReq) (*govultr.DatabaseConnectionPool, *http.Response, error) {
+	m.record("CreateConnectionPool", ctx, databaseID, databaseConnectionPoolReq)
+	if m.CreateConnectionPoolFunc == nil {
+		var r0 *govultr.DatabaseConnectionPool
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "CreateConnectionPool")
+	}
+
+	return m.CreateConnectionPoolFunc(ctx, databaseID, databaseConnectionPoolReq)
+}
+
+// GetConnectionPool records the call and calls GetConnectionPoolFunc
+func (m *DatabaseService) GetConnectionPool(ctx context.Context, databaseID string, poolName string) (*govultr.DatabaseConnectionPool, *http.Response, error) {
+	m.record("GetConnectionPool", ctx, databaseID, poolName)
+	if m.GetConnectionPoolFunc == nil {
+		var r0 *govultr.DatabaseConnectionPool
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "GetConnectionPool")
+	}
+
+	return m.GetConnectionPoolFunc(ctx, databaseID, poolName)
+}
+
+// UpdateConnectionPool records the call and calls UpdateConnectionPoolFunc
+func (m *DatabaseService) UpdateConnectionPool(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolUpdateReq) (*govultr.DatabaseConnectionPool, *http.Response, error) {
+	m.record("UpdateConnectionPool", ctx, databaseID, poolName, databaseConnectionPoolReq)
+	if m.UpdateConnectionPoolFunc == nil {
+		var r0 *govultr.DatabaseConnectionPool
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "UpdateConnectionPool")
+	}
+
+	return m.UpdateConnectionPoolFunc(ctx, databaseID, poolName, databaseConnectionPoolReq)
+}
+
+// DeleteConnectionPool records the call and calls DeleteConnectionPoolFunc
+func (m *DatabaseService) DeleteConnectionPool(ctx context.Context, databaseID string, poolName string) error {
+	m.record("DeleteConnectionPool", ctx, databaseID, poolName)
+	if m.DeleteConnectionPoolFunc == nil {
+		return notMocked("DatabaseService", "DeleteConnectionPool")
+	}
+
+	return m.DeleteConnectionPoolFunc(ctx, databaseID, poolName)
+}
+
+// ListAdvancedOptions records the call and calls ListAdvancedOptionsFunc
+func (m *DatabaseService) ListAdvancedOptions(ctx context.Context, databaseID string) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("ListAdvancedOptions", ctx, databaseID)
+	if m.ListAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListAdvancedOptions")
+	}
+
+	return m.ListAdvancedOptionsFunc(ctx, databaseID)
+}
+
+// UpdateAdvancedOptions records the call and calls UpdateAdvancedOptionsFunc
+func (m *DatabaseService) UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *govultr.DatabaseAdvancedOptions) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("UpdateAdvancedOptions", ctx, databaseID, databaseAdvancedOptionsReq)
+	if m.UpdateAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "UpdateAdvancedOptions")
+	}
+
+	return m.UpdateAdvancedOptionsFunc(ctx, databaseID, databaseAdvancedOptionsReq)
+}
+
+// ListKafkaRESTAdvancedOptions records the call and calls ListKafkaRESTAdvancedOptionsFunc
+func (m *DatabaseService) ListKafkaRESTAdvancedOptions(ctx context.Context, databaseID string) (*govultr.DatabaseKafkaRESTAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("ListKafkaRESTAdvancedOptions", ctx, databaseID)
+	if m.ListKafkaRESTAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseKafkaRESTAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListKafkaRESTAdvancedOptions")
+	}
+
+	return m.ListKafkaRESTAdvancedOptionsFunc(ctx, databaseID)
+}
+
+// UpdateKafkaRESTAdvancedOptions records the call and calls UpdateKafkaRESTAdvancedOptionsFunc
+func (m *DatabaseService) UpdateKafkaRESTAdvancedOptions(ctx context.Context, databaseID string, databaseKafkaRESTAdvancedOptionsReq *govultr.DatabaseKafkaRESTAdvancedOptions) (*govultr.DatabaseKafkaRESTAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("UpdateKafkaRESTAdvancedOptions", ctx, databaseID, databaseKafkaRESTAdvancedOptionsReq)
+	if m.UpdateKafkaRESTAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseKafkaRESTAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "UpdateKafkaRESTAdvancedOptions")
+	}
+
+	return m.UpdateKafkaRESTAdvancedOptionsFunc(ctx, databaseID, databaseKafkaRESTAdvancedOptionsReq)
+}
+
+// ListSchemaRegistryAdvancedOptions records the call and calls ListSchemaRegistryAdvancedOptionsFunc
+func (m *DatabaseService) ListSchemaRegistryAdvancedOptions(ctx context.Context, databaseID string) (*govultr.DatabaseSchemaRegistryAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("ListSchemaRegistryAdvancedOptions", ctx, databaseID)
+	if m.ListSchemaRegistryAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseSchemaRegistryAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListSchemaRegistryAdvancedOptions")
+	}
+
+	return m.ListSchemaRegistryAdvancedOptionsFunc(ctx, databaseID)
+}
+
+// UpdateSchemaRegistryAdvancedOptions records the call and calls UpdateSchemaRegistryAdvancedOptionsFunc
+func (m *DatabaseService) UpdateSchemaRegistryAdvancedOptions(ctx context.Context, databaseID string, databaseSchemaRegistryAdvancedOptionsReq *govultr.DatabaseSchemaRegistryAdvancedOptions) (*govultr.DatabaseSchemaRegistryAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("UpdateSchemaRegistryAdvancedOptions", ctx, databaseID, databaseSchemaRegistryAdvancedOptionsReq)
+	if m.UpdateSchemaRegistryAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseSchemaRegistryAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "UpdateSchemaRegistryAdvancedOptions")
+	}
+
+	return m.UpdateSchemaRegistryAdvancedOptionsFunc(ctx, databaseID, databaseSchemaRegistryAdvancedOptionsReq)
+}
+
+// ListKafkaConnectAdvancedOptions records the call and calls ListKafkaConnectAdvancedOptionsFunc
+func (m *DatabaseService) ListKafkaConnectAdvancedOptions(ctx context.Context, databaseID string) (*govultr.DatabaseKafkaConnectAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("ListKafkaConnectAdvancedOptions", ctx, databaseID)
+	if m.ListKafkaConnectAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseKafkaConnectAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "ListKafkaConnectAdvancedOptions")
+	}
+
+	return m.ListKafkaConnectAdvancedOptionsFunc(ctx, databaseID)
+}
+
+// UpdateKafkaConnectAdvancedOptions records the call and calls UpdateKafkaConnectAdvancedOptionsFunc
+func (m *DatabaseService) UpdateKafkaConnectAdvancedOptions(ctx context.Context, databaseID string, databaseKafkaConnectAdvancedOptionsReq *govultr.DatabaseKafkaConnectAdvancedOptions) (*govultr.DatabaseKafkaConnectAdvancedOptions, []govultr.AvailableOption, *http.Response, error) {
+	m.record("UpdateKafkaConnectAdvancedOptions", ctx, databaseID, databaseKafkaConnectAdvancedOptionsReq)
+	if m.UpdateKafkaConnectAdvancedOptionsFunc == nil {
+		var r0 *govultr.DatabaseKafkaConnectAdvancedOptions
+		var r1 []govultr.AvailableOption
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DatabaseService", "UpdateKafkaConnectAdvancedOptions")
+	}
+
+	return m.UpdateKafkaConnectAdvancedOptionsFunc(ctx, databaseID, databaseKafkaConnectAdvancedOptionsReq)
+}
+
+// ListAvailableVersions records the call and calls ListAvailableVersionsFunc
+func (m *DatabaseService) ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *http.Response, error) {
+	m.record("ListAvailableVersions", ctx, databaseID)
+	if m.ListAvailableVersionsFunc == nil {
+		var r0 []string
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "ListAvailableVersions")
+	}
+
+	return m.ListAvailableVersionsFunc(ctx, databaseID)
+}
+
+// StartVersionUpgrade records the call and calls StartVersionUpgradeFunc
+func (m *DatabaseService) StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *govultr.DatabaseVersionUpgradeReq) (string, *http.Response, error) {
+	m.record("StartVersionUpgrade", ctx, databaseID, databaseVersionUpgradeReq)
+	if m.StartVersionUpgradeFunc == nil {
+		var r0 string
+		var r1 *http.Response
+		return r0, r1, notMocked("DatabaseService", "StartVersionUpgrade")
+	}
+
+	return m.StartVersionUpgradeFunc(ctx, databaseID, databaseVersionUpgradeReq)
+}
+
+// DomainService is a mock of govultr.DomainService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type DomainService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, domainReq *govultr.DomainReq) (*govultr.Domain, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, domain string) (*govultr.Domain, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, domain, dnsSec string) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, domain string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Domain, *govultr.Meta, *http.Response, error)
+
+	// GetSoaFunc is called by GetSoa
+	GetSoaFunc func(ctx context.Context, domain string) (*govultr.Soa, *http.Response, error)
+
+	// UpdateSoaFunc is called by UpdateSoa
+	UpdateSoaFunc func(ctx context.Context, domain string, soaReq *govultr.Soa) error
+
+	// GetDNSSecFunc is called by GetDNSSec
+	GetDNSSecFunc func(ctx context.Context, domain string) ([]string, *http.Response, error)
+}
+
+var _ govultr.DomainService = (*DomainService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *DomainService) Create(ctx context.Context, domainReq *govultr.DomainReq) (*govultr.Domain, *http.Response, error) {
+	m.record("Create", ctx, domainReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.Domain
+		var r1 *http.Response
+		return r0, r1, notMocked("DomainService", "Create")
+	}
+
+	return m.CreateFunc(ctx, domainReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *DomainService) Get(ctx context.Context, domain string) (*govultr.Domain, *http.Response, error) {
+	m.record("Get", ctx, domain)
+	if m.GetFunc == nil {
+		var r0 *govultr.Domain
+		var r1 *http.Response
+		return r0, r1, notMocked("DomainService", "Get")
+	}
+
+	return m.GetFunc(ctx, domain)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *DomainService) Update(ctx context.Context, domain, dnsSec string) error {
+	m.record("Update", ctx, domain, dnsSec)
+	if m.UpdateFunc == nil {
+		return notMocked("DomainService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, domain, dnsSec)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *DomainService) Delete(ctx context.Context, domain string) error {
+	m.record("Delete", ctx, domain)
+	if m.DeleteFunc == nil {
+		return notMocked("DomainService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, domain)
+}
+
+// List records the call and calls ListFunc
+func (m *DomainService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Domain, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Domain
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DomainService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// GetSoa records the call and calls GetSoaFunc
+func (m *DomainService) GetSoa(ctx context.Context, domain string) (*govultr.Soa, *http.Response, error) {
+	m.record("GetSoa", ctx, domain)
+	if m.GetSoaFunc == nil {
+		var r0 *govultr.Soa
+		var r1 *http.Response
+		return r0, r1, notMocked("DomainService", "GetSoa")
+	}
+
+	return m.GetSoaFunc(ctx, domain)
+}
+
+// UpdateSoa records the call and calls UpdateSoaFunc
+func (m *DomainService) UpdateSoa(ctx context.Context, domain string, soaReq *govultr.Soa) error {
+	m.record("UpdateSoa", ctx, domain, soaReq)
+	if m.UpdateSoaFunc == nil {
+		return notMocked("DomainService", "UpdateSoa")
+	}
+
+	return m.UpdateSoaFunc(ctx, domain, soaReq)
+}
+
+// GetDNSSec records the call and calls GetDNSSecFunc
+func (m *DomainService) GetDNSSec(ctx context.Context, domain string) ([]string, *http.Response, error) {
+	m.record("GetDNSSec", ctx, domain)
+	if m.GetDNSSecFunc == nil {
+		var r0 []string
+		var r1 *http.Response
+		return r0, r1, notMocked("DomainService", "GetDNSSec")
+	}
+
+	return m.GetDNSSecFunc(ctx, domain)
+}
+
+// DomainRecordService is a mock of govultr.DomainRecordService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type DomainRecordService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, domain string, domainRecordCreateReq *govultr.DomainRecordCreateReq) (*govultr.DomainRecord, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, domain, recordID string) (*govultr.DomainRecord, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, domain, recordID string, domainRecordUpdateReq *govultr.DomainRecordUpdateReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, domain, recordID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, domain string, options *govultr.ListOptions) ([]govultr.DomainRecord, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.DomainRecordService = (*DomainRecordService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *DomainRecordService) Create(ctx context.Context, domain string, domainRecordCreateReq *govultr.DomainRecordCreateReq) (*govultr.DomainRecord, *http.Response, error) {
+	m.record("Create", ctx, domain, domainRecordCreateReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.DomainRecord
+		var r1 *http.Response
+		return r0, r1, notMocked("DomainRecordService", "Create")
+	}
+
+	return m.CreateFunc(ctx, domain, domainRecordCreateReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *DomainRecordService) Get(ctx context.Context, domain, recordID string) (*govultr.DomainRecord, *http.Response, error) {
+	m.record("Get", ctx, domain, recordID)
+	if m.GetFunc == nil {
+		var r0 *govultr.DomainRecord
+		var r1 *http.Response
+		return r0, r1, notMocked("DomainRecordService", "Get")
+	}
+
+	return m.GetFunc(ctx, domain, recordID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *DomainRecordService) Update(ctx context.Context, domain, recordID string, domainRecordUpdateReq *govultr.DomainRecordUpdateReq) error {
+	m.record("Update", ctx, domain, recordID, domainRecordUpdateReq)
+	if m.UpdateFunc == nil {
+		return notMocked("DomainRecordService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, domain, recordID, domainRecordUpdateReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *DomainRecordService) Delete(ctx context.Context, domain, recordID string) error {
+	m.record("Delete", ctx, domain, recordID)
+	if m.DeleteFunc == nil {
+		return notMocked("DomainRecordService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, domain, recordID)
+}
+
+// List records the call and calls ListFunc
+func (m *DomainRecordService) List(ctx context.Context, domain string, options *govultr.ListOptions) ([]govultr.DomainRecord, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, domain, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.DomainRecord
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("DomainRecordService", "List")
+	}
+
+	return m.ListFunc(ctx, domain, options)
+}
+
+// FirewallGroupService is a mock of govultr.FirewallGroupService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type FirewallGroupService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, fwGroupReq *govultr.FirewallGroupReq) (*govultr.FirewallGroup, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, groupID string) (*govultr.FirewallGroup, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, fwGroupID string, fwGroupReq *govultr.FirewallGroupReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, fwGroupID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.FirewallGroup, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.FirewallGroupService = (*FirewallGroupService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *FirewallGroupService) Create(ctx context.Context, fwGroupReq *govultr.FirewallGroupReq) (*govultr.FirewallGroup, *http.Response, error) {
+	m.record("Create", ctx, fwGroupReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.FirewallGroup
+		var r1 *http.Response
+		return r0, r1, notMocked("FirewallGroupService", "Create")
+	}
+
+	return m.CreateFunc(ctx, fwGroupReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *FirewallGroupService) Get(ctx context.Context, groupID string) (*govultr.FirewallGroup, *http.Response, error) {
+	m.record("Get", ctx, groupID)
+	if m.GetFunc == nil {
+		var r0 *govultr.FirewallGroup
+		var r1 *http.Response
+		return r0, r1, notMocked("FirewallGroupService", "Get")
+	}
+
+	return m.GetFunc(ctx, groupID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *FirewallGroupService) Update(ctx context.Context, fwGroupID string, fwGroupReq *govultr.FirewallGroupReq) error {
+	m.record("Update", ctx, fwGroupID, fwGroupReq)
+	if m.UpdateFunc == nil {
+		return notMocked("FirewallGroupService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, fwGroupID, fwGroupReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *FirewallGroupService) Delete(ctx context.Context, fwGroupID string) error {
+	m.record("Delete", ctx, fwGroupID)
+	if m.DeleteFunc == nil {
+		return notMocked("FirewallGroupService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, fwGroupID)
+}
+
+// List records the call and calls ListFunc
+func (m *FirewallGroupService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.FirewallGroup, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.FirewallGroup
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("FirewallGroupService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// FireWallRuleService is a mock of govultr.FireWallRuleService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type FireWallRuleService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, fwGroupID string, fwRuleReq *govultr.FirewallRuleReq) (*govultr.FirewallRule, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.FirewallRule, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, fwGroupID string, fwRuleID int) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, fwGroupID string, options *govultr.ListOptions) ([]govultr.FirewallRule, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.FireWallRuleService = (*FireWallRuleService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *FireWallRuleService) Create(ctx context.Context, fwGroupID string, fwRuleReq *govultr.FirewallRuleReq) (*govultr.FirewallRule, *http.Response, error) {
+	m.record("Create", ctx, fwGroupID, fwRuleReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.FirewallRule
+		var r1 *http.Response
+		return r0, r1, notMocked("FireWallRuleService", "Create")
+	}
+
+	return m.CreateFunc(ctx, fwGroupID, fwRuleReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *FireWallRuleService) Get(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.FirewallRule, *http.Response, error) {
+	m.record("Get", ctx, fwGroupID, fwRuleID)
+	if m.GetFunc == nil {
+		var r0 *govultr.FirewallRule
+		var r1 *http.Response
+		return r0, r1, notMocked("FireWallRuleService", "Get")
+	}
+
+	return m.GetFunc(ctx, fwGroupID, fwRuleID)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *FireWallRuleService) Delete(ctx context.Context, fwGroupID string, fwRuleID int) error {
+	m.record("Delete", ctx, fwGroupID, fwRuleID)
+	if m.DeleteFunc == nil {
+		return notMocked("FireWallRuleService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, fwGroupID, fwRuleID)
+}
+
+// List records the call and calls ListFunc
+func (m *FireWallRuleService) List(ctx context.Context, fwGroupID string, options *govultr.ListOptions) ([]govultr.FirewallRule, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, fwGroupID, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.FirewallRule
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("FireWallRuleService", "List")
+	}
+
+	return m.ListFunc(ctx, fwGroupID, options)
+}
+
+// InstanceService is a mock of govultr.InstanceService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type InstanceService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, instanceReq *govultr.InstanceCreateReq) (*govultr.Instance, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, instanceID string) (*govultr.Instance, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, instanceID string, instanceReq *govultr.InstanceUpdateReq) (*govultr.Instance, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, instanceID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *http.Response, error)
+
+	// StartFunc is called by Start
+	StartFunc func(ctx context.Context, instanceID string) error
+
+	// HaltFunc is called by Halt
+	HaltFunc func(ctx context.Context, instanceID string) error
+
+	// RebootFunc is called by Reboot
+	RebootFunc func(ctx context.Context, instanceID string) error
+
+	// ReinstallFunc is called by Reinstall
+	ReinstallFunc func(ctx context.Context, instanceID string, reinstallReq *govultr.ReinstallReq) (*govultr.Instance, *http.Response, error)
+
+	// MassStartFunc is called by MassStart
+	MassStartFunc func(ctx context.Context, instanceList []string) error
+
+	// MassHaltFunc is called by MassHalt
+	MassHaltFunc func(ctx context.Context, instanceList []string) error
+
+	// MassRebootFunc is called by MassReboot
+	MassRebootFunc func(ctx context.Context, instanceList []string) error
+
+	// RestoreFunc is called by Restore
+	RestoreFunc func(ctx context.Context, instanceID string, restoreReq *govultr.RestoreReq) (*http.Response, error)
+
+	// GetBandwidthFunc is called by GetBandwidth
+	GetBandwidthFunc func(ctx context.Context, instanceID string) (*govultr.Bandwidth, *http.Response, error)
+
+	// GetNeighborsFunc is called by GetNeighbors
+	GetNeighborsFunc func(ctx context.Context, instanceID string) (*govultr.Neighbors, *http.Response, error)
+
+	// ListVPCInfoFunc is called by ListVPCInfo
+	ListVPCInfoFunc func(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, *http.Response, error)
+
+	// AttachVPCFunc is called by AttachVPC
+	AttachVPCFunc func(ctx context.Context, instanceID, vpcID string) error
+
+	// DetachVPCFunc is called by DetachVPC
+	DetachVPCFunc func(ctx context.Context, instanceID, vpcID string) error
+
+	// ISOStatusFunc is called by ISOStatus
+	ISOStatusFunc func(ctx context.Context, instanceID string) (*govultr.Iso, *http.Response, error)
+
+	// AttachISOFunc is called by AttachISO
+	AttachISOFunc func(ctx context.Context, instanceID, isoID string) (*http.Response, error)
+
+	// DetachISOFunc is called by DetachISO
+	DetachISOFunc func(ctx context.Context, instanceID string) (*http.Response, error)
+
+	// GetBackupScheduleFunc is called by GetBackupSchedule
+	GetBackupScheduleFunc func(ctx context.Context, instanceID string) (*govultr.BackupSchedule, *http.Response, error)
+
+	// SetBackupScheduleFunc is called by SetBackupSchedule
+	SetBackupScheduleFunc func(ctx context.Context, instanceID string, backup *govultr.BackupScheduleReq) (*http.Response, error)
+
+	// CreateIPv4Func is called by CreateIPv4
+	CreateIPv4Func func(ctx context.Context, instanceID string, reboot *bool) (*govultr.IPv4, *http.Response, error)
+
+	// ListIPv4Func is called by ListIPv4
+	ListIPv4Func func(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *http.Response, error)
+
+	// DeleteIPv4Func is called by DeleteIPv4
+	DeleteIPv4Func func(ctx context.Context, instanceID, ip string) error
+
+	// ListIPv6Func is called by ListIPv6
+	ListIPv6Func func(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *http.Response, error)
+
+	// CreateReverseIPv6Func is called by CreateReverseIPv6
+	CreateReverseIPv6Func func(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) error
+
+	// ListReverseIPv6Func is called by ListReverseIPv6
+	ListReverseIPv6Func func(ctx context.Context, instanceID string) ([]govultr.ReverseIP, *http.Response, error)
+
+	// DeleteReverseIPv6Func is called by DeleteReverseIPv6
+	DeleteReverseIPv6Func func(ctx context.Context, instanceID, ip string) error
+
+	// CreateReverseIPv4Func is called by CreateReverseIPv4
+	CreateReverseIPv4Func func(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) error
+
+	// DefaultReverseIPv4Func is called by DefaultReverseIPv4
+	DefaultReverseIPv4Func func(ctx context.Context, instanceID, ip string) error
+
+	// GetUserDataFunc is called by GetUserData
+	GetUserDataFunc func(ctx context.Context, instanceID string) (*govultr.UserData, *http.Response, error)
+
+	// GetUpgradesFunc is called by GetUpgrades
+	GetUpgradesFunc func(ctx context.Context, instanceID string) (*govultr.Upgrades, *http.Response, error)
+}
+
+var _ govultr.InstanceService = (*InstanceService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *InstanceService) Create(ctx context.Context, instanceReq *govultr.InstanceCreateReq) (*govultr.Instance, *http.Response, error) {
+	m.record("Create", ctx, instanceReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.Instance
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "Create")
+	}
+
+	return m.CreateFunc(ctx, instanceReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *InstanceService) Get(ctx context.Context, instanceID string) (*govultr.Instance, *http.Response, error) {
+	m.record("Get", ctx, instanceID)
+	if m.GetFunc == nil {
+		var r0 *govultr.Instance
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "Get")
+	}
+
+	return m.GetFunc(ctx, instanceID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *InstanceService) Update(ctx context.Context, instanceID string, instanceReq *govultr.InstanceUpdateReq) (*govultr.Instance, *http.Response, error) {
+	m.record("Update", ctx, instanceID, instanceReq)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.Instance
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, instanceID, instanceReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *InstanceService) Delete(ctx context.Context, instanceID string) error {
+	m.record("Delete", ctx, instanceID)
+	if m.DeleteFunc == nil {
+		return notMocked("InstanceService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, instanceID)
+}
+
+// List records the call and calls ListFunc
+func (m *InstanceService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Instance
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("InstanceService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Start records the call and calls StartFunc
+func (m *InstanceService) Start(ctx context.Context, instanceID string) error {
+	m.record("Start", ctx, instanceID)
+	if m.StartFunc == nil {
+		return notMocked("InstanceService", "Start")
+	}
+
+	return m.StartFunc(ctx, instanceID)
+}
+
+// Halt records the call and calls HaltFunc
+func (m *InstanceService) Halt(ctx context.Context, instanceID string) error {
+	m.record("Halt", ctx, instanceID)
+	if m.HaltFunc == nil {
+		return notMocked("InstanceService", "Halt")
+	}
+
+	return m.HaltFunc(ctx, instanceID)
+}
+
+// Reboot records the call and calls RebootFunc
+func (m *InstanceService) Reboot(ctx context.Context, instanceID string) error {
+	m.record("Reboot", ctx, instanceID)
+	if m.RebootFunc == nil {
+		return notMocked("InstanceService", "Reboot")
+	}
+
+	return m.RebootFunc(ctx, instanceID)
+}
+
+// Reinstall records the call and calls ReinstallFunc
+func (m *InstanceService) Reinstall(ctx context.Context, instanceID string, reinstallReq *govultr.ReinstallReq) (*govultr.Instance, *http.Response, error) {
+	m.record("Reinstall", ctx, instanceID, reinstallReq)
+	if m.ReinstallFunc == nil {
+		var r0 *govultr.Instance
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "Reinstall")
+	}
+
+	return m.ReinstallFunc(ctx, instanceID, reinstallReq)
+}
+
+// MassStart records the call and calls MassStartFunc
+func (m *InstanceService) MassStart(ctx context.Context, instanceList []string) error {
+	m.record("MassStart", ctx, instanceList)
+	if m.MassStartFunc == nil {
+		return notMocked("InstanceService", "MassStart")
+	}
+
+	return m.MassStartFunc(ctx, instanceList)
+}
+
+// MassHalt records the call and calls MassHaltFunc
+func (m *InstanceService) MassHalt(ctx context.Context, instanceList []string) error {
+	m.record("MassHalt", ctx, instanceList)
+	if m.MassHaltFunc == nil {
+		return notMocked("InstanceService", "MassHalt")
+	}
+
+	return m.MassHaltFunc(ctx, instanceList)
+}
+
+// MassReboot records the call and calls MassRebootFunc
+func (m *InstanceService) MassReboot(ctx context.Context, instanceList []string) error {
+	m.record("MassReboot", ctx, instanceList)
+	if m.MassRebootFunc == nil {
+		return notMocked("InstanceService", "MassReboot")
+	}
+
+	return m.MassRebootFunc(ctx, instanceList)
+}
+
+// Restore records the call and calls RestoreFunc
+func (m *InstanceService) Restore(ctx context.Context, instanceID string, restoreReq *govultr.RestoreReq) (*http.Response, error) {
+	m.record("Restore", ctx, instanceID, restoreReq)
+	if m.RestoreFunc == nil {
+		var r0 *http.Response
+		return r0, notMocked("InstanceService", "Restore")
+	}
+
+	return m.RestoreFunc(ctx, instanceID, restoreReq)
+}
+
+// GetBandwidth records the call and calls GetBandwidthFunc
+func (m *InstanceService) GetBandwidth(ctx context.Context, instanceID string) (*govultr.Bandwidth, *http.Response, error) {
+	m.record("GetBandwidth", ctx, instanceID)
+	if m.GetBandwidthFunc == nil {
+		var r0 *govultr.Bandwidth
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "GetBandwidth")
+	}
+
+	return m.GetBandwidthFunc(ctx, instanceID)
+}
+
+// GetNeighbors records the call and calls GetNeighborsFunc
+func (m *InstanceService) GetNeighbors(ctx context.Context, instanceID string) (*govultr.Neighbors, *http.Response, error) {
+	m.record("GetNeighbors", ctx, instanceID)
+	if m.GetNeighborsFunc == nil {
+		var r0 *govultr.Neighbors
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "GetNeighbors")
+	}
+
+	return m.GetNeighborsFunc(ctx, instanceID)
+}
+
+// ListVPCInfo records the call and calls ListVPCInfoFunc
+func (m *InstanceService) ListVPCInfo(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, *http.Response, error) {
+	m.record("ListVPCInfo", ctx, instanceID, options)
+	if m.ListVPCInfoFunc == nil {
+		var r0 []govultr.VPCInfo
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("InstanceService", "ListVPCInfo")
+	}
+
+	return m.ListVPCInfoFunc(ctx, instanceID, options)
+}
+
+// AttachVPC records the call and calls AttachVPCFunc
+func (m *InstanceService) AttachVPC(ctx context.Context, instanceID, vpcID string) error {
+	m.record("AttachVPC", ctx, instanceID, vpcID)
+	if m.AttachVPCFunc == nil {
+		return notMocked("InstanceService", "AttachVPC")
+	}
+
+	return m.AttachVPCFunc(ctx, instanceID, vpcID)
+}
+
+// DetachVPC records the call and calls DetachVPCFunc
+func (m *InstanceService) DetachVPC(ctx context.Context, instanceID, vpcID string) error {
+	m.record("DetachVPC", ctx, instanceID, vpcID)
+	if m.DetachVPCFunc == nil {
+		return notMocked("InstanceService", "DetachVPC")
+	}
+
+	return m.DetachVPCFunc(ctx, instanceID, vpcID)
+}
+
+// ISOStatus records the call and calls ISOStatusFunc
+func (m *InstanceService) ISOStatus(ctx context.Context, instanceID string) (*govultr.Iso, *http.Response, error) {
+	m.record("ISOStatus", ctx, instanceID)
+	if m.ISOStatusFunc == nil {
+		var r0 *govultr.Iso
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "ISOStatus")
+	}
+
+	return m.ISOStatusFunc(ctx, instanceID)
+}
+
+// AttachISO records the call and calls AttachISOFunc
+func (m *InstanceService) AttachISO(ctx context.Context, instanceID, isoID string) (*http.Response, error) {
+	m.record("AttachISO", ctx, instanceID, isoID)
+	if m.AttachISOFunc == nil {
+		var r0 *http.Response
+		return r0, notMocked("InstanceService", "AttachISO")
+	}
+
+	return m.AttachISOFunc(ctx, instanceID, isoID)
+}
+
+// DetachISO records the call and calls DetachISOFunc
+func (m *InstanceService) DetachISO(ctx context.Context, instanceID string) (*http.Response, error) {
+	m.record("DetachISO", ctx, instanceID)
+	if m.DetachISOFunc == nil {
+		var r0 *http.Response
+		return r0, notMocked("InstanceService", "DetachISO")
+	}
+
+	return m.DetachISOFunc(ctx, instanceID)
+}
+
+// GetBackupSchedule records the call and calls GetBackupScheduleFunc
+func (m *InstanceService) GetBackupSchedule(ctx context.Context, instanceID string) (*govultr.BackupSchedule, *http.Response, error) {
+	m.record("GetBackupSchedule", ctx, instanceID)
+	if m.GetBackupScheduleFunc == nil {
+		var r0 *govultr.BackupSchedule
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "GetBackupSchedule")
+	}
+
+	return m.GetBackupScheduleFunc(ctx, instanceID)
+}
+
+// SetBackupSchedule records the call and calls SetBackupScheduleFunc
+func (m *InstanceService) SetBackupSchedule(ctx context.Context, instanceID string, backup *govultr.BackupScheduleReq) (*http.Response, error) {
+	m.record("SetBackupSchedule", ctx, instanceID, backup)
+	if m.SetBackupScheduleFunc == nil {
+		var r0 *http.Response
+		return r0, notMocked("InstanceService", "SetBackupSchedule")
+	}
+
+	return m.SetBackupScheduleFunc(ctx, instanceID, backup)
+}
+
+// CreateIPv4 records the call and calls CreateIPv4Func
+func (m *InstanceService) CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*govultr.IPv4, *http.Response, error) {
+	m.record("CreateIPv4", ctx, instanceID, reboot)
+	if m.CreateIPv4Func == nil {
+		var r0 *govultr.IPv4
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "CreateIPv4")
+	}
+
+	return m.CreateIPv4Func(ctx, instanceID, reboot)
+}
+
+// ListIPv4 records the call and calls ListIPv4Func
+func (m *InstanceService) ListIPv4(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *http.Response, error) {
+	m.record("ListIPv4", ctx, instanceID, option)
+	if m.ListIPv4Func == nil {
+		var r0 []govultr.IPv4
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("InstanceService", "ListIPv4")
+	}
+
+	return m.ListIPv4Func(ctx, instanceID, option)
+}
+
+// DeleteIPv4 records the call and calls DeleteIPv4Func
+func (m *InstanceService) DeleteIPv4(ctx context.Context, instanceID, ip string) error {
+	m.record("DeleteIPv4", ctx, instanceID, ip)
+	if m.DeleteIPv4Func == nil {
+		return notMocked("InstanceService", "DeleteIPv4")
+	}
+
+	return m.DeleteIPv4Func(ctx, instanceID, ip)
+}
+
+// ListIPv6 records the call and calls ListIPv6Func
+func (m *InstanceService) ListIPv6(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *http.Response, error) {
+	m.record("ListIPv6", ctx, instanceID, option)
+	if m.ListIPv6Func == nil {
+		var r0 []govultr.IPv6
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("InstanceService", "ListIPv6")
+	}
+
+	return m.ListIPv6Func(ctx, instanceID, option)
+}
+
+// CreateReverseIPv6 records the call and calls CreateReverseIPv6Func
+func (m *InstanceService) CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) error {
+	m.record("CreateReverseIPv6", ctx, instanceID, reverseReq)
+	if m.CreateReverseIPv6Func == nil {
+		return notMocked("InstanceService", "CreateReverseIPv6")
+	}
+
+	return m.CreateReverseIPv6Func(ctx, instanceID, reverseReq)
+}
+
+// ListReverseIPv6 records the call and calls ListReverseIPv6Func
+func (m *InstanceService) ListReverseIPv6(ctx context.Context, instanceID string) ([]govultr.ReverseIP, *http.Response, error) {
+	m.record("ListReverseIPv6", ctx, instanceID)
+	if m.ListReverseIPv6Func == nil {
+		var r0 []govultr.ReverseIP
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "ListReverseIPv6")
+	}
+
+	return m.ListReverseIPv6Func(ctx, instanceID)
+}
+
+// DeleteReverseIPv6 records the call and calls DeleteReverseIPv6Func
+func (m *InstanceService) DeleteReverseIPv6(ctx context.Context, instanceID, ip string) error {
+	m.record("DeleteReverseIPv6", ctx, instanceID, ip)
+	if m.DeleteReverseIPv6Func == nil {
+		return notMocked("InstanceService", "DeleteReverseIPv6")
+	}
+
+	return m.DeleteReverseIPv6Func(ctx, instanceID, ip)
+}
+
+// CreateReverseIPv4 records the call and calls CreateReverseIPv4Func
+func (m *InstanceService) CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) error {
+	m.record("CreateReverseIPv4", ctx, instanceID, reverseReq)
+	if m.CreateReverseIPv4Func == nil {
+		return notMocked("InstanceService", "CreateReverseIPv4")
+	}
+
+	return m.CreateReverseIPv4Func(ctx, instanceID, reverseReq)
+}
+
+// DefaultReverseIPv4 records the call and calls DefaultReverseIPv4Func
+func (m *InstanceService) DefaultReverseIPv4(ctx context.Context, instanceID, ip string) error {
+	m.record("DefaultReverseIPv4", ctx, instanceID, ip)
+	if m.DefaultReverseIPv4Func == nil {
+		return notMocked("InstanceService", "DefaultReverseIPv4")
+	}
+
+	return m.DefaultReverseIPv4Func(ctx, instanceID, ip)
+}
+
+// GetUserData records the call and calls GetUserDataFunc
+func (m *InstanceService) GetUserData(ctx context.Context, instanceID string) (*govultr.UserData, *http.Response, error) {
+	m.record("GetUserData", ctx, instanceID)
+	if m.GetUserDataFunc == nil {
+		var r0 *govultr.UserData
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "GetUserData")
+	}
+
+	return m.GetUserDataFunc(ctx, instanceID)
+}
+
+// GetUpgrades records the call and calls GetUpgradesFunc
+func (m *InstanceService) GetUpgrades(ctx context.Context, instanceID string) (*govultr.Upgrades, *http.Response, error) {
+	m.record("GetUpgrades", ctx, instanceID)
+	if m.GetUpgradesFunc == nil {
+		var r0 *govultr.Upgrades
+		var r1 *http.Response
+		return r0, r1, notMocked("InstanceService", "GetUpgrades")
+	}
+
+	return m.GetUpgradesFunc(ctx, instanceID)
+}
+
+// ISOService is a mock of govultr.ISOService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type ISOService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, isoReq *govultr.ISOReq) (*govultr.ISO, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, isoID string) (*govultr.ISO, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, isoID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ISO, *govultr.Meta, *http.Response, error)
+
+	// ListPublicFunc is called by ListPublic
+	ListPublicFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.PublicISO, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.ISOService = (*ISOService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *ISOService) Create(ctx context.Context, isoReq *govultr.ISOReq) (*govultr.ISO, *http.Response, error) {
+	m.record("Create", ctx, isoReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.ISO
+		var r1 *http.Response
+		return r0, r1, notMocked("ISOService", "Create")
+	}
+
+	return m.CreateFunc(ctx, isoReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *ISOService) Get(ctx context.Context, isoID string) (*govultr.ISO, *http.Response, error) {
+	m.record("Get", ctx, isoID)
+	if m.GetFunc == nil {
+		var r0 *govultr.ISO
+		var r1 *http.Response
+		return r0, r1, notMocked("ISOService", "Get")
+	}
+
+	return m.GetFunc(ctx, isoID)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *ISOService) Delete(ctx context.Context, isoID string) error {
+	m.record("Delete", ctx, isoID)
+	if m.DeleteFunc == nil {
+		return notMocked("ISOService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, isoID)
+}
+
+// List records the call and calls ListFunc
+func (m *ISOService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ISO, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.ISO
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ISOService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// ListPublic records the call and calls ListPublicFunc
+func (m *ISOService) ListPublic(ctx context.Context, options *govultr.ListOptions) ([]govultr.PublicISO, *govultr.Meta, *http.Response, error) {
+	m.record("ListPublic", ctx, options)
+	if m.ListPublicFunc == nil {
+		var r0 []govultr.PublicISO
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ISOService", "ListPublic")
+	}
+
+	return m.ListPublicFunc(ctx, options)
+}
+
+// KubernetesService is a mock of govultr.KubernetesService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type KubernetesService struct {
+	calls
+
+	// CreateClusterFunc is called by CreateCluster
+	CreateClusterFunc func(ctx context.Context, createReq *govultr.ClusterReq) (*govultr.Cluster, *http.Response, error)
+
+	// GetClusterFunc is called by GetCluster
+	GetClusterFunc func(ctx context.Context, id string) (*govultr.Cluster, *http.Response, error)
+
+	// ListClustersFunc is called by ListClusters
+	ListClustersFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *http.Response, error)
+
+	// UpdateClusterFunc is called by UpdateCluster
+	UpdateClusterFunc func(ctx context.Context, vkeID string, updateReq *govultr.ClusterReqUpdate) error
+
+	// DeleteClusterFunc is called by DeleteCluster
+	DeleteClusterFunc func(ctx context.Context, id string) error
+
+	// DeleteClusterWithResourcesFunc is called by DeleteClusterWithResources
+	DeleteClusterWithResourcesFunc func(ctx context.Context, id string) error
+
+	// CreateNodePoolFunc is called by CreateNodePool
+	CreateNodePoolFunc func(ctx context.Context, vkeID string, nodePoolReq *govultr.NodePoolReq) (*govultr.NodePool, *http.Response, error)
+
+	// ListNodePoolsFunc is called by ListNodePools
+	ListNodePoolsFunc func(ctx context.Context, vkeID string, options *govultr.ListOptions) ([]govultr.NodePool, *govultr.Meta, *http.Response, error)
+
+	// GetNodePoolFunc is called by GetNodePool
+	GetNodePoolFunc func(ctx context.Context, vkeID, nodePoolID string) (*govultr.NodePool, *http.Response, error)
+
+	// UpdateNodePoolFunc is called by UpdateNodePool
+	UpdateNodePoolFunc func(ctx context.Context, vkeID, nodePoolID string, updateReq *govultr.NodePoolReqUpdate) (*govultr.NodePool, *http.Response, error)
+
+	// DeleteNodePoolFunc is called by DeleteNodePool
+	DeleteNodePoolFunc func(ctx context.Context, vkeID, nodePoolID string) error
+
+	// ListNodePoolLabelsFunc is called by ListNodePoolLabels
+	ListNodePoolLabelsFunc func(ctx context.Context, vkeID, nodePoolID string) ([]govultr.NodePoolLabel, *http.Response, error)
+
+	// CreateNodePoolLabelFunc is called by CreateNodePoolLabel
+	CreateNodePoolLabelFunc func(ctx context.Context, vkeID string, nodePoolID string, nodePoolLabelReq *govultr.NodePoolLabelReq) (*govultr.NodePoolLabel, *http.Response, error)
+
+	// GetNodePoolLabelFunc is called by GetNodePoolLabel
+	GetNodePoolLabelFunc func(ctx context.Context, vkeID string, nodePoolID string, nodePoolLabelID string) (*govultr.NodePoolLabel, *http.Response, error)
+
+	// DeleteNodePoolLabelFunc is called by DeleteNodePoolLabel
+	DeleteNodePoolLabelFunc func(ctx context.Context, vkeID string, nodePoolID string, nodePoolLabelID string) error
+
+	// ListNodePoolTaintsFunc is called by ListNodePoolTaints
+	ListNodePoolTaintsFunc func(ctx context.Context, vkeID, nodePoolID string) ([]govultr.NodePoolTaint, *http.Response, error)
+
+	// CreateNodePoolTaintFunc is called by CreateNodePoolTaint
+	CreateNodePoolTaintFunc func(ctx context.Context, vkeID string, nodePoolID string, nodePoolTaintReq *govultr.NodePoolTaintReq) (*govultr.NodePoolTaint, *http.Response, error)
+
+	// GetNodePoolTaintFunc is called by GetNodePoolTaint
+	GetNodePoolTaintFunc func(ctx context.Context, vkeID string, nodePoolID string, nodePoolTaintID string) (*govultr.NodePoolTaint, *http.Response, error)
+
+	// DeleteNodePoolTaintFunc is called by DeleteNodePoolTaint
+	DeleteNodePoolTaintFunc func(ctx context.Context, vkeID string, nodePoolID string, nodePoolTaintID string) error
+
+	// ListWorkerNodesFunc is called by ListWorkerNodes
+	ListWorkerNodesFunc func(ctx context.Context, vkeID, nodePoolID string, options *govultr.ListOptions) ([]govultr.Node, *govultr.Meta, *http.Response, error)
+
+	// DeleteNodePoolInstanceFunc is called by DeleteNodePoolInstance
+	DeleteNodePoolInstanceFunc func(ctx context.Context, vkeID, nodePoolID, nodeID string) error
+
+	// RecycleNodePoolInstanceFunc is called by RecycleNodePoolInstance
+	RecycleNodePoolInstanceFunc func(ctx context.Context, vkeID, nodePoolID, nodeID string) error
+
+	// GetKubeConfigFunc is called by GetKubeConfig
+	GetKubeConfigFunc func(ctx context.Context, vkeID string) (*govultr.KubeConfig, *http.Response, error)
+
+	// GetVersionsFunc is called by GetVersions
+	GetVersionsFunc func(ctx context.Context) (*govultr.Versions, *http.Response, error)
+
+	// GetUpgradesFunc is called by GetUpgrades
+	GetUpgradesFunc func(ctx context.Context, vkeID string) ([]string, *http.Response, error)
+
+	// UpgradeFunc is called by Upgrade
+	UpgradeFunc func(ctx context.Context, vkeID string, body *govultr.ClusterUpgradeReq) error
+}
+
+var _ govultr.KubernetesService = (*KubernetesService)(nil)
+
+// CreateCluster records the call and calls CreateClusterFunc
+func (m *KubernetesService) CreateCluster(ctx context.Context, createReq *govultr.ClusterReq) (*govultr.Cluster, *http.Response, error) {
+	m.record("CreateCluster", ctx, createReq)
+	if m.CreateClusterFunc == nil {
+		var r0 *govultr.Cluster
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "CreateCluster")
+	}
+
+	return m.CreateClusterFunc(ctx, createReq)
+}
+
+// GetCluster records the call and calls GetClusterFunc
+func (m *KubernetesService) GetCluster(ctx context.Context, id string) (*govultr.Cluster, *http.Response, error) {
+	m.record("GetCluster", ctx, id)
+	if m.GetClusterFunc == nil {
+		var r0 *govultr.Cluster
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetCluster")
+	}
+
+	return m.GetClusterFunc(ctx, id)
+}
+
+// ListClusters records the call and calls ListClustersFunc
+func (m *KubernetesService) ListClusters(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *http.Response, error) {
+	m.record("ListClusters", ctx, options)
+	if m.ListClustersFunc == nil {
+		var r0 []govultr.Cluster
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("KubernetesService", "ListClusters")
+	}
+
+	return m.ListClustersFunc(ctx, options)
+}
+
+// UpdateCluster records the call and calls UpdateClusterFunc
+func (m *KubernetesService) UpdateCluster(ctx context.Context, vkeID string, updateReq *govultr.ClusterReqUpdate) error {
+	m.record("UpdateCluster", ctx, vkeID, updateReq)
+	if m.UpdateClusterFunc == nil {
+		return notMocked("KubernetesService", "UpdateCluster")
+	}
+
+	return m.UpdateClusterFunc(ctx, vkeID, updateReq)
+}
+
+// DeleteCluster records the call and calls DeleteClusterFunc
+func (m *KubernetesService) DeleteCluster(ctx context.Context, id string) error {
+	m.record("DeleteCluster", ctx, id)
+	if m.DeleteClusterFunc == nil {
+		return notMocked("KubernetesService", "DeleteCluster")
+	}
+
+	return m.DeleteClusterFunc(ctx, id)
+}
+
+// DeleteClusterWithResources records the call and calls DeleteClusterWithResourcesFunc
+func (m *KubernetesService) DeleteClusterWithResources(ctx context.Context, id string) error {
+	m.record("DeleteClusterWithResources", ctx, id)
+	if m.DeleteClusterWithResourcesFunc == nil {
+		return notMocked("KubernetesService", "DeleteClusterWithResources")
+	}
+
+	return m.DeleteClusterWithResourcesFunc(ctx, id)
+}
+
+// CreateNodePool records the call and calls CreateNodePoolFunc
+func (m *KubernetesService) CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *govultr.NodePoolReq) (*govultr.NodePool, *http.Response, error) {
+	m.record("CreateNodePool", ctx, vkeID, nodePoolReq)
+	if m.CreateNodePoolFunc == nil {
+		var r0 *govultr.NodePool
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "CreateNodePool")
+	}
+
+	return m.CreateNodePoolFunc(ctx, vkeID, nodePoolReq)
+}
+
+// ListNodePools records the call and calls ListNodePoolsFunc
+func (m *KubernetesService) ListNodePools(ctx context.Context, vkeID string, options *govultr.ListOptions) ([]govultr.NodePool, *govultr.Meta, *http.Response, error) {
+	m.record("ListNodePools", ctx, vkeID, options)
+	if m.ListNodePoolsFunc == nil {
+		var r0 []govultr.NodePool
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("KubernetesService", "ListNodePools")
+	}
+
+	return m.ListNodePoolsFunc(ctx, vkeID, options)
+}
+
+// GetNodePool records the call and calls GetNodePoolFunc
+func (m *KubernetesService) GetNodePool(ctx context.Context, vkeID, nodePoolID string) (*govultr.NodePool, *http.Response, error) {
+	m.record("GetNodePool", ctx, vkeID, nodePoolID)
+	if m.GetNodePoolFunc == nil {
+		var r0 *govultr.NodePool
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetNodePool")
+	}
+
+	return m.GetNodePoolFunc(ctx, vkeID, nodePoolID)
+}
+
+// UpdateNodePool records the call and calls UpdateNodePoolFunc
+func (m *KubernetesService) UpdateNodePool(ctx context.Context, vkeID, nodePoolID string, updateReq *govultr.NodePoolReqUpdate) (*govultr.NodePool, *http.Response, error) {
+	m.record("UpdateNodePool", ctx, vkeID, nodePoolID, updateReq)
+	if m.UpdateNodePoolFunc == nil {
+		var r0 *govultr.NodePool
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "UpdateNodePool")
+	}
+
+	return m.UpdateNodePoolFunc(ctx, vkeID, nodePoolID, updateReq)
+}
+
+// DeleteNodePool records the call and calls DeleteNodePoolFunc
+func (m *KubernetesService) DeleteNodePool(ctx context.Context, vkeID, nodePoolID string) error {
+	m.record("DeleteNodePool", ctx, vkeID, nodePoolID)
+	if m.DeleteNodePoolFunc == nil {
+		return notMocked("KubernetesService", "DeleteNodePool")
+	}
+
+	return m.DeleteNodePoolFunc(ctx, vkeID, nodePoolID)
+}
+
+// ListNodePoolLabels records the call and calls ListNodePoolLabelsFunc
+func (m *KubernetesService) ListNodePoolLabels(ctx context.Context, vkeID, nodePoolID string) ([]govultr.NodePoolLabel, *http.Response, error) {
+	m.record("ListNodePoolLabels", ctx, vkeID, nodePoolID)
+	if m.ListNodePoolLabelsFunc == nil {
+		var r0 []govultr.NodePoolLabel
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "ListNodePoolLabels")
+	}
+
+	return m.ListNodePoolLabelsFunc(ctx, vkeID, nodePoolID)
+}
+
+// CreateNodePoolLabel records the call and calls CreateNodePoolLabelFunc
+func (m *KubernetesService) CreateNodePoolLabel(ctx context.Context, vkeID string, nodePoolID string, nodePoolLabelReq *govultr.NodePoolLabelReq) (*govultr.NodePoolLabel, *http.Response, error) {
+	m.record("CreateNodePoolLabel", ctx, vkeID, nodePoolID, nodePoolLabelReq)
+	if m.CreateNodePoolLabelFunc == nil {
+		var r0 *govultr.NodePoolLabel
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "CreateNodePoolLabel")
+	}
+
+	return m.CreateNodePoolLabelFunc(ctx, vkeID, nodePoolID, nodePoolLabelReq)
+}
+
+// GetNodePoolLabel records the call and calls GetNodePoolLabelFunc
+func (m *KubernetesService) GetNodePoolLabel(ctx context.Context, vkeID string, nodePoolID string, nodePoolLabelID string) (*govultr.NodePoolLabel, *http.Response, error) {
+	m.record("GetNodePoolLabel", ctx, vkeID, nodePoolID, nodePoolLabelID)
+	if m.GetNodePoolLabelFunc == nil {
+		var r0 *govultr.NodePoolLabel
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetNodePoolLabel")
+	}
+
+	return m.GetNodePoolLabelFunc(ctx, vkeID, nodePoolID, nodePoolLabelID)
+}
+
+// DeleteNodePoolLabel records the call and calls DeleteNodePoolLabelFunc
+func (m *KubernetesService) DeleteNodePoolLabel(ctx context.Context, vkeID string, nodePoolID string, nodePoolLabelID string) error {
+	m.record("DeleteNodePoolLabel", ctx, vkeID, nodePoolID, nodePoolLabelID)
+	if m.DeleteNodePoolLabelFunc == nil {
+		return notMocked("KubernetesService", "DeleteNodePoolLabel")
+	}
+
+	return m.DeleteNodePoolLabelFunc(ctx, vkeID, nodePoolID, nodePoolLabelID)
+}
+
+// ListNodePoolTaints records the call and calls ListNodePoolTaintsFunc
+func (m *KubernetesService) ListNodePoolTaints(ctx context.Context, vkeID, nodePoolID string) ([]govultr.NodePoolTaint, *http.Response, error) {
+	m.record("ListNodePoolTaints", ctx, vkeID, nodePoolID)
+	if m.ListNodePoolTaintsFunc == nil {
+		var r0 []govultr.NodePoolTaint
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "ListNodePoolTaints")
+	}
+
+	return m.ListNodePoolTaintsFunc(ctx, vkeID, nodePoolID)
+}
+
+// CreateNodePoolTaint records the call and calls CreateNodePoolTaintFunc
+func (m *KubernetesService) CreateNodePoolTaint(ctx context.Context, vkeID string, nodePoolID string, nodePoolTaintReq *govultr.NodePoolTaintReq) (*govultr.NodePoolTaint, *http.Response, error) {
+	m.record("CreateNodePoolTaint", ctx, vkeID, nodePoolID, nodePoolTaintReq)
+	if m.CreateNodePoolTaintFunc == nil {
+		var r0 *govultr.NodePoolTaint
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "CreateNodePoolTaint")
+	}
+
+	return m.CreateNodePoolTaintFunc(ctx, vkeID, nodePoolID, nodePoolTaintReq)
+}
+
+// GetNodePoolTaint records the call and calls GetNodePoolTaintFunc
+func (m *KubernetesService) GetNodePoolTaint(ctx context.Context, vkeID string, nodePoolID string, nodePoolTaintID string) (*govultr.NodePoolTaint, *http.Response, error) {
+	m.record("GetNodePoolTaint", ctx, vkeID, nodePoolID, nodePoolTaintID)
+	if m.GetNodePoolTaintFunc == nil {
+		var r0 *govultr.NodePoolTaint
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetNodePoolTaint")
+	}
+
+	return m.GetNodePoolTaintFunc(ctx, vkeID, nodePoolID, nodePoolTaintID)
+}
+
+// DeleteNodePoolTaint records the call and calls DeleteNodePoolTaintFunc
+func (m *KubernetesService) DeleteNodePoolTaint(ctx context.Context, vkeID string, nodePoolID string, nodePoolTaintID string) error {
+	m.record("DeleteNodePoolTaint", ctx, vkeID, nodePoolID, nodePoolTaintID)
+	if m.DeleteNodePoolTaintFunc == nil {
+		return notMocked("KubernetesService", "DeleteNodePoolTaint")
+	}
+
+	return m.DeleteNodePoolTaintFunc(ctx, vkeID, nodePoolID, nodePoolTaintID)
+}
+
+// ListWorkerNodes records the call and calls ListWorkerNodesFunc
+func (m *KubernetesService) ListWorkerNodes(ctx context.Context, vkeID, nodePoolID string, options *govultr.ListOptions) ([]govultr.Node, *govultr.Meta, *http.Response, error) {
+	m.record("ListWorkerNodes", ctx, vkeID, nodePoolID, options)
+	if m.ListWorkerNodesFunc == nil {
+		var r0 []govultr.Node
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("KubernetesService", "ListWorkerNodes")
+	}
+
+	return m.ListWorkerNodesFunc(ctx, vkeID, nodePoolID, options)
+}
+
+// DeleteNodePoolInstance records the call and calls DeleteNodePoolInstanceFunc
+func (m *KubernetesService) DeleteNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) error {
+	m.record("DeleteNodePoolInstance", ctx, vkeID, nodePoolID, nodeID)
+	if m.DeleteNodePoolInstanceFunc == nil {
+		return notMocked("KubernetesService", "DeleteNodePoolInstance")
+	}
+
+	return m.DeleteNodePoolInstanceFunc(ctx, vkeID, nodePoolID, nodeID)
+}
+
+// RecycleNodePoolInstance records the call and calls RecycleNodePoolInstanceFunc
+func (m *KubernetesService) RecycleNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) error {
+	m.record("RecycleNodePoolInstance", ctx, vkeID, nodePoolID, nodeID)
+	if m.RecycleNodePoolInstanceFunc == nil {
+		return notMocked("KubernetesService", "RecycleNodePoolInstance")
+	}
+
+	return m.RecycleNodePoolInstanceFunc(ctx, vkeID, nodePoolID, nodeID)
+}
+
+// GetKubeConfig records the call and calls GetKubeConfigFunc
+func (m *KubernetesService) GetKubeConfig(ctx context.Context, vkeID string) (*govultr.KubeConfig, *http.Response, error) {
+	m.record("GetKubeConfig", ctx, vkeID)
+	if m.GetKubeConfigFunc == nil {
+		var r0 *govultr.KubeConfig
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetKubeConfig")
+	}
+
+	return m.GetKubeConfigFunc(ctx, vkeID)
+}
+
+// GetVersions records the call and calls GetVersionsFunc
+func (m *KubernetesService) GetVersions(ctx context.Context) (*govultr.Versions, *http.Response, error) {
+	m.record("GetVersions", ctx)
+	if m.GetVersionsFunc == nil {
+		var r0 *govultr.Versions
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetVersions")
+	}
+
+	return m.GetVersionsFunc(ctx)
+}
+
+// GetUpgrades records the call and calls GetUpgradesFunc
+func (m *KubernetesService) GetUpgrades(ctx context.Context, vkeID string) ([]string, *http.Response, error) {
+	m.record("GetUpgrades", ctx, vkeID)
+	if m.GetUpgradesFunc == nil {
+		var r0 []string
+		var r1 *http.Response
+		return r0, r1, notMocked("KubernetesService", "GetUpgrades")
+	}
+
+	return m.GetUpgradesFunc(ctx, vkeID)
+}
+
+// Upgrade records the call and calls UpgradeFunc
+func (m *KubernetesService) Upgrade(ctx context.Context, vkeID string, body *govultr.ClusterUpgradeReq) error {
+	m.record("Upgrade", ctx, vkeID, body)
+	if m.UpgradeFunc == nil {
+		return notMocked("KubernetesService", "Upgrade")
+	}
+
+	return m.UpgradeFunc(ctx, vkeID, body)
+}
+
+// LoadBalancerService is a mock of govultr.LoadBalancerService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type LoadBalancerService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, createReq *govultr.LoadBalancerReq) (*govultr.LoadBalancer, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, lbID string) (*govultr.LoadBalancer, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, lbID string, updateReq *govultr.LoadBalancerReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, lbID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *http.Response, error)
+
+	// DeleteSSLFunc is called by DeleteSSL
+	DeleteSSLFunc func(ctx context.Context, lbID string) error
+
+	// DeleteAutoSSLFunc is called by DeleteAutoSSL
+	DeleteAutoSSLFunc func(ctx context.Context, lbID string) error
+
+	// CreateForwardingRuleFunc is called by CreateForwardingRule
+	CreateForwardingRuleFunc func(ctx context.Context, lbID string, rule *govultr.ForwardingRule) (*govultr.ForwardingRule, *http.Response, error)
+
+	// GetForwardingRuleFunc is called by GetForwardingRule
+	GetForwardingRuleFunc func(ctx context.Context, lbID string, ruleID string) (*govultr.ForwardingRule, *http.Response, error)
+
+	// DeleteForwardingRuleFunc is called by DeleteForwardingRule
+	DeleteForwardingRuleFunc func(ctx context.Context, lbID string, RuleID string) error
+
+	// ListForwardingRulesFunc is called by ListForwardingRules
+	ListForwardingRulesFunc func(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.ForwardingRule, *govultr.Meta, *http.Response, error)
+
+	// CreateFirewallRulesFunc is called by CreateFirewallRules
+	CreateFirewallRulesFunc func(ctx context.Context, lbID string, fwRule []govultr.LBFirewallRule) error
+
+	// GetFirewallRuleFunc is called by GetFirewallRule
+	GetFirewallRuleFunc func(ctx context.Context, lbID string, ruleID string) (*govultr.LBFirewallRule, *http.Response, error)
+
+	// DeleteFirewallRuleFunc is called by DeleteFirewallRule
+	DeleteFirewallRuleFunc func(ctx context.Context, lbID, fwRuleID string) error
+
+	// ListFirewallRulesFunc is called by ListFirewallRules
+	ListFirewallRulesFunc func(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.LBFirewallRule, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.LoadBalancerService = (*LoadBalancerService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *LoadBalancerService) Create(ctx context.Context, createReq *govultr.LoadBalancerReq) (*govultr.LoadBalancer, *http.Response, error) {
+	m.record("Create", ctx, createReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.LoadBalancer
+		var r1 *http.Response
+		return r0, r1, notMocked("LoadBalancerService", "Create")
+	}
+
+	return m.CreateFunc(ctx, createReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *LoadBalancerService) Get(ctx context.Context, lbID string) (*govultr.LoadBalancer, *http.Response, error) {
+	m.record("Get", ctx, lbID)
+	if m.GetFunc == nil {
+		var r0 *govultr.LoadBalancer
+		var r1 *http.Response
+		return r0, r1, notMocked("LoadBalancerService", "Get")
+	}
+
+	return m.GetFunc(ctx, lbID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *LoadBalancerService) Update(ctx context.Context, lbID string, updateReq *govultr.LoadBalancerReq) error {
+	m.record("Update", ctx, lbID, updateReq)
+	if m.UpdateFunc == nil {
+		return notMocked("LoadBalancerService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, lbID, updateReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *LoadBalancerService) Delete(ctx context.Context, lbID string) error {
+	m.record("Delete", ctx, lbID)
+	if m.DeleteFunc == nil {
+		return notMocked("LoadBalancerService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, lbID)
+}
+
+// List records the call and calls ListFunc
+func (m *LoadBalancerService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.LoadBalancer
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("LoadBalancerService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// DeleteSSL records the call and calls DeleteSSLFunc
+func (m *LoadBalancerService) DeleteSSL(ctx context.Context, lbID string) error {
+	m.record("DeleteSSL", ctx, lbID)
+	if m.DeleteSSLFunc == nil {
+		return notMocked("LoadBalancerService", "DeleteSSL")
+	}
+
+	return m.DeleteSSLFunc(ctx, lbID)
+}
+
+// DeleteAutoSSL records the call and calls DeleteAutoSSLFunc
+func (m *LoadBalancerService) DeleteAutoSSL(ctx context.Context, lbID string) error {
+	m.record("DeleteAutoSSL", ctx, lbID)
+	if m.DeleteAutoSSLFunc == nil {
+		return notMocked("LoadBalancerService", "DeleteAutoSSL")
+	}
+
+	return m.DeleteAutoSSLFunc(ctx, lbID)
+}
+
+// CreateForwardingRule records the call and calls CreateForwardingRuleFunc
+func (m *LoadBalancerService) CreateForwardingRule(ctx context.Context, lbID string, rule *govultr.ForwardingRule) (*govultr.ForwardingRule, *http.Response, error) {
+	m.record("CreateForwardingRule", ctx, lbID, rule)
+	if m.CreateForwardingRuleFunc == nil {
+		var r0 *govultr.ForwardingRule
+		var r1 *http.Response
+		return r0, r1, notMocked("LoadBalancerService", "CreateForwardingRule")
+	}
+
+	return m.CreateForwardingRuleFunc(ctx, lbID, rule)
+}
+
+// GetForwardingRule records the call and calls GetForwardingRuleFunc
+func (m *LoadBalancerService) GetForwardingRule(ctx context.Context, lbID string, ruleID string) (*govultr.ForwardingRule, *http.Response, error) {
+	m.record("GetForwardingRule", ctx, lbID, ruleID)
+	if m.GetForwardingRuleFunc == nil {
+		var r0 *govultr.ForwardingRule
+		var r1 *http.Response
+		return r0, r1, notMocked("LoadBalancerService", "GetForwardingRule")
+	}
+
+	return m.GetForwardingRuleFunc(ctx, lbID, ruleID)
+}
+
+// DeleteForwardingRule records the call and calls DeleteForwardingRuleFunc
+func (m *LoadBalancerService) DeleteForwardingRule(ctx context.Context, lbID string, RuleID string) error {
+	m.record("DeleteForwardingRule", ctx, lbID, RuleID)
+	if m.DeleteForwardingRuleFunc == nil {
+		return notMocked("LoadBalancerService", "DeleteForwardingRule")
+	}
+
+	return m.DeleteForwardingRuleFunc(ctx, lbID, RuleID)
+}
+
+// ListForwardingRules records the call and calls ListForwardingRulesFunc
+func (m *LoadBalancerService) ListForwardingRules(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.ForwardingRule, *govultr.Meta, *http.Response, error) {
+	m.record("ListForwardingRules", ctx, lbID, options)
+	if m.ListForwardingRulesFunc == nil {
+		var r0 []govultr.ForwardingRule
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("LoadBalancerService", "ListForwardingRules")
+	}
+
+	return m.ListForwardingRulesFunc(ctx, lbID, options)
+}
+
+// CreateFirewallRules records the call and calls CreateFirewallRulesFunc
+func (m *LoadBalancerService) CreateFirewallRules(ctx context.Context, lbID string, fwRule []govultr.LBFirewallRule) error {
+	m.record("CreateFirewallRules", ctx, lbID, fwRule)
+	if m.CreateFirewallRulesFunc == nil {
+		return notMocked("LoadBalancerService", "CreateFirewallRules")
+	}
+
+	return m.CreateFirewallRulesFunc(ctx, lbID, fwRule)
+}
+
+// GetFirewallRule records the call and calls GetFirewallRuleFunc
+func (m *LoadBalancerService) GetFirewallRule(ctx context.Context, lbID string, ruleID string) (*govultr.LBFirewallRule, *http.Response, error) {
+	m.record("GetFirewallRule", ctx, lbID, ruleID)
+	if m.GetFirewallRuleFunc == nil {
+		var r0 *govultr.LBFirewallRule
+		var r1 *http.Response
+		return r0, r1, notMocked("LoadBalancerService", "GetFirewallRule")
+	}
+
+	return m.GetFirewallRuleFunc(ctx, lbID, ruleID)
+}
+
+// DeleteFirewallRule records the call and calls DeleteFirewallRuleFunc
+func (m *LoadBalancerService) DeleteFirewallRule(ctx context.Context, lbID, fwRuleID string) error {
+	m.record("DeleteFirewallRule", ctx, lbID, fwRuleID)
+	if m.DeleteFirewallRuleFunc == nil {
+		return notMocked("LoadBalancerService", "DeleteFirewallRule")
+	}
+
+	return m.DeleteFirewallRuleFunc(ctx, lbID, fwRuleID)
+}
+
+// ListFirewallRules records the call and calls ListFirewallRulesFunc
+func (m *LoadBalancerService) ListFirewallRules(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.LBFirewallRule, *govultr.Meta, *http.Response, error) {
+	m.record("ListFirewallRules", ctx, lbID, options)
+	if m.ListFirewallRulesFunc == nil {
+		var r0 []govultr.LBFirewallRule
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("LoadBalancerService", "ListFirewallRules")
+	}
+
+	return m.ListFirewallRulesFunc(ctx, lbID, options)
+}
+
+// LogsService is a mock of govultr.LogsService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type LogsService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options govultr.LogsOptions) ([]govultr.Log, *govultr.LogsMeta, *http.Response, error)
+
+	// StreamFunc is called by Stream
+	StreamFunc func(ctx context.Context, options govultr.LogsOptions) iter.Seq2[govultr.Log, error]
+
+	// FollowFunc is called by Follow
+	FollowFunc func(ctx context.Context, options govultr.LogsOptions, followOptions *govultr.LogsFollowOptions) <-chan govultr.LogEvent
+}
+
+var _ govultr.LogsService = (*LogsService)(nil)
+
+// List records the call and calls ListFunc
+func (m *LogsService) List(ctx context.Context, options govultr.LogsOptions) ([]govultr.Log, *govultr.LogsMeta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Log
+		var r1 *govultr.LogsMeta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("LogsService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Stream records the call and calls StreamFunc
+func (m *LogsService) Stream(ctx context.Context, options govultr.LogsOptions) iter.Seq2[govultr.Log, error] {
+	m.record("Stream", ctx, options)
+	if m.StreamFunc == nil {
+		var r0 iter.Seq2[govultr.Log, error]
+		return r0
+	}
+
+	return m.StreamFunc(ctx, options)
+}
+
+// Follow records the call and calls FollowFunc
+func (m *LogsService) Follow(ctx context.Context, options govultr.LogsOptions, followOptions *govultr.LogsFollowOptions) <-chan govultr.LogEvent {
+	m.record("Follow", ctx, options, followOptions)
+	if m.FollowFunc == nil {
+		var r0 <-chan govultr.LogEvent
+		return r0
+	}
+
+	return m.FollowFunc(ctx, options, followOptions)
+}
+
+// MarketplaceService is a mock of govultr.MarketplaceService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type MarketplaceService struct {
+	calls
+
+	// ListAppVariablesFunc is called by ListAppVariables
+	ListAppVariablesFunc func(ctx context.Context, imageID string) ([]govultr.MarketplaceAppVariable, *http.Response, error)
+}
+
+var _ govultr.MarketplaceService = (*MarketplaceService)(nil)
+
+// ListAppVariables records the call and calls ListAppVariablesFunc
+func (m *MarketplaceService) ListAppVariables(ctx context.Context, imageID string) ([]govultr.MarketplaceAppVariable, *http.Response, error) {
+	m.record("ListAppVariables", ctx, imageID)
+	if m.ListAppVariablesFunc == nil {
+		var r0 []govultr.MarketplaceAppVariable
+		var r1 *http.Response
+		return r0, r1, notMocked("MarketplaceService", "ListAppVariables")
+	}
+
+	return m.ListAppVariablesFunc(ctx, imageID)
+}
+
+// ObjectStorageService is a mock of govultr.ObjectStorageService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type ObjectStorageService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, objReq *govultr.ObjectStorageReq) (*govultr.ObjectStorage, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, id string) (*govultr.ObjectStorage, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, id string, objReq *govultr.ObjectStorageReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, id string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *http.Response, error)
+
+	// ListClusterFunc is called by ListCluster
+	ListClusterFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorageCluster, *govultr.Meta, *http.Response, error)
+
+	// RegenerateKeysFunc is called by RegenerateKeys
+	RegenerateKeysFunc func(ctx context.Context, id string) (*govultr.S3Keys, *http.Response, error)
+
+	// ListTiersFunc is called by ListTiers
+	ListTiersFunc func(ctx context.Context) ([]govultr.ObjectStorageTier, *http.Response, error)
+
+	// ListClusterTiersFunc is called by ListClusterTiers
+	ListClusterTiersFunc func(ctx context.Context, clusterID int) ([]govultr.ObjectStorageTier, *http.Response, error)
+
+	// ListBucketsFunc is called by ListBuckets
+	ListBucketsFunc func(ctx context.Context, osID string) ([]govultr.ObjectStorageBucket, *http.Response, error)
+
+	// CreateBucketFunc is called by CreateBucket
+	CreateBucketFunc func(ctx context.Context, osID string, bucketReq *govultr.ObjectStorageBucketReq) error
+
+	// DeleteBucketFunc is called by DeleteBucket
+	DeleteBucketFunc func(ctx context.Context, osID, bucketName string) error
+}
+
+var _ govultr.ObjectStorageService = (*ObjectStorageService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *ObjectStorageService) Create(ctx context.Context, objReq *govultr.ObjectStorageReq) (*govultr.ObjectStorage, *http.Response, error) {
+	m.record("Create", ctx, objReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.ObjectStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("ObjectStorageService", "Create")
+	}
+
+	return m.CreateFunc(ctx, objReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *ObjectStorageService) Get(ctx context.Context, id string) (*govultr.ObjectStorage, *http.Response, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc == nil {
+		var r0 *govultr.ObjectStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("ObjectStorageService", "Get")
+	}
+
+	return m.GetFunc(ctx, id)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *ObjectStorageService) Update(ctx context.Context, id string, objReq *govultr.ObjectStorageReq) error {
+	m.record("Update", ctx, id, objReq)
+	if m.UpdateFunc == nil {
+		return notMocked("ObjectStorageService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, id, objReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *ObjectStorageService) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc == nil {
+		return notMocked("ObjectStorageService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, id)
+}
+
+// List records the call and calls ListFunc
+func (m *ObjectStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.ObjectStorage
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ObjectStorageService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// ListCluster records the call and calls ListClusterFunc
+func (m *ObjectStorageService) ListCluster(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorageCluster, *govultr.Meta, *http.Response, error) {
+	m.record("ListCluster", ctx, options)
+	if m.ListClusterFunc == nil {
+		var r0 []govultr.ObjectStorageCluster
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ObjectStorageService", "ListCluster")
+	}
+
+	return m.ListClusterFunc(ctx, options)
+}
+
+// RegenerateKeys records the call and calls RegenerateKeysFunc
+func (m *ObjectStorageService) RegenerateKeys(ctx context.Context, id string) (*govultr.S3Keys, *http.Response, error) {
+	m.record("RegenerateKeys", ctx, id)
+	if m.RegenerateKeysFunc == nil {
+		var r0 *govultr.S3Keys
+		var r1 *http.Response
+		return r0, r1, notMocked("ObjectStorageService", "RegenerateKeys")
+	}
+
+	return m.RegenerateKeysFunc(ctx, id)
+}
+
+// ListTiers records the call and calls ListTiersFunc
+func (m *ObjectStorageService) ListTiers(ctx context.Context) ([]govultr.ObjectStorageTier, *http.Response, error) {
+	m.record("ListTiers", ctx)
+	if m.ListTiersFunc == nil {
+		var r0 []govultr.ObjectStorageTier
+		var r1 *http.Response
+		return r0, r1, notMocked("ObjectStorageService", "ListTiers")
+	}
+
+	return m.ListTiersFunc(ctx)
+}
+
+// ListClusterTiers records the call and calls ListClusterTiersFunc
+func (m *ObjectStorageService) ListClusterTiers(ctx context.Context, clusterID int) ([]govultr.ObjectStorageTier, *http.Response, error) {
+	m.record("ListClusterTiers", ctx, clusterID)
+	if m.ListClusterTiersFunc == nil {
+		var r0 []govultr.ObjectStorageTier
+		var r1 *http.Response
+		return r0, r1, notMocked("ObjectStorageService", "ListClusterTiers")
+	}
+
+	return m.ListClusterTiersFunc(ctx, clusterID)
+}
+
+// ListBuckets records the call and calls ListBucketsFunc
+func (m *ObjectStorageService) ListBuckets(ctx context.Context, osID string) ([]govultr.ObjectStorageBucket, *http.Response, error) {
+	m.record("ListBuckets", ctx, osID)
+	if m.ListBucketsFunc == nil {
+		var r0 []govultr.ObjectStorageBucket
+		var r1 *http.Response
+		return r0, r1, notMocked("ObjectStorageService", "ListBuckets")
+	}
+
+	return m.ListBucketsFunc(ctx, osID)
+}
+
+// CreateBucket records the call and calls CreateBucketFunc
+func (m *ObjectStorageService) CreateBucket(ctx context.Context, osID string, bucketReq *govultr.ObjectStorageBucketReq) error {
+	m.record("CreateBucket", ctx, osID, bucketReq)
+	if m.CreateBucketFunc == nil {
+		return notMocked("ObjectStorageService", "CreateBucket")
+	}
+
+	return m.CreateBucketFunc(ctx, osID, bucketReq)
+}
+
+// DeleteBucket records the call and calls DeleteBucketFunc
+func (m *ObjectStorageService) DeleteBucket(ctx context.Context, osID, bucketName string) error {
+	m.record("DeleteBucket", ctx, osID, bucketName)
+	if m.DeleteBucketFunc == nil {
+		return notMocked("ObjectStorageService", "DeleteBucket")
+	}
+
+	return m.DeleteBucketFunc(ctx, osID, bucketName)
+}
+
+// OIDCService is a mock of govultr.OIDCService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type OIDCService struct {
+	calls
+
+	// CreateOIDCProviderFunc is called by CreateOIDCProvider
+	CreateOIDCProviderFunc func(ctx context.Context, oidcProviderReq *govultr.OIDCProviderReq) (*govultr.OIDCProvider, *http.Response, error)
+
+	// GetOIDCProviderFunc is called by GetOIDCProvider
+	GetOIDCProviderFunc func(ctx context.Context, oidcProviderID string) (*govultr.OIDCProvider, *http.Response, error)
+
+	// ListOIDCProvidersFunc is called by ListOIDCProviders
+	ListOIDCProvidersFunc func(ctx context.Context) ([]govultr.OIDCProvider, *http.Response, error)
+
+	// DeleteOIDCProviderFunc is called by DeleteOIDCProvider
+	DeleteOIDCProviderFunc func(ctx context.Context, oidcProviderID string) error
+
+	// CreateOIDCIssuerFunc is called by CreateOIDCIssuer
+	CreateOIDCIssuerFunc func(ctx context.Context, oidcIssuerReq *govultr.OIDCIssuerReq) (*govultr.OIDCIssuer, *http.Response, error)
+
+	// GetOIDCIssuerFunc is called by GetOIDCIssuer
+	GetOIDCIssuerFunc func(ctx context.Context, oidcIssuerID string) (*govultr.OIDCIssuer, *http.Response, error)
+
+	// ListOIDCIssuersFunc is called by ListOIDCIssuers
+	ListOIDCIssuersFunc func(ctx context.Context) ([]govultr.OIDCIssuer, *http.Response, error)
+
+	// DeleteOIDCIssuerFunc is called by DeleteOIDCIssuer
+	DeleteOIDCIssuerFunc func(ctx context.Context, oidcIssuerID string) error
+
+	// CreateOIDCTokenFunc is called by CreateOIDCToken
+	CreateOIDCTokenFunc func(ctx context.Context, oidcTokenReq *govultr.OIDCTokenReq) (*govultr.OIDCToken, *http.Response, error)
+
+	// GetOIDCTokenFunc is called by GetOIDCToken
+	GetOIDCTokenFunc func(ctx context.Context, oidcTokenID string) (*govultr.OIDCToken, *http.Response, error)
+
+	// AuthorizeOIDCFunc is called by AuthorizeOIDC
+	AuthorizeOIDCFunc func(ctx context.Context, oidcAuthParams *govultr.OIDCAuthorizeParameters) error
+
+	// DiscoveryOIDCFunc is called by DiscoveryOIDC
+	DiscoveryOIDCFunc func(ctx context.Context, oidcProviderID string) (*govultr.OIDCDocument, *http.Response, error)
+}
+
+var _ govultr.OIDCService = (*OIDCService)(nil)
+
+// CreateOIDCProvider records the call and calls CreateOIDCProviderFunc
+func (m *OIDCService) CreateOIDCProvider(ctx context.Context, oidcProviderReq *govultr.OIDCProviderReq) (*govultr.OIDCProvider, *http.Response, error) {
+	m.record("CreateOIDCProvider", ctx, oidcProviderReq)
+	if m.CreateOIDCProviderFunc == nil {
+		var r0 *govultr.OIDCProvider
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "CreateOIDCProvider")
+	}
+
+	return m.CreateOIDCProviderFunc(ctx, oidcProviderReq)
+}
+
+// GetOIDCProvider records the call and calls GetOIDCProviderFunc
+func (m *OIDCService) GetOIDCProvider(ctx context.Context, oidcProviderID string) (*govultr.OIDCProvider, *http.Response, error) {
+	m.record("GetOIDCProvider", ctx, oidcProviderID)
+	if m.GetOIDCProviderFunc == nil {
+		var r0 *govultr.OIDCProvider
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "GetOIDCProvider")
+	}
+
+	return m.GetOIDCProviderFunc(ctx, oidcProviderID)
+}
+
+// ListOIDCProviders records the call and calls ListOIDCProvidersFunc
+func (m *OIDCService) ListOIDCProviders(ctx context.Context) ([]govultr.OIDCProvider, *http.Response, error) {
+	m.record("ListOIDCProviders", ctx)
+	if m.ListOIDCProvidersFunc == nil {
+		var r0 []govultr.OIDCProvider
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "ListOIDCProviders")
+	}
+
+	return m.ListOIDCProvidersFunc(ctx)
+}
+
+// DeleteOIDCProvider records the call and calls DeleteOIDCProviderFunc
+func (m *OIDCService) DeleteOIDCProvider(ctx context.Context, oidcProviderID string) error {
+	m.record("DeleteOIDCProvider", ctx, oidcProviderID)
+	if m.DeleteOIDCProviderFunc == nil {
+		return notMocked("OIDCService", "DeleteOIDCProvider")
+	}
+
+	return m.DeleteOIDCProviderFunc(ctx, oidcProviderID)
+}
+
+// CreateOIDCIssuer records the call and calls CreateOIDCIssuerFunc
+func (m *OIDCService) CreateOIDCIssuer(ctx context.Context, oidcIssuerReq *govultr.OIDCIssuerReq) (*govultr.OIDCIssuer, *http.Response, error) {
+	m.record("CreateOIDCIssuer", ctx, oidcIssuerReq)
+	if m.CreateOIDCIssuerFunc == nil {
+		var r0 *govultr.OIDCIssuer
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "CreateOIDCIssuer")
+	}
+
+	return m.CreateOIDCIssuerFunc(ctx, oidcIssuerReq)
+}
+
+// GetOIDCIssuer records the call and calls GetOIDCIssuerFunc
+func (m *OIDCService) GetOIDCIssuer(ctx context.Context, oidcIssuerID string) (*govultr.OIDCIssuer, *http.Response, error) {
+	m.record("GetOIDCIssuer", ctx, oidcIssuerID)
+	if m.GetOIDCIssuerFunc == nil {
+		var r0 *govultr.OIDCIssuer
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "GetOIDCIssuer")
+	}
+
+	return m.GetOIDCIssuerFunc(ctx, oidcIssuerID)
+}
+
+// ListOIDCIssuers records the call and calls ListOIDCIssuersFunc
+func (m *OIDCService) ListOIDCIssuers(ctx context.Context) ([]govultr.OIDCIssuer, *http.Response, error) {
+	m.record("ListOIDCIssuers", ctx)
+	if m.ListOIDCIssuersFunc == nil {
+		var r0 []govultr.OIDCIssuer
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "ListOIDCIssuers")
+	}
+
+	return m.ListOIDCIssuersFunc(ctx)
+}
+
+// DeleteOIDCIssuer records the call and calls DeleteOIDCIssuerFunc
+func (m *OIDCService) DeleteOIDCIssuer(ctx context.Context, oidcIssuerID string) error {
+	m.record("DeleteOIDCIssuer", ctx, oidcIssuerID)
+	if m.DeleteOIDCIssuerFunc == nil {
+		return notMocked("OIDCService", "DeleteOIDCIssuer")
+	}
+
+	return m.DeleteOIDCIssuerFunc(ctx, oidcIssuerID)
+}
+
+// CreateOIDCToken records the call and calls CreateOIDCTokenFunc
+func (m *OIDCService) CreateOIDCToken(ctx context.Context, oidcTokenReq *govultr.OIDCTokenReq) (*govultr.OIDCToken, *http.Response, error) {
+	m.record("CreateOIDCToken", ctx, oidcTokenReq)
+	if m.CreateOIDCTokenFunc == nil {
+		var r0 *govultr.OIDCToken
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "CreateOIDCToken")
+	}
+
+	return m.CreateOIDCTokenFunc(ctx, oidcTokenReq)
+}
+
+// GetOIDCToken records the call and calls GetOIDCTokenFunc
+func (m *OIDCService) GetOIDCToken(ctx context.Context, oidcTokenID string) (*govultr.OIDCToken, *http.Response, error) {
+	m.record("GetOIDCToken", ctx, oidcTokenID)
+	if m.GetOIDCTokenFunc == nil {
+		var r0 *govultr.OIDCToken
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "GetOIDCToken")
+	}
+
+	return m.GetOIDCTokenFunc(ctx, oidcTokenID)
+}
+
+// AuthorizeOIDC records the call and calls AuthorizeOIDCFunc
+func (m *OIDCService) AuthorizeOIDC(ctx context.Context, oidcAuthParams *govultr.OIDCAuthorizeParameters) error {
+	m.record("AuthorizeOIDC", ctx, oidcAuthParams)
+	if m.AuthorizeOIDCFunc == nil {
+		return notMocked("OIDCService", "AuthorizeOIDC")
+	}
+
+	return m.AuthorizeOIDCFunc(ctx, oidcAuthParams)
+}
+
+// DiscoveryOIDC records the call and calls DiscoveryOIDCFunc
+func (m *OIDCService) DiscoveryOIDC(ctx context.Context, oidcProviderID string) (*govultr.OIDCDocument, *http.Response, error) {
+	m.record("DiscoveryOIDC", ctx, oidcProviderID)
+	if m.DiscoveryOIDCFunc == nil {
+		var r0 *govultr.OIDCDocument
+		var r1 *http.Response
+		return r0, r1, notMocked("OIDCService", "DiscoveryOIDC")
+	}
+
+	return m.DiscoveryOIDCFunc(ctx, oidcProviderID)
+}
+
+// OrganizationService is a mock of govultr.OrganizationService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type OrganizationService struct {
+	calls
+
+	// CreateOrganizationFunc is called by CreateOrganization
+	CreateOrganizationFunc func(ctx context.Context, organizationReq *govultr.OrganizationReq) (*govultr.Organization, *http.Response, error)
+
+	// GetOrganizationFunc is called by GetOrganization
+	GetOrganizationFunc func(ctx context.Context, organizationID string) (*govultr.Organization, *http.Response, error)
+
+	// ListOrganizationsFunc is called by ListOrganizations
+	ListOrganizationsFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Organization, *govultr.Meta, *http.Response, error)
+
+	// UpdateOrganizationFunc is called by UpdateOrganization
+	UpdateOrganizationFunc func(ctx context.Context, organizationID string, organizationReq *govultr.OrganizationReq) (*govultr.Organization, *http.Response, error)
+
+	// DeleteOrganizationFunc is called by DeleteOrganization
+	DeleteOrganizationFunc func(ctx context.Context, organizationID string) error
+
+	// RestoreOrganizationFunc is called by RestoreOrganization
+	RestoreOrganizationFunc func(ctx context.Context, organizationID string) error
+
+	// DeleteUserFunc is called by DeleteUser
+	DeleteUserFunc func(ctx context.Context, organizationID, userID string) error
+
+	// SuspendUserFunc is called by SuspendUser
+	SuspendUserFunc func(ctx context.Context, organizationID, userID string) error
+
+	// UnsuspendUserFunc is called by UnsuspendUser
+	UnsuspendUserFunc func(ctx context.Context, organizationID, userID string) error
+
+	// ListSuspendedUsersFunc is called by ListSuspendedUsers
+	ListSuspendedUsersFunc func(ctx context.Context, organizationIDstring string, options *govultr.ListOptions) ([]govultr.OrganizationUser, *govultr.Meta, *http.Response, error)
+
+	// CreateInvitationFunc is called by CreateInvitation
+	CreateInvitationFunc func(ctx context.Context, invitationReq *govultr.OrganizationInvitationReq) (*govultr.OrganizationInvitation, *http.Response, error)
+
+	// GetInvitationFunc is called by GetInvitation
+	GetInvitationFunc func(ctx context.Context, invitationID string) (*govultr.OrganizationInvitation, *http.Response, error)
+
+	// ListInvitationsFunc is called by ListInvitations
+	ListInvitationsFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationInvitation, *govultr.Meta, *http.Response, error)
+
+	// ResendInvitationFunc is called by ResendInvitation
+	ResendInvitationFunc func(ctx context.Context, invitationID string) (*govultr.OrganizationInvitation, *http.Response, error)
+
+	// CreateGroupFunc is called by CreateGroup
+	CreateGroupFunc func(ctx context.Context, groupReq *govultr.OrganizationGroupReq) (*govultr.OrganizationGroup, *http.Response, error)
+
+	// GetGroupFunc is called by GetGroup
+	GetGroupFunc func(ctx context.Context, groupID string) (*govultr.OrganizationGroup, *http.Response, error)
+
+	// ListGroupsFunc is called by ListGroups
+	ListGroupsFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error)
+
+	// UpdateGroupFunc is called by UpdateGroup
+	UpdateGroupFunc func(ctx context.Context, groupID string, groupReq *govultr.OrganizationGroupReq) (*govultr.OrganizationGroup, *http.Response, error)
+
+	// DeleteGroupFunc is called by DeleteGroup
+	DeleteGroupFunc func(ctx context.Context, groupID string) error
+
+	// AddGroupMemberFunc is called by AddGroupMember
+	AddGroupMemberFunc func(ctx context.Context, groupID string, memberReq *govultr.OrganizationGroupMemberReq) error
+
+	// RemoveGroupMemberFunc is called by RemoveGroupMember
+	RemoveGroupMemberFunc func(ctx context.Context, groupID, userID string) error
+
+	// ListGroupPoliciesFunc is called by ListGroupPolicies
+	ListGroupPoliciesFunc func(ctx context.Context, groupID string) (*govultr.OrganizationGroupPolicies, *govultr.Meta, *http.Response, error)
+
+	// ListGroupRolesFunc is called by ListGroupRoles
+	ListGroupRolesFunc func(ctx context.Context, groupID string) (*govultr.OrganizationGroupRoles, *govultr.Meta, *http.Response, error)
+
+	// CreateRoleFunc is called by CreateRole
+	CreateRoleFunc func(ctx context.Context, roleReq *govultr.OrganizationRoleReq) (*govultr.OrganizationRole, *http.Response, error)
+
+	// GetRoleFunc is called by GetRole
+	GetRoleFunc func(ctx context.Context, roleID string) (*govultr.OrganizationRole, *http.Response, error)
+
+	// ListRolesFunc is called by ListRoles
+	ListRolesFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationRole, *govultr.Meta, *http.Response, error)
+
+	// UpdateRoleFunc is called by UpdateRole
+	UpdateRoleFunc func(ctx context.Context, roleID string, roleReq *govultr.OrganizationRoleReq) (*govultr.OrganizationRole, *http.Response, error)
+
+	// DeleteRoleFunc is called by DeleteRole
+	DeleteRoleFunc func(ctx context.Context, roleID string) error
+
+	// RestoreRoleFunc is called by RestoreRole
+	RestoreRoleFunc func(ctx context.Context, roleID string) (*govultr.OrganizationRole, *http.Response, error)
+
+	// CreatePolicyFunc is called by CreatePolicy
+	CreatePolicyFunc func(ctx context.Context, policyReq *govultr.OrganizationPolicyReq) (*govultr.OrganizationPolicy, *http.Response, error)
+
+	// GetPolicyFunc is called by GetPolicy
+	GetPolicyFunc func(ctx context.Context, policyID string) (*govultr.OrganizationPolicy, *http.Response, error)
+
+	// ListPoliciesFunc is called by ListPolicies
+	ListPoliciesFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationPolicy, *govultr.Meta, *http.Response, error)
+
+	// UpdatePolicyFunc is called by UpdatePolicy
+	UpdatePolicyFunc func(ctx context.Context, policyID string, policyReq *govultr.OrganizationPolicyReq) (*govultr.OrganizationPolicy, *http.Response, error)
+
+	// DeletePolicyFunc is called by DeletePolicy
+	DeletePolicyFunc func(ctx context.Context, policyID string) error
+
+	// RestorePolicyFunc is called by RestorePolicy
+	RestorePolicyFunc func(ctx context.Context, policyID string) (*govultr.OrganizationPolicy, *http.Response, error)
+
+	// ListRolePoliciesFunc is called by ListRolePolicies
+	ListRolePoliciesFunc func(ctx context.Context, roleID string, options *govultr.ListOptions) ([]govultr.OrganizationPolicy, *govultr.Meta, *http.Response, error)
+
+	// AttachRolePolicyFunc is called by AttachRolePolicy
+	AttachRolePolicyFunc func(ctx context.Context, roleID, policyID string) (*govultr.OrganizationRolePolicyAttachment, *http.Response, error)
+
+	// DetachRolePolicyFunc is called by DetachRolePolicy
+	DetachRolePolicyFunc func(ctx context.Context, roleID, policyID string) error
+
+	// ListRoleUsersFunc is called by ListRoleUsers
+	ListRoleUsersFunc func(ctx context.Context, roleID string, options *govultr.ListOptions) ([]govultr.OrganizationRoleUserAssignment, *govultr.Meta, *http.Response, error)
+
+	// AttachRoleUserFunc is called by AttachRoleUser
+	AttachRoleUserFunc func(ctx context.Context, roleID, userID string) (*govultr.OrganizationRoleUserAssignment, *http.Response, error)
+
+	// DetachRoleUserFunc is called by DetachRoleUser
+	DetachRoleUserFunc func(ctx context.Context, roleID, userID string) error
+
+	// ListRoleGroupsFunc is called by ListRoleGroups
+	ListRoleGroupsFunc func(ctx context.Context, roleID string, options *govultr.ListOptions) ([]govultr.OrganizationRoleGroupAssignment, *govultr.Meta, *http.Response, error)
+
+	// AttachRoleGroupFunc is called by AttachRoleGroup
+	AttachRoleGroupFunc func(ctx context.Context, roleID, groupID string) (*govultr.OrganizationRoleGroupAssignment, *http.Response, error)
+
+	// DetachRoleGroupFunc is called by DetachRoleGroup
+	DetachRoleGroupFunc func(ctx context.Context, roleID, groupID string) error
+
+	// ListPolicyUsersFunc is called by ListPolicyUsers
+	ListPolicyUsersFunc func(ctx context.Context, policyID string, options *govultr.ListOptions) ([]govultr.OrganizationUser, *govultr.Meta, *http.Response, error)
+
+	// AttachPolicyUserFunc is called by AttachPolicyUser
+	AttachPolicyUserFunc func(ctx context.Context, policyID, userID string) error
+
+	// DetachPolicyUserFunc is called by DetachPolicyUser
+	DetachPolicyUserFunc func(ctx context.Context, policyID, userID string) error
+
+	// ListPolicyGroupsFunc is called by ListPolicyGroups
+	ListPolicyGroupsFunc func(ctx context.Context, policyID string, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error)
+
+	// AttachPolicyGroupFunc is called by AttachPolicyGroup
+	AttachPolicyGroupFunc func(ctx context.Context, policyID, groupID string) error
+
+	// DetachPolicyGroupFunc is called by DetachPolicyGroup
+	DetachPolicyGroupFunc func(ctx context.Context, policyID, groupID string) error
+
+	// CreateRoleTrustFunc is called by CreateRoleTrust
+	CreateRoleTrustFunc func(ctx context.Context, roleTrustReq *govultr.OrganizationRoleTrustCreateReq) (*govultr.OrganizationRoleTrust, *http.Response, error)
+
+	// GetRoleTrustFunc is called by GetRoleTrust
+	GetRoleTrustFunc func(ctx context.Context, roleTrustID string) (*govultr.OrganizationRoleTrust, *http.Response, error)
+
+	// ListRoleTrustsFunc is called by ListRoleTrusts
+	ListRoleTrustsFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationRoleTrust, *govultr.Meta, *http.Response, error)
+
+	// UpdateRoleTrustFunc is called by UpdateRoleTrust
+	UpdateRoleTrustFunc func(ctx context.Context, roleTrustID string, roleTrustReq *govultr.OrganizationRoleTrustUpdateReq) (*govultr.OrganizationRoleTrust, *http.Response, error)
+
+	// DeleteRoleTrustFunc is called by DeleteRoleTrust
+	DeleteRoleTrustFunc func(ctx context.Context, roleTrustID string) error
+
+	// RestoreRoleTrustFunc is called by RestoreRoleTrust
+	RestoreRoleTrustFunc func(ctx context.Context, roleTrustID string) (*govultr.OrganizationRoleTrust, *http.Response, error)
+
+	// ListRoleTrustsByRoleFunc is called by ListRoleTrustsByRole
+	ListRoleTrustsByRoleFunc func(ctx context.Context, roleID string) ([]govultr.OrganizationRoleTrust, *govultr.Meta, *http.Response, error)
+
+	// ListRoleTrustsByUserFunc is called by ListRoleTrustsByUser
+	ListRoleTrustsByUserFunc func(ctx context.Context, userID string) ([]govultr.OrganizationRoleTrust, *govultr.Meta, *http.Response, error)
+
+	// CreateRoleSessionFunc is called by CreateRoleSession
+	CreateRoleSessionFunc func(ctx context.Context, roleSessionReq *govultr.OrganizationRoleSessionReq) (*govultr.OrganizationRoleSession, *http.Response, error)
+
+	// GetRoleSessionFunc is called by GetRoleSession
+	GetRoleSessionFunc func(ctx context.Context, token string) (*govultr.OrganizationRoleSession, *http.Response, error)
+
+	// ListRoleSessionsFunc is called by ListRoleSessions
+	ListRoleSessionsFunc func(ctx context.Context, userID string) ([]govultr.OrganizationRoleSession, *govultr.Meta, *http.Response, error)
+
+	// RevokeRoleSessionFunc is called by RevokeRoleSession
+	RevokeRoleSessionFunc func(ctx context.Context, token string) error
+
+	// ListUserGroupsFunc is called by ListUserGroups
+	ListUserGroupsFunc func(ctx context.Context, userID string, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error)
+
+	// ListUserRolesFunc is called by ListUserRoles
+	ListUserRolesFunc func(ctx context.Context, userID string, options *govultr.ListOptions) (*govultr.OrganizationRolesForUser, *govultr.Meta, *http.Response, error)
+
+	// ListUserPoliciesFunc is called by ListUserPolicies
+	ListUserPoliciesFunc func(ctx context.Context, userID string, options *govultr.ListOptions) (*govultr.OrganizationPoliciesForUser, *govultr.Meta, *http.Response, error)
+
+	// ListCurrentUserGroupsFunc is called by ListCurrentUserGroups
+	ListCurrentUserGroupsFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error)
+
+	// ListCurrentUserRolesFunc is called by ListCurrentUserRoles
+	ListCurrentUserRolesFunc func(ctx context.Context, options *govultr.ListOptions) (*govultr.OrganizationRolesForUser, *govultr.Meta, *http.Response, error)
+
+	// ListCurrentUserPoliciesFunc is called by ListCurrentUserPolicies
+	ListCurrentUserPoliciesFunc func(ctx context.Context, options *govultr.ListOptions) (*govultr.OrganizationPoliciesForUser, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.OrganizationService = (*OrganizationService)(nil)
+
+// CreateOrganization records the call and calls CreateOrganizationFunc
+func (m *OrganizationService) CreateOrganization(ctx context.Context, organizationReq *govultr.OrganizationReq) (*govultr.Organization, *http.Response, error) {
+	m.record("CreateOrganization", ctx, organizationReq)
+	if m.CreateOrganizationFunc == nil {
+		var r0 *govultr.Organization
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreateOrganization")
+	}
+
+	return m.CreateOrganizationFunc(ctx, organizationReq)
+}
+
+// GetOrganization records the call and calls GetOrganizationFunc
+func (m *OrganizationService) GetOrganization(ctx context.Context, organizationID string) (*govultr.Organization, *http.Response, error) {
+	m.record("GetOrganization", ctx, organizationID)
+	if m.GetOrganizationFunc == nil {
+		var r0 *govultr.Organization
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetOrganization")
+	}
+
+	return m.GetOrganizationFunc(ctx, organizationID)
+}
+
+// ListOrganizations records the call and calls ListOrganizationsFunc
+func (m *OrganizationService) ListOrganizations(ctx context.Context, options *govultr.ListOptions) ([]govultr.Organization, *govultr.Meta, *http.Response, error) {
+	m.record("ListOrganizations", ctx, options)
+	if m.ListOrganizationsFunc == nil {
+		var r0 []govultr.Organization
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListOrganizations")
+	}
+
+	return m.ListOrganizationsFunc(ctx, options)
+}
+
+// UpdateOrganization records the call and calls UpdateOrganizationFunc
+func (m *OrganizationService) UpdateOrganization(ctx context.Context, organizationID string, organizationReq *govultr.OrganizationReq) (*govultr.Organization, *http.Response, error) {
+	m.record("UpdateOrganization", ctx, organizationID, organizationReq)
+	if m.UpdateOrganizationFunc == nil {
+		var r0 *govultr.Organization
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "UpdateOrganization")
+	}
+
+	return m.UpdateOrganizationFunc(ctx, organizationID, organizationReq)
+}
+
+// DeleteOrganization records the call and calls DeleteOrganizationFunc
+func (m *OrganizationService) DeleteOrganization(ctx context.Context, organizationID string) error {
+	m.record("DeleteOrganization", ctx, organizationID)
+	if m.DeleteOrganizationFunc == nil {
+		return notMocked("OrganizationService", "DeleteOrganization")
+	}
+
+	return m.DeleteOrganizationFunc(ctx, organizationID)
+}
+
+// RestoreOrganization records the call and calls RestoreOrganizationFunc
+func (m *OrganizationService) RestoreOrganization(ctx context.Context, organizationID string) error {
+	m.record("RestoreOrganization", ctx, organizationID)
+	if m.RestoreOrganizationFunc == nil {
+		return notMocked("OrganizationService", "RestoreOrganization")
+	}
+
+	return m.RestoreOrganizationFunc(ctx, organizationID)
+}
+
+// DeleteUser records the call and calls DeleteUserFunc
+func (m *OrganizationService) DeleteUser(ctx context.Context, organizationID, userID string) error {
+	m.record("DeleteUser", ctx, organizationID, userID)
+	if m.DeleteUserFunc == nil {
+		return notMocked("OrganizationService", "DeleteUser")
+	}
+
+	return m.DeleteUserFunc(ctx, organizationID, userID)
+}
+
+// SuspendUser records the call and calls SuspendUserFunc
+func (m *OrganizationService) SuspendUser(ctx context.Context, organizationID, userID string) error {
+	m.record("SuspendUser", ctx, organizationID, userID)
+	if m.SuspendUserFunc == nil {
+		return notMocked("OrganizationService", "SuspendUser")
+	}
+
+	return m.SuspendUserFunc(ctx, organizationID, userID)
+}
+
+// UnsuspendUser records the call and calls UnsuspendUserFunc
+func (m *OrganizationService) UnsuspendUser(ctx context.Context, organizationID, userID string) error {
+	m.record("UnsuspendUser", ctx, organizationID, userID)
+	if m.UnsuspendUserFunc == nil {
+		return notMocked("OrganizationService", "UnsuspendUser")
+	}
+
+	return m.UnsuspendUserFunc(ctx, organizationID, userID)
+}
+
+// ListSuspendedUsers records the call and calls ListSuspendedUsersFunc
+func (m *OrganizationService) ListSuspendedUsers(ctx context.Context, organizationIDstring string, options *govultr.ListOptions) ([]govultr.OrganizationUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListSuspendedUsers", ctx, organizationIDstring, options)
+	if m.ListSuspendedUsersFunc == nil {
+		var r0 []govultr.OrganizationUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListSuspendedUsers")
+	}
+
+	return m.ListSuspendedUsersFunc(ctx, organizationIDstring, options)
+}
+
+// CreateInvitation records the call and calls CreateInvitationFunc
+func (m *OrganizationService) CreateInvitation(ctx context.Context, invitationReq *govultr.OrganizationInvitationReq) (*govultr.OrganizationInvitation, *http.Response, error) {
+	m.record("CreateInvitation", ctx, invitationReq)
+	if m.CreateInvitationFunc == nil {
+		var r0 *govultr.OrganizationInvitation
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreateInvitation")
+	}
+
+	return m.CreateInvitationFunc(ctx, invitationReq)
+}
+
+// GetInvitation records the call and calls GetInvitationFunc
+func (m *OrganizationService) GetInvitation(ctx context.Context, invitationID string) (*govultr.OrganizationInvitation, *http.Response, error) {
+	m.record("GetInvitation", ctx, invitationID)
+	if m.GetInvitationFunc == nil {
+		var r0 *govultr.OrganizationInvitation
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetInvitation")
+	}
+
+	return m.GetInvitationFunc(ctx, invitationID)
+}
+
+// ListInvitations records the call and calls ListInvitationsFunc
+func (m *OrganizationService) ListInvitations(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationInvitation, *govultr.Meta, *http.Response, error) {
+	m.record("ListInvitations", ctx, options)
+	if m.ListInvitationsFunc == nil {
+		var r0 []govultr.OrganizationInvitation
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListInvitations")
+	}
+
+	return m.ListInvitationsFunc(ctx, options)
+}
+
+// ResendInvitation records the call and calls ResendInvitationFunc
+func (m *OrganizationService) ResendInvitation(ctx context.Context, invitationID string) (*govultr.OrganizationInvitation, *http.Response, error) {
+	m.record("ResendInvitation", ctx, invitationID)
+	if m.ResendInvitationFunc == nil {
+		var r0 *govultr.OrganizationInvitation
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "ResendInvitation")
+	}
+
+	return m.ResendInvitationFunc(ctx, invitationID)
+}
+
+// CreateGroup records the call and calls CreateGroupFunc
+func (m *OrganizationService) CreateGroup(ctx context.Context, groupReq *govultr.OrganizationGroupReq) (*govultr.OrganizationGroup, *http.Response, error) {
+	m.record("CreateGroup", ctx, groupReq)
+	if m.CreateGroupFunc == nil {
+		var r0 *govultr.OrganizationGroup
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreateGroup")
+	}
+
+	return m.CreateGroupFunc(ctx, groupReq)
+}
+
+// GetGroup records the call and calls GetGroupFunc
+func (m *OrganizationService) GetGroup(ctx context.Context, groupID string) (*govultr.OrganizationGroup, *http.Response, error) {
+	m.record("GetGroup", ctx, groupID)
+	if m.GetGroupFunc == nil {
+		var r0 *govultr.OrganizationGroup
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetGroup")
+	}
+
+	return m.GetGroupFunc(ctx, groupID)
+}
+
+// ListGroups records the call and calls ListGroupsFunc
+func (m *OrganizationService) ListGroups(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error) {
+	m.record("ListGroups", ctx, options)
+	if m.ListGroupsFunc == nil {
+		var r0 []govultr.OrganizationGroup
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListGroups")
+	}
+
+	return m.ListGroupsFunc(ctx, options)
+}
+
+// UpdateGroup records the call and calls UpdateGroupFunc
+func (m *OrganizationService) UpdateGroup(ctx context.Context, groupID string, groupReq *govultr.OrganizationGroupReq) (*govultr.OrganizationGroup, *http.Response, error) {
+	m.record("UpdateGroup", ctx, groupID, groupReq)
+	if m.UpdateGroupFunc == nil {
+		var r0 *govultr.OrganizationGroup
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "UpdateGroup")
+	}
+
+	return m.UpdateGroupFunc(ctx, groupID, groupReq)
+}
+
+// DeleteGroup records the call and calls DeleteGroupFunc
+func (m *OrganizationService) DeleteGroup(ctx context.Context, groupID string) error {
+	m.record("DeleteGroup", ctx, groupID)
+	if m.DeleteGroupFunc == nil {
+		return notMocked("OrganizationService", "DeleteGroup")
+	}
+
+	return m.DeleteGroupFunc(ctx, groupID)
+}
+
+// AddGroupMember records the call and calls AddGroupMemberFunc
+func (m *OrganizationService) AddGroupMember(ctx context.Context, groupID string, memberReq *govultr.OrganizationGroupMemberReq) error {
+	m.record("AddGroupMember", ctx, groupID, memberReq)
+	if m.AddGroupMemberFunc == nil {
+		return notMocked("OrganizationService", "AddGroupMember")
+	}
+
+	return m.AddGroupMemberFunc(ctx, groupID, memberReq)
+}
+
+// RemoveGroupMember records the call and calls RemoveGroupMemberFunc
+func (m *OrganizationService) RemoveGroupMember(ctx context.Context, groupID, userID string) error {
+	m.record("RemoveGroupMember", ctx, groupID, userID)
+	if m.RemoveGroupMemberFunc == nil {
+		return notMocked("OrganizationService", "RemoveGroupMember")
+	}
+
+	return m.RemoveGroupMemberFunc(ctx, groupID, userID)
+}
+
+// ListGroupPolicies records the call and calls ListGroupPoliciesFunc
+func (m *OrganizationService) ListGroupPolicies(ctx context.Context, groupID string) (*govultr.OrganizationGroupPolicies, *govultr.Meta, *http.Response, error) {
+	m.record("ListGroupPolicies", ctx, groupID)
+	if m.ListGroupPoliciesFunc == nil {
+		var r0 *govultr.OrganizationGroupPolicies
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListGroupPolicies")
+	}
+
+	return m.ListGroupPoliciesFunc(ctx, groupID)
+}
+
+// ListGroupRoles records the call and calls ListGroupRolesFunc
+func (m *OrganizationService) ListGroupRoles(ctx context.Context, groupID string) (*govultr.OrganizationGroupRoles, *govultr.Meta, *http.Response, error) {
+	m.record("ListGroupRoles", ctx, groupID)
+	if m.ListGroupRolesFunc == nil {
+		var r0 *govultr.OrganizationGroupRoles
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListGroupRoles")
+	}
+
+	return m.ListGroupRolesFunc(ctx, groupID)
+}
+
+// CreateRole records the call and calls CreateRoleFunc
+func (m *OrganizationService) CreateRole(ctx context.Context, roleReq *govultr.OrganizationRoleReq) (*govultr.OrganizationRole, *http.Response, error) {
+	m.record("CreateRole", ctx, roleReq)
+	if m.CreateRoleFunc == nil {
+		var r0 *govultr.OrganizationRole
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreateRole")
+	}
+
+	return m.CreateRoleFunc(ctx, roleReq)
+}
+
+// GetRole records the call and calls GetRoleFunc
+func (m *OrganizationService) GetRole(ctx context.Context, roleID string) (*govultr.OrganizationRole, *http.Response, error) {
+	m.record("GetRole", ctx, roleID)
+	if m.GetRoleFunc == nil {
+		var r0 *govultr.OrganizationRole
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetRole")
+	}
+
+	return m.GetRoleFunc(ctx, roleID)
+}
+
+// ListRoles records the call and calls ListRolesFunc
+func (m *OrganizationService) ListRoles(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationRole, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoles", ctx, options)
+	if m.ListRolesFunc == nil {
+		var r0 []govultr.OrganizationRole
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoles")
+	}
+
+	return m.ListRolesFunc(ctx, options)
+}
+
+// UpdateRole records the call and calls UpdateRoleFunc
+func (m *OrganizationService) UpdateRole(ctx context.Context, roleID string, roleReq *govultr.OrganizationRoleReq) (*govultr.OrganizationRole, *http.Response, error) {
+	m.record("UpdateRole", ctx, roleID, roleReq)
+	if m.UpdateRoleFunc == nil {
+		var r0 *govultr.OrganizationRole
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "UpdateRole")
+	}
+
+	return m.UpdateRoleFunc(ctx, roleID, roleReq)
+}
+
+// DeleteRole records the call and calls DeleteRoleFunc
+func (m *OrganizationService) DeleteRole(ctx context.Context, roleID string) error {
+	m.record("DeleteRole", ctx, roleID)
+	if m.DeleteRoleFunc == nil {
+		return notMocked("OrganizationService", "DeleteRole")
+	}
+
+	return m.DeleteRoleFunc(ctx, roleID)
+}
+
+// RestoreRole records the call and calls RestoreRoleFunc
+func (m *OrganizationService) RestoreRole(ctx context.Context, roleID string) (*govultr.OrganizationRole, *http.Response, error) {
+	m.record("RestoreRole", ctx, roleID)
+	if m.RestoreRoleFunc == nil {
+		var r0 *govultr.OrganizationRole
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "RestoreRole")
+	}
+
+	return m.RestoreRoleFunc(ctx, roleID)
+}
+
+// CreatePolicy records the call and calls CreatePolicyFunc
+func (m *OrganizationService) CreatePolicy(ctx context.Context, policyReq *govultr.OrganizationPolicyReq) (*govultr.OrganizationPolicy, *http.Response, error) {
+	m.record("CreatePolicy", ctx, policyReq)
+	if m.CreatePolicyFunc == nil {
+		var r0 *govultr.OrganizationPolicy
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreatePolicy")
+	}
+
+	return m.CreatePolicyFunc(ctx, policyReq)
+}
+
+// GetPolicy records the call and calls GetPolicyFunc
+func (m *OrganizationService) GetPolicy(ctx context.Context, policyID string) (*govultr.OrganizationPolicy, *http.Response, error) {
+	m.record("GetPolicy", ctx, policyID)
+	if m.GetPolicyFunc == nil {
+		var r0 *govultr.OrganizationPolicy
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetPolicy")
+	}
+
+	return m.GetPolicyFunc(ctx, policyID)
+}
+
+// ListPolicies records the call and calls ListPoliciesFunc
+func (m *OrganizationService) ListPolicies(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationPolicy, *govultr.Meta, *http.Response, error) {
+	m.record("ListPolicies", ctx, options)
+	if m.ListPoliciesFunc == nil {
+		var r0 []govultr.OrganizationPolicy
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListPolicies")
+	}
+
+	return m.ListPoliciesFunc(ctx, options)
+}
+
+// UpdatePolicy records the call and calls UpdatePolicyFunc
+func (m *OrganizationService) UpdatePolicy(ctx context.Context, policyID string, policyReq *govultr.OrganizationPolicyReq) (*govultr.OrganizationPolicy, *http.Response, error) {
+	m.record("UpdatePolicy", ctx, policyID, policyReq)
+	if m.UpdatePolicyFunc == nil {
+		var r0 *govultr.OrganizationPolicy
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "UpdatePolicy")
+	}
+
+	return m.UpdatePolicyFunc(ctx, policyID, policyReq)
+}
+
+// DeletePolicy records the call and calls DeletePolicyFunc
+func (m *OrganizationService) DeletePolicy(ctx context.Context, policyID string) error {
+	m.record("DeletePolicy", ctx, policyID)
+	if m.DeletePolicyFunc == nil {
+		return notMocked("OrganizationService", "DeletePolicy")
+	}
+
+	return m.DeletePolicyFunc(ctx, policyID)
+}
+
+// RestorePolicy records the call and calls RestorePolicyFunc
+func (m *OrganizationService) RestorePolicy(ctx context.Context, policyID string) (*govultr.OrganizationPolicy, *http.Response, error) {
+	m.record("RestorePolicy", ctx, policyID)
+	if m.RestorePolicyFunc == nil {
+		var r0 *govultr.OrganizationPolicy
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "RestorePolicy")
+	}
+
+	return m.RestorePolicyFunc(ctx, policyID)
+}
+
+// ListRolePolicies records the call and calls ListRolePoliciesFunc
+func (m *OrganizationService) ListRolePolicies(ctx context.Context, roleID string, options *govultr.ListOptions) ([]govultr.OrganizationPolicy, *govultr.Meta, *http.Response, error) {
+	m.record("ListRolePolicies", ctx, roleID, options)
+	if m.ListRolePoliciesFunc == nil {
+		var r0 []govultr.OrganizationPolicy
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRolePolicies")
+	}
+
+	return m.ListRolePoliciesFunc(ctx, roleID, options)
+}
+
+// AttachRolePolicy records the call and calls AttachRolePolicyFunc
+func (m *OrganizationService) AttachRolePolicy(ctx context.Context, roleID, policyID string) (*govultr.OrganizationRolePolicyAttachment, *http.Response, error) {
+	m.record("AttachRolePolicy", ctx, roleID, policyID)
+	if m.AttachRolePolicyFunc == nil {
+		var r0 *govultr.OrganizationRolePolicyAttachment
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "AttachRolePolicy")
+	}
+
+	return m.AttachRolePolicyFunc(ctx, roleID, policyID)
+}
+
+// DetachRolePolicy records the call and calls DetachRolePolicyFunc
+func (m *OrganizationService) DetachRolePolicy(ctx context.Context, roleID, policyID string) error {
+	m.record("DetachRolePolicy", ctx, roleID, policyID)
+	if m.DetachRolePolicyFunc == nil {
+		return notMocked("OrganizationService", "DetachRolePolicy")
+	}
+
+	return m.DetachRolePolicyFunc(ctx, roleID, policyID)
+}
+
+// ListRoleUsers records the call and calls ListRoleUsersFunc
+func (m *OrganizationService) ListRoleUsers(ctx context.Context, roleID string, options *govultr.ListOptions) ([]govultr.OrganizationRoleUserAssignment, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoleUsers", ctx, roleID, options)
+	if m.ListRoleUsersFunc == nil {
+		var r0 []govultr.OrganizationRoleUserAssignment
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoleUsers")
+	}
+
+	return m.ListRoleUsersFunc(ctx, roleID, options)
+}
+
+// AttachRoleUser records the call and calls AttachRoleUserFunc
+func (m *OrganizationService) AttachRoleUser(ctx context.Context, roleID, userID string) (*govultr.OrganizationRoleUserAssignment, *http.Response, error) {
+	m.record("AttachRoleUser", ctx, roleID, userID)
+	if m.AttachRoleUserFunc == nil {
+		var r0 *govultr.OrganizationRoleUserAssignment
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "AttachRoleUser")
+	}
+
+	return m.AttachRoleUserFunc(ctx, roleID, userID)
+}
+
+// DetachRoleUser records the call and calls DetachRoleUserFunc
+func (m *OrganizationService) DetachRoleUser(ctx context.Context, roleID, userID string) error {
+	m.record("DetachRoleUser", ctx, roleID, userID)
+	if m.DetachRoleUserFunc == nil {
+		return notMocked("OrganizationService", "DetachRoleUser")
+	}
+
+	return m.DetachRoleUserFunc(ctx, roleID, userID)
+}
+
+// ListRoleGroups records the call and calls ListRoleGroupsFunc
+func (m *OrganizationService) ListRoleGroups(ctx context.Context, roleID string, options *govultr.ListOptions) ([]govultr.OrganizationRoleGroupAssignment, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoleGroups", ctx, roleID, options)
+	if m.ListRoleGroupsFunc == nil {
+		var r0 []govultr.OrganizationRoleGroupAssignment
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoleGroups")
+	}
+
+	return m.ListRoleGroupsFunc(ctx, roleID, options)
+}
+
+// AttachRoleGroup records the call and calls AttachRoleGroupFunc
+func (m *OrganizationService) AttachRoleGroup(ctx context.Context, roleID, groupID string) (*govultr.OrganizationRoleGroupAssignment, *http.Response, error) {
+	m.record("AttachRoleGroup", ctx, roleID, groupID)
+	if m.AttachRoleGroupFunc == nil {
+		var r0 *govultr.OrganizationRoleGroupAssignment
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "AttachRoleGroup")
+	}
+
+	return m.AttachRoleGroupFunc(ctx, roleID, groupID)
+}
+
+// DetachRoleGroup records the call and calls DetachRoleGroupFunc
+func (m *OrganizationService) DetachRoleGroup(ctx context.Context, roleID, groupID string) error {
+	m.record("DetachRoleGroup", ctx, roleID, groupID)
+	if m.DetachRoleGroupFunc == nil {
+		return notMocked("OrganizationService", "DetachRoleGroup")
+	}
+
+	return m.DetachRoleGroupFunc(ctx, roleID, groupID)
+}
+
+// ListPolicyUsers records the call and calls ListPolicyUsersFunc
+func (m *OrganizationService) ListPolicyUsers(ctx context.Context, policyID string, options *govultr.ListOptions) ([]govultr.OrganizationUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListPolicyUsers", ctx, policyID, options)
+	if m.ListPolicyUsersFunc == nil {
+		var r0 []govultr.OrganizationUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListPolicyUsers")
+	}
+
+	return m.ListPolicyUsersFunc(ctx, policyID, options)
+}
+
+// AttachPolicyUser records the call and calls AttachPolicyUserFunc
+func (m *OrganizationService) AttachPolicyUser(ctx context.Context, policyID, userID string) error {
+	m.record("AttachPolicyUser", ctx, policyID, userID)
+	if m.AttachPolicyUserFunc == nil {
+		return notMocked("OrganizationService", "AttachPolicyUser")
+	}
+
+	return m.AttachPolicyUserFunc(ctx, policyID, userID)
+}
+
+// DetachPolicyUser records the call and calls DetachPolicyUserFunc
+func (m *OrganizationService) DetachPolicyUser(ctx context.Context, policyID, userID string) error {
+	m.record("DetachPolicyUser", ctx, policyID, userID)
+	if m.DetachPolicyUserFunc == nil {
+		return notMocked("OrganizationService", "DetachPolicyUser")
+	}
+
+	return m.DetachPolicyUserFunc(ctx, policyID, userID)
+}
+
+// ListPolicyGroups records the call and calls ListPolicyGroupsFunc
+func (m *OrganizationService) ListPolicyGroups(ctx context.Context, policyID string, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error) {
+	m.record("ListPolicyGroups", ctx, policyID, options)
+	if m.ListPolicyGroupsFunc == nil {
+		var r0 []govultr.OrganizationGroup
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListPolicyGroups")
+	}
+
+	return m.ListPolicyGroupsFunc(ctx, policyID, options)
+}
+
+// AttachPolicyGroup records the call and calls AttachPolicyGroupFunc
+func (m *OrganizationService) AttachPolicyGroup(ctx context.Context, policyID, groupID string) error {
+	m.record("AttachPolicyGroup", ctx, policyID, groupID)
+	if m.AttachPolicyGroupFunc == nil {
+		return notMocked("OrganizationService", "AttachPolicyGroup")
+	}
+
+	return m.AttachPolicyGroupFunc(ctx, policyID, groupID)
+}
+
+// DetachPolicyGroup records the call and calls DetachPolicyGroupFunc
+func (m *OrganizationService) DetachPolicyGroup(ctx context.Context, policyID, groupID string) error {
+	m.record("DetachPolicyGroup", ctx, policyID, groupID)
+	if m.DetachPolicyGroupFunc == nil {
+		return notMocked("OrganizationService", "DetachPolicyGroup")
+	}
+
+	return m.DetachPolicyGroupFunc(ctx, policyID, groupID)
+}
+
+// CreateRoleTrust records the call and calls CreateRoleTrustFunc
+func (m *OrganizationService) CreateRoleTrust(ctx context.Context, roleTrustReq *govultr.OrganizationRoleTrustCreateReq) (*govultr.OrganizationRoleTrust, *http.Response, error) {
+	m.record("CreateRoleTrust", ctx, roleTrustReq)
+	if m.CreateRoleTrustFunc == nil {
+		var r0 *govultr.OrganizationRoleTrust
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreateRoleTrust")
+	}
+
+	return m.CreateRoleTrustFunc(ctx, roleTrustReq)
+}
+
+// GetRoleTrust records the call and calls GetRoleTrustFunc
+func (m *OrganizationService) GetRoleTrust(ctx context.Context, roleTrustID string) (*govultr.OrganizationRoleTrust, *http.Response, error) {
+	m.record("GetRoleTrust", ctx, roleTrustID)
+	if m.GetRoleTrustFunc == nil {
+		var r0 *govultr.OrganizationRoleTrust
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetRoleTrust")
+	}
+
+	return m.GetRoleTrustFunc(ctx, roleTrustID)
+}
+
+// ListRoleTrusts records the call and calls ListRoleTrustsFunc
+func (m *OrganizationService) ListRoleTrusts(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationRoleTrust, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoleTrusts", ctx, options)
+	if m.ListRoleTrustsFunc == nil {
+		var r0 []govultr.OrganizationRoleTrust
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoleTrusts")
+	}
+
+	return m.ListRoleTrustsFunc(ctx, options)
+}
+
+// UpdateRoleTrust records the call and calls UpdateRoleTrustFunc
+func (m *OrganizationService) UpdateRoleTrust(ctx context.Context, roleTrustID string, roleTrustReq *govultr.OrganizationRoleTrustUpdateReq) (*govultr.OrganizationRoleTrust, *http.Response, error) {
+	m.record("UpdateRoleTrust", ctx, roleTrustID, roleTrustReq)
+	if m.UpdateRoleTrustFunc == nil {
+		var r0 *govultr.OrganizationRoleTrust
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "UpdateRoleTrust")
+	}
+
+	return m.UpdateRoleTrustFunc(ctx, roleTrustID, roleTrustReq)
+}
+
+// DeleteRoleTrust records the call and calls DeleteRoleTrustFunc
+func (m *OrganizationService) DeleteRoleTrust(ctx context.Context, roleTrustID string) error {
+	m.record("DeleteRoleTrust", ctx, roleTrustID)
+	if m.DeleteRoleTrustFunc == nil {
+		return notMocked("OrganizationService", "DeleteRoleTrust")
+	}
+
+	return m.DeleteRoleTrustFunc(ctx, roleTrustID)
+}
+
+// RestoreRoleTrust records the call and calls RestoreRoleTrustFunc
+func (m *OrganizationService) RestoreRoleTrust(ctx context.Context, roleTrustID string) (*govultr.OrganizationRoleTrust, *http.Response, error) {
+	m.record("RestoreRoleTrust", ctx, roleTrustID)
+	if m.RestoreRoleTrustFunc == nil {
+		var r0 *govultr.OrganizationRoleTrust
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "RestoreRoleTrust")
+	}
+
+	return m.RestoreRoleTrustFunc(ctx, roleTrustID)
+}
+
+// ListRoleTrustsByRole records the call and calls ListRoleTrustsByRoleFunc
+func (m *OrganizationService) ListRoleTrustsByRole(ctx context.Context, roleID string) ([]govultr.OrganizationRoleTrust, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoleTrustsByRole", ctx, roleID)
+	if m.ListRoleTrustsByRoleFunc == nil {
+		var r0 []govultr.OrganizationRoleTrust
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoleTrustsByRole")
+	}
+
+	return m.ListRoleTrustsByRoleFunc(ctx, roleID)
+}
+
+// ListRoleTrustsByUser records the call and calls ListRoleTrustsByUserFunc
+func (m *OrganizationService) ListRoleTrustsByUser(ctx context.Context, userID string) ([]govultr.OrganizationRoleTrust, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoleTrustsByUser", ctx, userID)
+	if m.ListRoleTrustsByUserFunc == nil {
+		var r0 []govultr.OrganizationRoleTrust
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoleTrustsByUser")
+	}
+
+	return m.ListRoleTrustsByUserFunc(ctx, userID)
+}
+
+// CreateRoleSession records the call and calls CreateRoleSessionFunc
+func (m *OrganizationService) CreateRoleSession(ctx context.Context, roleSessionReq *govultr.OrganizationRoleSessionReq) (*govultr.OrganizationRoleSession, *http.Response, error) {
+	m.record("CreateRoleSession", ctx, roleSessionReq)
+	if m.CreateRoleSessionFunc == nil {
+		var r0 *govultr.OrganizationRoleSession
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "CreateRoleSession")
+	}
+
+	return m.CreateRoleSessionFunc(ctx, roleSessionReq)
+}
+
+// GetRoleSession records the call and calls GetRoleSessionFunc
+func (m *OrganizationService) GetRoleSession(ctx context.Context, token string) (*govultr.OrganizationRoleSession, *http.Response, error) {
+	m.record("GetRoleSession", ctx, token)
+	if m.GetRoleSessionFunc == nil {
+		var r0 *govultr.OrganizationRoleSession
+		var r1 *http.Response
+		return r0, r1, notMocked("OrganizationService", "GetRoleSession")
+	}
+
+	return m.GetRoleSessionFunc(ctx, token)
+}
+
+// ListRoleSessions records the call and calls ListRoleSessionsFunc
+func (m *OrganizationService) ListRoleSessions(ctx context.Context, userID string) ([]govultr.OrganizationRoleSession, *govultr.Meta, *http.Response, error) {
+	m.record("ListRoleSessions", ctx, userID)
+	if m.ListRoleSessionsFunc == nil {
+		var r0 []govultr.OrganizationRoleSession
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListRoleSessions")
+	}
+
+	return m.ListRoleSessionsFunc(ctx, userID)
+}
+
+// RevokeRoleSession records the call and calls RevokeRoleSessionFunc
+func (m *OrganizationService) RevokeRoleSession(ctx context.Context, token string) error {
+	m.record("RevokeRoleSession", ctx, token)
+	if m.RevokeRoleSessionFunc == nil {
+		return notMocked("OrganizationService", "RevokeRoleSession")
+	}
+
+	return m.RevokeRoleSessionFunc(ctx, token)
+}
+
+// ListUserGroups records the call and calls ListUserGroupsFunc
+func (m *OrganizationService) ListUserGroups(ctx context.Context, userID string, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error) {
+	m.record("ListUserGroups", ctx, userID, options)
+	if m.ListUserGroupsFunc == nil {
+		var r0 []govultr.OrganizationGroup
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListUserGroups")
+	}
+
+	return m.ListUserGroupsFunc(ctx, userID, options)
+}
+
+// ListUserRoles records the call and calls ListUserRolesFunc
+func (m *OrganizationService) ListUserRoles(ctx context.Context, userID string, options *govultr.ListOptions) (*govultr.OrganizationRolesForUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListUserRoles", ctx, userID, options)
+	if m.ListUserRolesFunc == nil {
+		var r0 *govultr.OrganizationRolesForUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListUserRoles")
+	}
+
+	return m.ListUserRolesFunc(ctx, userID, options)
+}
+
+// ListUserPolicies records the call and calls ListUserPoliciesFunc
+func (m *OrganizationService) ListUserPolicies(ctx context.Context, userID string, options *govultr.ListOptions) (*govultr.OrganizationPoliciesForUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListUserPolicies", ctx, userID, options)
+	if m.ListUserPoliciesFunc == nil {
+		var r0 *govultr.OrganizationPoliciesForUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListUserPolicies")
+	}
+
+	return m.ListUserPoliciesFunc(ctx, userID, options)
+}
+
+// ListCurrentUserGroups records the call and calls ListCurrentUserGroupsFunc
+func (m *OrganizationService) ListCurrentUserGroups(ctx context.Context, options *govultr.ListOptions) ([]govultr.OrganizationGroup, *govultr.Meta, *http.Response, error) {
+	m.record("ListCurrentUserGroups", ctx, options)
+	if m.ListCurrentUserGroupsFunc == nil {
+		var r0 []govultr.OrganizationGroup
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListCurrentUserGroups")
+	}
+
+	return m.ListCurrentUserGroupsFunc(ctx, options)
+}
+
+// ListCurrentUserRoles records the call and calls ListCurrentUserRolesFunc
+func (m *OrganizationService) ListCurrentUserRoles(ctx context.Context, options *govultr.ListOptions) (*govultr.OrganizationRolesForUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListCurrentUserRoles", ctx, options)
+	if m.ListCurrentUserRolesFunc == nil {
+		var r0 *govultr.OrganizationRolesForUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListCurrentUserRoles")
+	}
+
+	return m.ListCurrentUserRolesFunc(ctx, options)
+}
+
+// ListCurrentUserPolicies records the call and calls ListCurrentUserPoliciesFunc
+func (m *OrganizationService) ListCurrentUserPolicies(ctx context.Context, options *govultr.ListOptions) (*govultr.OrganizationPoliciesForUser, *govultr.Meta, *http.Response, error) {
+	m.record("ListCurrentUserPolicies", ctx, options)
+	if m.ListCurrentUserPoliciesFunc == nil {
+		var r0 *govultr.OrganizationPoliciesForUser
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OrganizationService", "ListCurrentUserPolicies")
+	}
+
+	return m.ListCurrentUserPoliciesFunc(ctx, options)
+}
+
+// OSService is a mock of govultr.OSService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type OSService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OS, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.OSService = (*OSService)(nil)
+
+// List records the call and calls ListFunc
+func (m *OSService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.OS, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.OS
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("OSService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// PlanService is a mock of govultr.PlanService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type PlanService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error)
+
+	// ListBareMetalFunc is called by ListBareMetal
+	ListBareMetalFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.PlanService = (*PlanService)(nil)
+
+// List records the call and calls ListFunc
+func (m *PlanService) List(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, planType, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Plan
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("PlanService", "List")
+	}
+
+	return m.ListFunc(ctx, planType, options)
+}
+
+// ListBareMetal records the call and calls ListBareMetalFunc
+func (m *PlanService) ListBareMetal(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *http.Response, error) {
+	m.record("ListBareMetal", ctx, options)
+	if m.ListBareMetalFunc == nil {
+		var r0 []govultr.BareMetalPlan
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("PlanService", "ListBareMetal")
+	}
+
+	return m.ListBareMetalFunc(ctx, options)
+}
+
+// RegionService is a mock of govultr.RegionService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type RegionService struct {
+	calls
+
+	// AvailabilityFunc is called by Availability
+	AvailabilityFunc func(ctx context.Context, regionID string, planType string) (*govultr.PlanAvailability, *http.Response, error)
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Region, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.RegionService = (*RegionService)(nil)
+
+// Availability records the call and calls AvailabilityFunc
+func (m *RegionService) Availability(ctx context.Context, regionID string, planType string) (*govultr.PlanAvailability, *http.Response, error) {
+	m.record("Availability", ctx, regionID, planType)
+	if m.AvailabilityFunc == nil {
+		var r0 *govultr.PlanAvailability
+		var r1 *http.Response
+		return r0, r1, notMocked("RegionService", "Availability")
+	}
+
+	return m.AvailabilityFunc(ctx, regionID, planType)
+}
+
+// List records the call and calls ListFunc
+func (m *RegionService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Region, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Region
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("RegionService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// ReservedIPService is a mock of govultr.ReservedIPService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type ReservedIPService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, ripCreate *govultr.ReservedIPReq) (*govultr.ReservedIP, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, id string, ripUpdate *govultr.ReservedIPUpdateReq) (*govultr.ReservedIP, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, id string) (*govultr.ReservedIP, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, id string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ReservedIP, *govultr.Meta, *http.Response, error)
+
+	// ConvertFunc is called by Convert
+	ConvertFunc func(ctx context.Context, ripConvert *govultr.ReservedIPConvertReq) (*govultr.ReservedIP, *http.Response, error)
+
+	// AttachFunc is called by Attach
+	AttachFunc func(ctx context.Context, id, instance string) error
+
+	// DetachFunc is called by Detach
+	DetachFunc func(ctx context.Context, id string) error
+}
+
+var _ govultr.ReservedIPService = (*ReservedIPService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *ReservedIPService) Create(ctx context.Context, ripCreate *govultr.ReservedIPReq) (*govultr.ReservedIP, *http.Response, error) {
+	m.record("Create", ctx, ripCreate)
+	if m.CreateFunc == nil {
+		var r0 *govultr.ReservedIP
+		var r1 *http.Response
+		return r0, r1, notMocked("ReservedIPService", "Create")
+	}
+
+	return m.CreateFunc(ctx, ripCreate)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *ReservedIPService) Update(ctx context.Context, id string, ripUpdate *govultr.ReservedIPUpdateReq) (*govultr.ReservedIP, *http.Response, error) {
+	m.record("Update", ctx, id, ripUpdate)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.ReservedIP
+		var r1 *http.Response
+		return r0, r1, notMocked("ReservedIPService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, id, ripUpdate)
+}
+
+// Get records the call and calls GetFunc
+func (m *ReservedIPService) Get(ctx context.Context, id string) (*govultr.ReservedIP, *http.Response, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc == nil {
+		var r0 *govultr.ReservedIP
+		var r1 *http.Response
+		return r0, r1, notMocked("ReservedIPService", "Get")
+	}
+
+	return m.GetFunc(ctx, id)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *ReservedIPService) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc == nil {
+		return notMocked("ReservedIPService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, id)
+}
+
+// List records the call and calls ListFunc
+func (m *ReservedIPService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ReservedIP, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.ReservedIP
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("ReservedIPService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Convert records the call and calls ConvertFunc
+func (m *ReservedIPService) Convert(ctx context.Context, ripConvert *govultr.ReservedIPConvertReq) (*govultr.ReservedIP, *http.Response, error) {
+	m.record("Convert", ctx, ripConvert)
+	if m.ConvertFunc == nil {
+		var r0 *govultr.ReservedIP
+		var r1 *http.Response
+		return r0, r1, notMocked("ReservedIPService", "Convert")
+	}
+
+	return m.ConvertFunc(ctx, ripConvert)
+}
+
+// Attach records the call and calls AttachFunc
+func (m *ReservedIPService) Attach(ctx context.Context, id, instance string) error {
+	m.record("Attach", ctx, id, instance)
+	if m.AttachFunc == nil {
+		return notMocked("ReservedIPService", "Attach")
+	}
+
+	return m.AttachFunc(ctx, id, instance)
+}
+
+// Detach records the call and calls DetachFunc
+func (m *ReservedIPService) Detach(ctx context.Context, id string) error {
+	m.record("Detach", ctx, id)
+	if m.DetachFunc == nil {
+		return notMocked("ReservedIPService", "Detach")
+	}
+
+	return m.DetachFunc(ctx, id)
+}
+
+// InferenceService is a mock of govultr.InferenceService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type InferenceService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context) ([]govultr.Inference, *http.Response, error)
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, inferenceReq *govultr.InferenceCreateUpdateReq) (*govultr.Inference, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, inferenceID string) (*govultr.Inference, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, inferenceID string, inferenceReq *govultr.InferenceCreateUpdateReq) (*govultr.Inference, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, inferenceID string) error
+
+	// GetUsageFunc is called by GetUsage
+	GetUsageFunc func(ctx context.Context, inferenceID string) (*govultr.InferenceUsage, *http.Response, error)
+}
+
+var _ govultr.InferenceService = (*InferenceService)(nil)
+
+// List records the call and calls ListFunc
+func (m *InferenceService) List(ctx context.Context) ([]govultr.Inference, *http.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		var r0 []govultr.Inference
+		var r1 *http.Response
+		return r0, r1, notMocked("InferenceService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+// Create records the call and calls CreateFunc
+func (m *InferenceService) Create(ctx context.Context, inferenceReq *govultr.InferenceCreateUpdateReq) (*govultr.Inference, *http.Response, error) {
+	m.record("Create", ctx, inferenceReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.Inference
+		var r1 *http.Response
+		return r0, r1, notMocked("InferenceService", "Create")
+	}
+
+	return m.CreateFunc(ctx, inferenceReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *InferenceService) Get(ctx context.Context, inferenceID string) (*govultr.Inference, *http.Response, error) {
+	m.record("Get", ctx, inferenceID)
+	if m.GetFunc == nil {
+		var r0 *govultr.Inference
+		var r1 *http.Response
+		return r0, r1, notMocked("InferenceService", "Get")
+	}
+
+	return m.GetFunc(ctx, inferenceID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *InferenceService) Update(ctx context.Context, inferenceID string, inferenceReq *govultr.InferenceCreateUpdateReq) (*govultr.Inference, *http.Response, error) {
+	m.record("Update", ctx, inferenceID, inferenceReq)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.Inference
+		var r1 *http.Response
+		return r0, r1, notMocked("InferenceService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, inferenceID, inferenceReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *InferenceService) Delete(ctx context.Context, inferenceID string) error {
+	m.record("Delete", ctx, inferenceID)
+	if m.DeleteFunc == nil {
+		return notMocked("InferenceService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, inferenceID)
+}
+
+// GetUsage records the call and calls GetUsageFunc
+func (m *InferenceService) GetUsage(ctx context.Context, inferenceID string) (*govultr.InferenceUsage, *http.Response, error) {
+	m.record("GetUsage", ctx, inferenceID)
+	if m.GetUsageFunc == nil {
+		var r0 *govultr.InferenceUsage
+		var r1 *http.Response
+		return r0, r1, notMocked("InferenceService", "GetUsage")
+	}
+
+	return m.GetUsageFunc(ctx, inferenceID)
+}
+
+// SnapshotService is a mock of govultr.SnapshotService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type SnapshotService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Snapshot, *govultr.Meta, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, snapshotID string) (*govultr.Snapshot, *http.Response, error)
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, snapshotReq *govultr.SnapshotReq) (*govultr.Snapshot, *http.Response, error)
+
+	// CreateFromURLFunc is called by CreateFromURL
+	CreateFromURLFunc func(ctx context.Context, snapshotURLReq *govultr.SnapshotURLReq) (*govultr.Snapshot, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, snapshotID string, snapshotReq *govultr.SnapshotUpdateReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, snapshotID string) error
+}
+
+var _ govultr.SnapshotService = (*SnapshotService)(nil)
+
+// List records the call and calls ListFunc
+func (m *SnapshotService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Snapshot, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.Snapshot
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("SnapshotService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Get records the call and calls GetFunc
+func (m *SnapshotService) Get(ctx context.Context, snapshotID string) (*govultr.Snapshot, *http.Response, error) {
+	m.record("Get", ctx, snapshotID)
+	if m.GetFunc == nil {
+		var r0 *govultr.Snapshot
+		var r1 *http.Response
+		return r0, r1, notMocked("SnapshotService", "Get")
+	}
+
+	return m.GetFunc(ctx, snapshotID)
+}
+
+// Create records the call and calls CreateFunc
+func (m *SnapshotService) Create(ctx context.Context, snapshotReq *govultr.SnapshotReq) (*govultr.Snapshot, *http.Response, error) {
+	m.record("Create", ctx, snapshotReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.Snapshot
+		var r1 *http.Response
+		return r0, r1, notMocked("SnapshotService", "Create")
+	}
+
+	return m.CreateFunc(ctx, snapshotReq)
+}
+
+// CreateFromURL records the call and calls CreateFromURLFunc
+func (m *SnapshotService) CreateFromURL(ctx context.Context, snapshotURLReq *govultr.SnapshotURLReq) (*govultr.Snapshot, *http.Response, error) {
+	m.record("CreateFromURL", ctx, snapshotURLReq)
+	if m.CreateFromURLFunc == nil {
+		var r0 *govultr.Snapshot
+		var r1 *http.Response
+		return r0, r1, notMocked("SnapshotService", "CreateFromURL")
+	}
+
+	return m.CreateFromURLFunc(ctx, snapshotURLReq)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *SnapshotService) Update(ctx context.Context, snapshotID string, snapshotReq *govultr.SnapshotUpdateReq) error {
+	m.record("Update", ctx, snapshotID, snapshotReq)
+	if m.UpdateFunc == nil {
+		return notMocked("SnapshotService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, snapshotID, snapshotReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *SnapshotService) Delete(ctx context.Context, snapshotID string) error {
+	m.record("Delete", ctx, snapshotID)
+	if m.DeleteFunc == nil {
+		return notMocked("SnapshotService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, snapshotID)
+}
+
+// SSHKeyService is a mock of govultr.SSHKeyService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type SSHKeyService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, sshKeyReq *govultr.SSHKeyReq) (*govultr.SSHKey, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, sshKeyID string) (*govultr.SSHKey, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, sshKeyID string, sshKeyReq *govultr.SSHKeyReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, sshKeyID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.SSHKey, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.SSHKeyService = (*SSHKeyService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *SSHKeyService) Create(ctx context.Context, sshKeyReq *govultr.SSHKeyReq) (*govultr.SSHKey, *http.Response, error) {
+	m.record("Create", ctx, sshKeyReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.SSHKey
+		var r1 *http.Response
+		return r0, r1, notMocked("SSHKeyService", "Create")
+	}
+
+	return m.CreateFunc(ctx, sshKeyReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *SSHKeyService) Get(ctx context.Context, sshKeyID string) (*govultr.SSHKey, *http.Response, error) {
+	m.record("Get", ctx, sshKeyID)
+	if m.GetFunc == nil {
+		var r0 *govultr.SSHKey
+		var r1 *http.Response
+		return r0, r1, notMocked("SSHKeyService", "Get")
+	}
+
+	return m.GetFunc(ctx, sshKeyID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *SSHKeyService) Update(ctx context.Context, sshKeyID string, sshKeyReq *govultr.SSHKeyReq) error {
+	m.record("Update", ctx, sshKeyID, sshKeyReq)
+	if m.UpdateFunc == nil {
+		return notMocked("SSHKeyService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, sshKeyID, sshKeyReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *SSHKeyService) Delete(ctx context.Context, sshKeyID string) error {
+	m.record("Delete", ctx, sshKeyID)
+	if m.DeleteFunc == nil {
+		return notMocked("SSHKeyService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, sshKeyID)
+}
+
+// List records the call and calls ListFunc
+func (m *SSHKeyService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.SSHKey, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.SSHKey
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("SSHKeyService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// StartupScriptService is a mock of govultr.StartupScriptService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type StartupScriptService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, req *govultr.StartupScriptReq) (*govultr.StartupScript, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, scriptID string) (*govultr.StartupScript, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, scriptID string, scriptReq *govultr.StartupScriptReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, scriptID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.StartupScript, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.StartupScriptService = (*StartupScriptService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *StartupScriptService) Create(ctx context.Context, req *govultr.StartupScriptReq) (*govultr.StartupScript, *http.Response, error) {
+	m.record("Create", ctx, req)
+	if m.CreateFunc == nil {
+		var r0 *govultr.StartupScript
+		var r1 *http.Response
+		return r0, r1, notMocked("StartupScriptService", "Create")
+	}
+
+	return m.CreateFunc(ctx, req)
+}
+
+// Get records the call and calls GetFunc
+func (m *StartupScriptService) Get(ctx context.Context, scriptID string) (*govultr.StartupScript, *http.Response, error) {
+	m.record("Get", ctx, scriptID)
+	if m.GetFunc == nil {
+		var r0 *govultr.StartupScript
+		var r1 *http.Response
+		return r0, r1, notMocked("StartupScriptService", "Get")
+	}
+
+	return m.GetFunc(ctx, scriptID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *StartupScriptService) Update(ctx context.Context, scriptID string, scriptReq *govultr.StartupScriptReq) error {
+	m.record("Update", ctx, scriptID, scriptReq)
+	if m.UpdateFunc == nil {
+		return notMocked("StartupScriptService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, scriptID, scriptReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *StartupScriptService) Delete(ctx context.Context, scriptID string) error {
+	m.record("Delete", ctx, scriptID)
+	if m.DeleteFunc == nil {
+		return notMocked("StartupScriptService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, scriptID)
+}
+
+// List records the call and calls ListFunc
+func (m *StartupScriptService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.StartupScript, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.StartupScript
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("StartupScriptService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// SubAccountService is a mock of govultr.SubAccountService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type SubAccountService struct {
+	calls
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.SubAccount, *govultr.Meta, *http.Response, error)
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, saReq *govultr.SubAccountReq) (*govultr.SubAccount, *http.Response, error)
+}
+
+var _ govultr.SubAccountService = (*SubAccountService)(nil)
+
+// List records the call and calls ListFunc
+func (m *SubAccountService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.SubAccount, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.SubAccount
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("SubAccountService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// Create records the call and calls CreateFunc
+func (m *SubAccountService) Create(ctx context.Context, saReq *govultr.SubAccountReq) (*govultr.SubAccount, *http.Response, error) {
+	m.record("Create", ctx, saReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.SubAccount
+		var r1 *http.Response
+		return r0, r1, notMocked("SubAccountService", "Create")
+	}
+
+	return m.CreateFunc(ctx, saReq)
+}
+
+// UserService is a mock of govultr.UserService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type UserService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, userCreate *govultr.UserReq) (*govultr.User, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, userID string) (*govultr.User, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, userID string, userReq *govultr.UserReq) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, userID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.User, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.UserService = (*UserService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *UserService) Create(ctx context.Context, userCreate *govultr.UserReq) (*govultr.User, *http.Response, error) {
+	m.record("Create", ctx, userCreate)
+	if m.CreateFunc == nil {
+		var r0 *govultr.User
+		var r1 *http.Response
+		return r0, r1, notMocked("UserService", "Create")
+	}
+
+	return m.CreateFunc(ctx, userCreate)
+}
+
+// Get records the call and calls GetFunc
+func (m *UserService) Get(ctx context.Context, userID string) (*govultr.User, *http.Response, error) {
+	m.record("Get", ctx, userID)
+	if m.GetFunc == nil {
+		var r0 *govultr.User
+		var r1 *http.Response
+		return r0, r1, notMocked("UserService", "Get")
+	}
+
+	return m.GetFunc(ctx, userID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *UserService) Update(ctx context.Context, userID string, userReq *govultr.UserReq) error {
+	m.record("Update", ctx, userID, userReq)
+	if m.UpdateFunc == nil {
+		return notMocked("UserService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, userID, userReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *UserService) Delete(ctx context.Context, userID string) error {
+	m.record("Delete", ctx, userID)
+	if m.DeleteFunc == nil {
+		return notMocked("UserService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, userID)
+}
+
+// List records the call and calls ListFunc
+func (m *UserService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.User, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.User
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("UserService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// VirtualFileSystemStorageService is a mock of govultr.VirtualFileSystemStorageService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type VirtualFileSystemStorageService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, vfsReq *govultr.VirtualFileSystemStorageReq) (*govultr.VirtualFileSystemStorage, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, vfsID string) (*govultr.VirtualFileSystemStorage, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, vfsID string, vfsUpdateReq *govultr.VirtualFileSystemStorageUpdateReq) (*govultr.VirtualFileSystemStorage, *http.Response, error)
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, vfsID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.VirtualFileSystemStorage, *govultr.Meta, *http.Response, error)
+
+	// AttachmentListFunc is called by AttachmentList
+	AttachmentListFunc func(ctx context.Context, vfsID string) ([]govultr.VirtualFileSystemStorageAttachment, *http.Response, error)
+
+	// AttachmentGetFunc is called by AttachmentGet
+	AttachmentGetFunc func(ctx context.Context, vfsID, targetID string) (*govultr.VirtualFileSystemStorageAttachment, *http.Response, error)
+
+	// AttachFunc is called by Attach
+	AttachFunc func(ctx context.Context, vfsID, targetID string) (*govultr.VirtualFileSystemStorageAttachment, *http.Response, error)
+
+	// DetachFunc is called by Detach
+	DetachFunc func(ctx context.Context, vfsID, targetID string) error
+}
+
+var _ govultr.VirtualFileSystemStorageService = (*VirtualFileSystemStorageService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *VirtualFileSystemStorageService) Create(ctx context.Context, vfsReq *govultr.VirtualFileSystemStorageReq) (*govultr.VirtualFileSystemStorage, *http.Response, error) {
+	m.record("Create", ctx, vfsReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.VirtualFileSystemStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("VirtualFileSystemStorageService", "Create")
+	}
+
+	return m.CreateFunc(ctx, vfsReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *VirtualFileSystemStorageService) Get(ctx context.Context, vfsID string) (*govultr.VirtualFileSystemStorage, *http.Response, error) {
+	m.record("Get", ctx, vfsID)
+	if m.GetFunc == nil {
+		var r0 *govultr.VirtualFileSystemStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("VirtualFileSystemStorageService", "Get")
+	}
+
+	return m.GetFunc(ctx, vfsID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *VirtualFileSystemStorageService) Update(ctx context.Context, vfsID string, vfsUpdateReq *govultr.VirtualFileSystemStorageUpdateReq) (*govultr.VirtualFileSystemStorage, *http.Response, error) {
+	m.record("Update", ctx, vfsID, vfsUpdateReq)
+	if m.UpdateFunc == nil {
+		var r0 *govultr.VirtualFileSystemStorage
+		var r1 *http.Response
+		return r0, r1, notMocked("VirtualFileSystemStorageService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, vfsID, vfsUpdateReq)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *VirtualFileSystemStorageService) Delete(ctx context.Context, vfsID string) error {
+	m.record("Delete", ctx, vfsID)
+	if m.DeleteFunc == nil {
+		return notMocked("VirtualFileSystemStorageService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, vfsID)
+}
+
+// List records the call and calls ListFunc
+func (m *VirtualFileSystemStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.VirtualFileSystemStorage, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.VirtualFileSystemStorage
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("VirtualFileSystemStorageService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// AttachmentList records the call and calls AttachmentListFunc
+func (m *VirtualFileSystemStorageService) AttachmentList(ctx context.Context, vfsID string) ([]govultr.VirtualFileSystemStorageAttachment, *http.Response, error) {
+	m.record("AttachmentList", ctx, vfsID)
+	if m.AttachmentListFunc == nil {
+		var r0 []govultr.VirtualFileSystemStorageAttachment
+		var r1 *http.Response
+		return r0, r1, notMocked("VirtualFileSystemStorageService", "AttachmentList")
+	}
+
+	return m.AttachmentListFunc(ctx, vfsID)
+}
+
+// AttachmentGet records the call and calls AttachmentGetFunc
+func (m *VirtualFileSystemStorageService) AttachmentGet(ctx context.Context, vfsID, targetID string) (*govultr.VirtualFileSystemStorageAttachment, *http.Response, error) {
+	m.record("AttachmentGet", ctx, vfsID, targetID)
+	if m.AttachmentGetFunc == nil {
+		var r0 *govultr.VirtualFileSystemStorageAttachment
+		var r1 *http.Response
+		return r0, r1, notMocked("VirtualFileSystemStorageService", "AttachmentGet")
+	}
+
+	return m.AttachmentGetFunc(ctx, vfsID, targetID)
+}
+
+// Attach records the call and calls AttachFunc
+func (m *VirtualFileSystemStorageService) Attach(ctx context.Context, vfsID, targetID string) (*govultr.VirtualFileSystemStorageAttachment, *http.Response, error) {
+	m.record("Attach", ctx, vfsID, targetID)
+	if m.AttachFunc == nil {
+		var r0 *govultr.VirtualFileSystemStorageAttachment
+		var r1 *http.Response
+		return r0, r1, notMocked("VirtualFileSystemStorageService", "Attach")
+	}
+
+	return m.AttachFunc(ctx, vfsID, targetID)
+}
+
+// Detach records the call and calls DetachFunc
+func (m *VirtualFileSystemStorageService) Detach(ctx context.Context, vfsID, targetID string) error {
+	m.record("Detach", ctx, vfsID, targetID)
+	if m.DetachFunc == nil {
+		return notMocked("VirtualFileSystemStorageService", "Detach")
+	}
+
+	return m.DetachFunc(ctx, vfsID, targetID)
+}
+
+// VPCService is a mock of govultr.VPCService. Each method calls the function in
+// its Func field, and returns ErrNotMocked when that is nil.
+type VPCService struct {
+	calls
+
+	// CreateFunc is called by Create
+	CreateFunc func(ctx context.Context, createReq *govultr.VPCReq) (*govultr.VPC, *http.Response, error)
+
+	// GetFunc is called by Get
+	GetFunc func(ctx context.Context, vpcID string) (*govultr.VPC, *http.Response, error)
+
+	// UpdateFunc is called by Update
+	UpdateFunc func(ctx context.Context, vpcID string, description string) error
+
+	// DeleteFunc is called by Delete
+	DeleteFunc func(ctx context.Context, vpcID string) error
+
+	// ListFunc is called by List
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.VPC, *govultr.Meta, *http.Response, error)
+
+	// ListAttachmentsFunc is called by ListAttachments
+	ListAttachmentsFunc func(ctx context.Context, vpcID string, options *govultr.ListOptions) ([]govultr.VPCAttachment, *govultr.Meta, *http.Response, error)
+
+	// CreateNATGatewayFunc is called by CreateNATGateway
+	CreateNATGatewayFunc func(ctx context.Context, vpcID string, createReq *govultr.NATGatewayReq) (*govultr.NATGateway, *http.Response, error)
+
+	// GetNATGatewayFunc is called by GetNATGateway
+	GetNATGatewayFunc func(ctx context.Context, vpcID, gatewayID string) (*govultr.NATGateway, *http.Response, error)
+
+	// UpdateNATGatewayFunc is called by UpdateNATGateway
+	UpdateNATGatewayFunc func(ctx context.Context, vpcID, gatewayID string, updateReq *govultr.NATGatewayReq) (*govultr.NATGateway, *http.Response, error)
+
+	// DeleteNATGatewayFunc is called by DeleteNATGateway
+	DeleteNATGatewayFunc func(ctx context.Context, vpcID, gatewayID string) error
+
+	// ListNATGatewaysFunc is called by ListNATGateways
+	ListNATGatewaysFunc func(ctx context.Context, vpcID string, options *govultr.ListOptions) ([]govultr.NATGateway, *govultr.Meta, *http.Response, error)
+
+	// CreateNATGatewayFirewallRuleFunc is called by CreateNATGatewayFirewallRule
+	CreateNATGatewayFirewallRuleFunc func(ctx context.Context, vpcID, gatewayID string, createReq *govultr.NATGatewayFirewallRuleCreateReq) (*govultr.NATGatewayFirewallRule, *http.Response, error)
+
+	// GetNATGatewayFirewallRuleFunc is called by GetNATGatewayFirewallRule
+	GetNATGatewayFirewallRuleFunc func(ctx context.Context, vpcID, gatewayID, ruleID string) (*govultr.NATGatewayFirewallRule, *http.Response, error)
+
+	// UpdateNATGatewayFirewallRuleFunc is called by UpdateNATGatewayFirewallRule
+	UpdateNATGatewayFirewallRuleFunc func(ctx context.Context, vpcID, gatewayID, ruleID string, updateReq *govultr.NATGatewayFirewallRuleUpdateReq) (*govultr.NATGatewayFirewallRule, *http.Response, error)
+
+	// DeleteNATGatewayFirewallRuleFunc is called by DeleteNATGatewayFirewallRule
+	DeleteNATGatewayFirewallRuleFunc func(ctx context.Context, vpcID, gatewayID, ruleID string) error
+
+	// ListNATGatewayFirewallRulesFunc is called by ListNATGatewayFirewallRules
+	ListNATGatewayFirewallRulesFunc func(ctx context.Context, vpcID, gatewayID string, options *govultr.ListOptions) ([]govultr.NATGatewayFirewallRule, *govultr.Meta, *http.Response, error)
+
+	// CreateNATGatewayPortForwardingRuleFunc is called by CreateNATGatewayPortForwardingRule
+	CreateNATGatewayPortForwardingRuleFunc func(ctx context.Context, vpcID, gatewayID string, createReq *govultr.NATGatewayPortForwardingRuleReq) (*govultr.NATGatewayPortForwardingRule, *http.Response, error)
+
+	// GetNATGatewayPortForwardingRuleFunc is called by GetNATGatewayPortForwardingRule
+	GetNATGatewayPortForwardingRuleFunc func(ctx context.Context, vpcID, gatewayID, ruleID string) (*govultr.NATGatewayPortForwardingRule, *http.Response, error)
+
+	// UpdateNATGatewayPortForwardingRuleFunc is called by UpdateNATGatewayPortForwardingRule
+	UpdateNATGatewayPortForwardingRuleFunc func(ctx context.Context, vpcID, gatewayID, ruleID string, updateReq *govultr.NATGatewayPortForwardingRuleReq) (*govultr.NATGatewayPortForwardingRule, *http.Response, error)
+
+	// DeleteNATGatewayPortForwardingRuleFunc is called by DeleteNATGatewayPortForwardingRule
+	DeleteNATGatewayPortForwardingRuleFunc func(ctx context.Context, vpcID, gatewayID, ruleID string) error
+
+	// ListNATGatewayPortForwardingRulesFunc is called by ListNATGatewayPortForwardingRules
+	ListNATGatewayPortForwardingRulesFunc func(ctx context.Context, vpcID, gatewayID string, options *govultr.ListOptions) ([]govultr.NATGatewayPortForwardingRule, *govultr.Meta, *http.Response, error)
+}
+
+var _ govultr.VPCService = (*VPCService)(nil)
+
+// Create records the call and calls CreateFunc
+func (m *VPCService) Create(ctx context.Context, createReq *govultr.VPCReq) (*govultr.VPC, *http.Response, error) {
+	m.record("Create", ctx, createReq)
+	if m.CreateFunc == nil {
+		var r0 *govultr.VPC
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "Create")
+	}
+
+	return m.CreateFunc(ctx, createReq)
+}
+
+// Get records the call and calls GetFunc
+func (m *VPCService) Get(ctx context.Context, vpcID string) (*govultr.VPC, *http.Response, error) {
+	m.record("Get", ctx, vpcID)
+	if m.GetFunc == nil {
+		var r0 *govultr.VPC
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "Get")
+	}
+
+	return m.GetFunc(ctx, vpcID)
+}
+
+// Update records the call and calls UpdateFunc
+func (m *VPCService) Update(ctx context.Context, vpcID string, description string) error {
+	m.record("Update", ctx, vpcID, description)
+	if m.UpdateFunc == nil {
+		return notMocked("VPCService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, vpcID, description)
+}
+
+// Delete records the call and calls DeleteFunc
+func (m *VPCService) Delete(ctx context.Context, vpcID string) error {
+	m.record("Delete", ctx, vpcID)
+	if m.DeleteFunc == nil {
+		return notMocked("VPCService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, vpcID)
+}
+
+// List records the call and calls ListFunc
+func (m *VPCService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.VPC, *govultr.Meta, *http.Response, error) {
+	m.record("List", ctx, options)
+	if m.ListFunc == nil {
+		var r0 []govultr.VPC
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("VPCService", "List")
+	}
+
+	return m.ListFunc(ctx, options)
+}
+
+// ListAttachments records the call and calls ListAttachmentsFunc
+func (m *VPCService) ListAttachments(ctx context.Context, vpcID string, options *govultr.ListOptions) ([]govultr.VPCAttachment, *govultr.Meta, *http.Response, error) {
+	m.record("ListAttachments", ctx, vpcID, options)
+	if m.ListAttachmentsFunc == nil {
+		var r0 []govultr.VPCAttachment
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("VPCService", "ListAttachments")
+	}
+
+	return m.ListAttachmentsFunc(ctx, vpcID, options)
+}
+
+// CreateNATGateway records the call and calls CreateNATGatewayFunc
+func (m *VPCService) CreateNATGateway(ctx context.Context, vpcID string, createReq *govultr.NATGatewayReq) (*govultr.NATGateway, *http.Response, error) {
+	m.record("CreateNATGateway", ctx, vpcID, createReq)
+	if m.CreateNATGatewayFunc == nil {
+		var r0 *govultr.NATGateway
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "CreateNATGateway")
+	}
+
+	return m.CreateNATGatewayFunc(ctx, vpcID, createReq)
+}
+
+// GetNATGateway records the call and calls GetNATGatewayFunc
+func (m *VPCService) GetNATGateway(ctx context.Context, vpcID, gatewayID string) (*govultr.NATGateway, *http.Response, error) {
+	m.record("GetNATGateway", ctx, vpcID, gatewayID)
+	if m.GetNATGatewayFunc == nil {
+		var r0 *govultr.NATGateway
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "GetNATGateway")
+	}
+
+	return m.GetNATGatewayFunc(ctx, vpcID, gatewayID)
+}
+
+// UpdateNATGateway records the call and calls UpdateNATGatewayFunc
+func (m *VPCService) UpdateNATGateway(ctx context.Context, vpcID, gatewayID string, updateReq *govultr.NATGatewayReq) (*govultr.NATGateway, *http.Response, error) {
+	m.record("UpdateNATGateway", ctx, vpcID, gatewayID, updateReq)
+	if m.UpdateNATGatewayFunc == nil {
+		var r0 *govultr.NATGateway
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "UpdateNATGateway")
+	}
+
+	return m.UpdateNATGatewayFunc(ctx, vpcID, gatewayID, updateReq)
+}
+
+// DeleteNATGateway records the call and calls DeleteNATGatewayFunc
+func (m *VPCService) DeleteNATGateway(ctx context.Context, vpcID, gatewayID string) error {
+	m.record("DeleteNATGateway", ctx, vpcID, gatewayID)
+	if m.DeleteNATGatewayFunc == nil {
+		return notMocked("VPCService", "DeleteNATGateway")
+	}
+
+	return m.DeleteNATGatewayFunc(ctx, vpcID, gatewayID)
+}
+
+// ListNATGateways records the call and calls ListNATGatewaysFunc
+func (m *VPCService) ListNATGateways(ctx context.Context, vpcID string, options *govultr.ListOptions) ([]govultr.NATGateway, *govultr.Meta, *http.Response, error) {
+	m.record("ListNATGateways", ctx, vpcID, options)
+	if m.ListNATGatewaysFunc == nil {
+		var r0 []govultr.NATGateway
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("VPCService", "ListNATGateways")
+	}
+
+	return m.ListNATGatewaysFunc(ctx, vpcID, options)
+}
+
+// CreateNATGatewayFirewallRule records the call and calls CreateNATGatewayFirewallRuleFunc
+func (m *VPCService) CreateNATGatewayFirewallRule(ctx context.Context, vpcID, gatewayID string, createReq *govultr.NATGatewayFirewallRuleCreateReq) (*govultr.NATGatewayFirewallRule, *http.Response, error) {
+	m.record("CreateNATGatewayFirewallRule", ctx, vpcID, gatewayID, createReq)
+	if m.CreateNATGatewayFirewallRuleFunc == nil {
+		var r0 *govultr.NATGatewayFirewallRule
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "CreateNATGatewayFirewallRule")
+	}
+
+	return m.CreateNATGatewayFirewallRuleFunc(ctx, vpcID, gatewayID, createReq)
+}
+
+// GetNATGatewayFirewallRule records the call and calls GetNATGatewayFirewallRuleFunc
+func (m *VPCService) GetNATGatewayFirewallRule(ctx context.Context, vpcID, gatewayID, ruleID string) (*govultr.NATGatewayFirewallRule, *http.Response, error) {
+	m.record("GetNATGatewayFirewallRule", ctx, vpcID, gatewayID, ruleID)
+	if m.GetNATGatewayFirewallRuleFunc == nil {
+		var r0 *govultr.NATGatewayFirewallRule
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "GetNATGatewayFirewallRule")
+	}
+
+	return m.GetNATGatewayFirewallRuleFunc(ctx, vpcID, gatewayID, ruleID)
+}
+
+// UpdateNATGatewayFirewallRule records the call and calls UpdateNATGatewayFirewallRuleFunc
+func (m *VPCService) UpdateNATGatewayFirewallRule(ctx context.Context, vpcID, gatewayID, ruleID string, updateReq *govultr.NATGatewayFirewallRuleUpdateReq) (*govultr.NATGatewayFirewallRule, *http.Response, error) {
+	m.record("UpdateNATGatewayFirewallRule", ctx, vpcID, gatewayID, ruleID, updateReq)
+	if m.UpdateNATGatewayFirewallRuleFunc == nil {
+		var r0 *govultr.NATGatewayFirewallRule
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "UpdateNATGatewayFirewallRule")
+	}
+
+	return m.UpdateNATGatewayFirewallRuleFunc(ctx, vpcID, gatewayID, ruleID, updateReq)
+}
+
+// DeleteNATGatewayFirewallRule records the call and calls DeleteNATGatewayFirewallRuleFunc
+func (m *VPCService) DeleteNATGatewayFirewallRule(ctx context.Context, vpcID, gatewayID, ruleID string) error {
+	m.record("DeleteNATGatewayFirewallRule", ctx, vpcID, gatewayID, ruleID)
+	if m.DeleteNATGatewayFirewallRuleFunc == nil {
+		return notMocked("VPCService", "DeleteNATGatewayFirewallRule")
+	}
+
+	return m.DeleteNATGatewayFirewallRuleFunc(ctx, vpcID, gatewayID, ruleID)
+}
+
+// ListNATGatewayFirewallRules records the call and calls ListNATGatewayFirewallRulesFunc
+func (m *VPCService) ListNATGatewayFirewallRules(ctx context.Context, vpcID, gatewayID string, options *govultr.ListOptions) ([]govultr.NATGatewayFirewallRule, *govultr.Meta, *http.Response, error) {
+	m.record("ListNATGatewayFirewallRules", ctx, vpcID, gatewayID, options)
+	if m.ListNATGatewayFirewallRulesFunc == nil {
+		var r0 []govultr.NATGatewayFirewallRule
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("VPCService", "ListNATGatewayFirewallRules")
+	}
+
+	return m.ListNATGatewayFirewallRulesFunc(ctx, vpcID, gatewayID, options)
+}
+
+// CreateNATGatewayPortForwardingRule records the call and calls CreateNATGatewayPortForwardingRuleFunc
+func (m *VPCService) CreateNATGatewayPortForwardingRule(ctx context.Context, vpcID, gatewayID string, createReq *govultr.NATGatewayPortForwardingRuleReq) (*govultr.NATGatewayPortForwardingRule, *http.Response, error) {
+	m.record("CreateNATGatewayPortForwardingRule", ctx, vpcID, gatewayID, createReq)
+	if m.CreateNATGatewayPortForwardingRuleFunc == nil {
+		var r0 *govultr.NATGatewayPortForwardingRule
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "CreateNATGatewayPortForwardingRule")
+	}
+
+	return m.CreateNATGatewayPortForwardingRuleFunc(ctx, vpcID, gatewayID, createReq)
+}
+
+// GetNATGatewayPortForwardingRule records the call and calls GetNATGatewayPortForwardingRuleFunc
+func (m *VPCService) GetNATGatewayPortForwardingRule(ctx context.Context, vpcID, gatewayID, ruleID string) (*govultr.NATGatewayPortForwardingRule, *http.Response, error) {
+	m.record("GetNATGatewayPortForwardingRule", ctx, vpcID, gatewayID, ruleID)
+	if m.GetNATGatewayPortForwardingRuleFunc == nil {
+		var r0 *govultr.NATGatewayPortForwardingRule
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "GetNATGatewayPortForwardingRule")
+	}
+
+	return m.GetNATGatewayPortForwardingRuleFunc(ctx, vpcID, gatewayID, ruleID)
+}
+
+// UpdateNATGatewayPortForwardingRule records the call and calls UpdateNATGatewayPortForwardingRuleFunc
+func (m *VPCService) UpdateNATGatewayPortForwardingRule(ctx context.Context, vpcID, gatewayID, ruleID string, updateReq *govultr.NATGatewayPortForwardingRuleReq) (*govultr.NATGatewayPortForwardingRule, *http.Response, error) {
+	m.record("UpdateNATGatewayPortForwardingRule", ctx, vpcID, gatewayID, ruleID, updateReq)
+	if m.UpdateNATGatewayPortForwardingRuleFunc == nil {
+		var r0 *govultr.NATGatewayPortForwardingRule
+		var r1 *http.Response
+		return r0, r1, notMocked("VPCService", "UpdateNATGatewayPortForwardingRule")
+	}
+
+	return m.UpdateNATGatewayPortForwardingRuleFunc(ctx, vpcID, gatewayID, ruleID, updateReq)
+}
+
+// DeleteNATGatewayPortForwardingRule records the call and calls DeleteNATGatewayPortForwardingRuleFunc
+func (m *VPCService) DeleteNATGatewayPortForwardingRule(ctx context.Context, vpcID, gatewayID, ruleID string) error {
+	m.record("DeleteNATGatewayPortForwardingRule", ctx, vpcID, gatewayID, ruleID)
+	if m.DeleteNATGatewayPortForwardingRuleFunc == nil {
+		return notMocked("VPCService", "DeleteNATGatewayPortForwardingRule")
+	}
+
+	return m.DeleteNATGatewayPortForwardingRuleFunc(ctx, vpcID, gatewayID, ruleID)
+}
+
+// ListNATGatewayPortForwardingRules records the call and calls ListNATGatewayPortForwardingRulesFunc
+func (m *VPCService) ListNATGatewayPortForwardingRules(ctx context.Context, vpcID, gatewayID string, options *govultr.ListOptions) ([]govultr.NATGatewayPortForwardingRule, *govultr.Meta, *http.Response, error) {
+	m.record("ListNATGatewayPortForwardingRules", ctx, vpcID, gatewayID, options)
+	if m.ListNATGatewayPortForwardingRulesFunc == nil {
+		var r0 []govultr.NATGatewayPortForwardingRule
+		var r1 *govultr.Meta
+		var r2 *http.Response
+		return r0, r1, r2, notMocked("VPCService", "ListNATGatewayPortForwardingRules")
+	}
+
+	return m.ListNATGatewayPortForwardingRulesFunc(ctx, vpcID, gatewayID, options)
+}
+
+// MockClient is a govultr.Client with every service replaced by a mock.
+// The mock fields shadow the service interfaces of the embedded Client, so
+// they can be configured directly.
+type MockClient struct {
+	*govultr.Client
+
+	Account                  *AccountService
+	Application              *ApplicationService
+	Backup                   *BackupService
+	BareMetalServer          *BareMetalServerService
+	Billing                  *BillingService
+	BlockStorage             *BlockStorageService
+	CDN                      *CDNService
+	ContainerRegistry        *ContainerRegistryService
+	Database                 *DatabaseService
+	Domain                   *DomainService
+	DomainRecord             *DomainRecordService
+	FirewallGroup            *FirewallGroupService
+	FirewallRule             *FireWallRuleService
+	Instance                 *InstanceService
+	ISO                      *ISOService
+	Kubernetes               *KubernetesService
+	LoadBalancer             *LoadBalancerService
+	Logs                     *LogsService
+	Marketplace              *MarketplaceService
+	ObjectStorage            *ObjectStorageService
+	OIDC                     *OIDCService
+	Organization             *OrganizationService
+	OS                       *OSService
+	Plan                     *PlanService
+	Region                   *RegionService
+	ReservedIP               *ReservedIPService
+	Inference                *InferenceService
+	Snapshot                 *SnapshotService
+	SSHKey                   *SSHKeyService
+	StartupScript            *StartupScriptService
+	SubAccount               *SubAccountService
+	User                     *UserService
+	VirtualFileSystemStorage *VirtualFileSystemStorageService
+	VPC                      *VPCService
+}
+
+// NewMockClient returns a MockClient with a mock for every service
+func NewMockClient() *MockClient {
+	m := &MockClient{
+		Client:                   govultr.NewClient(nil),
+		Account:                  &AccountService{},
+		Application:              &ApplicationService{},
+		Backup:                   &BackupService{},
+		BareMetalServer:          &BareMetalServerService{},
+		Billing:                  &BillingService{},
+		BlockStorage:             &BlockStorageService{},
+		CDN:                      &CDNService{},
+		ContainerRegistry:        &ContainerRegistryService{},
+		Database:                 &DatabaseService{},
+		Domain:                   &DomainService{},
+		DomainRecord:             &DomainRecordService{},
+		FirewallGroup:            &FirewallGroupService{},
+		FirewallRule:             &FireWallRuleService{},
+		Instance:                 &InstanceService{},
+		ISO:                      &ISOService{},
+		Kubernetes:               &KubernetesService{},
+		LoadBalancer:             &LoadBalancerService{},
+		Logs:                     &LogsService{},
+		Marketplace:              &MarketplaceService{},
+		ObjectStorage:            &ObjectStorageService{},
+		OIDC:                     &OIDCService{},
+		Organization:             &OrganizationService{},
+		OS:                       &OSService{},
+		Plan:                     &PlanService{},
+		Region:                   &RegionService{},
+		ReservedIP:               &ReservedIPService{},
+		Inference:                &InferenceService{},
+		Snapshot:                 &SnapshotService{},
+		SSHKey:                   &SSHKeyService{},
+		StartupScript:            &StartupScriptService{},
+		SubAccount:               &SubAccountService{},
+		User:                     &UserService{},
+		VirtualFileSystemStorage: &VirtualFileSystemStorageService{},
+		VPC:                      &VPCService{},
+	}
+
+	m.Client.Account = m.Account
+	m.Client.Application = m.Application
+	m.Client.Backup = m.Backup
+	m.Client.BareMetalServer = m.BareMetalServer
+	m.Client.Billing = m.Billing
+	m.Client.BlockStorage = m.BlockStorage
+	m.Client.CDN = m.CDN
+	m.Client.ContainerRegistry = m.ContainerRegistry
+	m.Client.Database = m.Database
+	m.Client.Domain = m.Domain
+	m.Client.DomainRecord = m.DomainRecord
+	m.Client.FirewallGroup = m.FirewallGroup
+	m.Client.FirewallRule = m.FirewallRule
+	m.Client.Instance = m.Instance
+	m.Client.ISO = m.ISO
+	m.Client.Kubernetes = m.Kubernetes
+	m.Client.LoadBalancer = m.LoadBalancer
+	m.Client.Logs = m.Logs
+	m.Client.Marketplace = m.Marketplace
+	m.Client.ObjectStorage = m.ObjectStorage
+	m.Client.OIDC = m.OIDC
+	m.Client.Organization = m.Organization
+	m.Client.OS = m.OS
+	m.Client.Plan = m.Plan
+	m.Client.Region = m.Region
+	m.Client.ReservedIP = m.ReservedIP
+	m.Client.Inference = m.Inference
+	m.Client.Snapshot = m.Snapshot
+	m.Client.SSHKey = m.SSHKey
+	m.Client.StartupScript = m.StartupScript
+	m.Client.SubAccount = m.SubAccount
+	m.Client.User = m.User
+	m.Client.VirtualFileSystemStorage = m.VirtualFileSystemStorage
+	m.Client.VPC = m.VPC
+
+	return m
+}
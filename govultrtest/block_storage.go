@@ -0,0 +1,171 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Monthly cost of a GB of block storage
+const blockCostPerGB = 0.1
+
+func (s *Server) registerBlockStorage(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/blocks", s.createBlock)
+	mux.HandleFunc("GET /v2/blocks", s.listBlocks)
+	mux.HandleFunc("GET /v2/blocks/{id}", s.getBlock)
+	mux.HandleFunc("PATCH /v2/blocks/{id}", s.updateBlock)
+	mux.HandleFunc("DELETE /v2/blocks/{id}", s.deleteBlock)
+	mux.HandleFunc("POST /v2/blocks/{id}/attach", s.attachBlock)
+	mux.HandleFunc("POST /v2/blocks/{id}/detach", s.detachBlock)
+}
+
+func (s *Server) createBlock(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.BlockStorageCreate{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	switch {
+	case req.Region == "":
+		writeError(w, http.StatusBadRequest, "Invalid region.")
+		return
+	case req.SizeGB <= 0:
+		writeError(w, http.StatusBadRequest, "Invalid size_gb.")
+		return
+	}
+
+	blockType := req.BlockType
+	if blockType == "" {
+		blockType = "high_perf"
+	}
+
+	block := &govultr.BlockStorage{
+		ID:          newID(),
+		DateCreated: now(),
+		Cost:        float32(req.SizeGB) * blockCostPerGB,
+		Status:      "pending",
+		SizeGB:      req.SizeGB,
+		Region:      req.Region,
+		Label:       req.Label,
+		BlockType:   blockType,
+		SnapshotID:  req.SnapshotID,
+		OSID:        req.OSID,
+		Bootable:    req.Bootable != nil && *req.Bootable,
+	}
+	block.MountID = req.Region + "-" + block.ID[:13]
+
+	s.blocks.add(block.ID, block)
+
+	id := block.ID
+	s.provision(func() {
+		if b, ok := s.blocks.get(id); ok {
+			b.Status = "active"
+		}
+	})
+
+	writeJSON(w, http.StatusAccepted, map[string]any{"block": block})
+}
+
+func (s *Server) listBlocks(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.blocks.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"blocks": page, "meta": meta})
+}
+
+func (s *Server) getBlock(w http.ResponseWriter, r *http.Request) {
+	block, ok := s.blocks.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Block storage")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"block": block})
+}
+
+func (s *Server) updateBlock(w http.ResponseWriter, r *http.Request) {
+	block, ok := s.blocks.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Block storage")
+		return
+	}
+
+	req := &govultr.BlockStorageUpdate{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.SizeGB != 0 {
+		if req.SizeGB < block.SizeGB {
+			writeError(w, http.StatusBadRequest, "Block storage can only be resized to a larger size.")
+			return
+		}
+		block.SizeGB = req.SizeGB
+		block.Cost = float32(req.SizeGB) * blockCostPerGB
+	}
+
+	if req.Label != "" {
+		block.Label = req.Label
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteBlock(w http.ResponseWriter, r *http.Request) {
+	if !s.blocks.remove(r.PathValue("id")) {
+		writeNotFound(w, "Block storage")
+		return
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) attachBlock(w http.ResponseWriter, r *http.Request) {
+	block, ok := s.blocks.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Block storage")
+		return
+	}
+
+	req := &govultr.BlockStorageAttach{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	instance, ok := s.instances.get(req.InstanceID)
+	if !ok {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	if block.AttachedToInstance != "" {
+		writeError(w, http.StatusBadRequest, "Block storage is already attached to an instance.")
+		return
+	}
+
+	block.AttachedToInstance = instance.ID
+	block.AttachedToInstanceIP = instance.MainIP
+	block.AttachedToInstanceLabel = instance.Label
+
+	writeNoContent(w)
+}
+
+func (s *Server) detachBlock(w http.ResponseWriter, r *http.Request) {
+	block, ok := s.blocks.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Block storage")
+		return
+	}
+
+	if block.AttachedToInstance == "" {
+		writeError(w, http.StatusBadRequest, "Block storage is not attached to an instance.")
+		return
+	}
+
+	detachBlock(block)
+	writeNoContent(w)
+}
+
+func detachBlock(block *govultr.BlockStorage) {
+	block.AttachedToInstance = ""
+	block.AttachedToInstanceIP = ""
+	block.AttachedToInstanceLabel = ""
+}
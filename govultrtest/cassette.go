@@ -0,0 +1,287 @@
+package govultrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Version of the cassette file format
+const cassetteVersion = 1
+
+// Mode is what a Recorder does with requests
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette without any network
+	// access. A request that was not recorded fails.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records them, replacing the
+	// cassette when it is saved
+	ModeRecord
+
+	// ModeAuto replays when the cassette exists and records otherwise
+	ModeAuto
+)
+
+// Cassette is the file format interactions are recorded in
+type Cassette struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and the response to it
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request with credentials and secrets scrubbed
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response with credentials and secrets scrubbed
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// RecorderOption configures a Recorder
+type RecorderOption func(*Recorder)
+
+// WithRealTransport sets the transport requests are sent with while
+// recording, http.DefaultTransport by default
+func WithRealTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithScrubber adds a function that removes secrets from an interaction
+// before it is recorded, on top of the credentials and secret fields that
+// govultr.RedactHeaders and govultr.RedactBody remove
+func WithScrubber(scrub func(*Interaction)) RecorderOption {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrub)
+	}
+}
+
+// Recorder is an http.RoundTripper that records API interactions to a
+// cassette file and replays them. Use it as the transport of the client:
+//
+//	rec, err := govultrtest.NewRecorder("testdata/instances.json", govultrtest.ModeAuto)
+//	t.Cleanup(func() { rec.Save() })
+//
+//	client, err := govultr.NewClientWithOptions(govultr.WithTransport(rec), govultr.WithAPIKey(key))
+//
+// Requests are matched on their method, path, query and body, and every
+// recorded interaction is replayed once, in the order it was recorded.
+// Credentials, passwords, API and S3 keys and kubeconfigs are scrubbed
+// before anything is written.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrubbers []func(*Interaction)
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In ModeReplay, and
+// in ModeAuto when the file exists, the cassette is loaded right away.
+func NewRecorder(path string, mode Mode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, transport: http.DefaultTransport}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeAuto {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode != ModeReplay {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+
+	r.interactions = cassette.Interactions
+	r.used = make([]bool, len(cassette.Interactions))
+
+	return r, nil
+}
+
+// Mode returns whether the recorder is recording or replaying
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	recorded := r.scrubRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, body, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || !matches(&interaction.Request, &recorded) {
+			continue
+		}
+
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("govultrtest: no recorded interaction left for %s %s in %s", req.Method, req.URL.RequestURI(), r.path)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, recorded RecordedRequest) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     govultr.RedactHeaders(resp.Header),
+			Body:       string(govultr.RedactBody(respBody)),
+		},
+	}
+
+	for _, scrub := range r.scrubbers {
+		scrub(interaction)
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// scrubRequest returns the request as it is recorded, which is also how
+// requests are compared on replay
+func (r *Recorder) scrubRequest(req *http.Request, body []byte) RecordedRequest {
+	interaction := &Interaction{Request: RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Header: govultr.RedactHeaders(req.Header),
+		Body:   string(govultr.RedactBody(body)),
+	}}
+
+	for _, scrub := range r.scrubbers {
+		scrub(interaction)
+	}
+
+	return interaction.Request
+}
+
+// Save writes the recorded interactions to the cassette. It does nothing
+// when replaying.
+func (r *Recorder) Save() error {
+	if r.mode == ModeReplay {
+		return nil
+	}
+
+	r.mu.Lock()
+	cassette := &Cassette{Version: cassetteVersion, Interactions: r.interactions}
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil { //nolint:mnd
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0o600) //nolint:mnd
+}
+
+// matches reports whether a live request is the recorded one
+func matches(recorded, live *RecordedRequest) bool {
+	if recorded.Method != live.Method || recorded.Path != live.Path || recorded.Query != live.Query {
+		return false
+	}
+
+	if recorded.Body == live.Body {
+		return true
+	}
+
+	var a, b any
+	if json.Unmarshal([]byte(recorded.Body), &a) != nil || json.Unmarshal([]byte(live.Body), &b) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(a, b)
+}
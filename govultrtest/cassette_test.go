@@ -0,0 +1,165 @@
+package govultrtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// runCassetteCalls makes the calls the cassette test records and replays
+func runCassetteCalls(t *testing.T, client *govultr.Client) (*govultr.Instance, []govultr.Instance) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	created, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr",
+		Plan:   "vc2-1c-1gb",
+		OsID:   2284,
+		Label:  "web",
+	})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	instance, _, err := client.Instance.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	instances, _, _, err := client.Instance.List(ctx, &govultr.ListOptions{PerPage: 10})
+	if err != nil {
+		t.Fatalf("Instance.List returned %+v", err)
+	}
+
+	return instance, instances
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "instances.json")
+
+	rec, err := NewRecorder(path, ModeAuto)
+	if err != nil {
+		t.Fatalf("NewRecorder returned %+v", err)
+	}
+
+	if rec.Mode() != ModeRecord {
+		t.Errorf("NewRecorder mode %v, expected %v without a cassette", rec.Mode(), ModeRecord)
+	}
+
+	srv := NewServer(WithAPIKey("recorded-key"))
+	recorded, recordedList := runCassetteCalls(t, srv.Client(govultr.WithTransport(rec)))
+	srv.Close()
+
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Recorder.Save returned %+v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette returned %+v", err)
+	}
+
+	if strings.Contains(string(data), "recorded-key") {
+		t.Error("cassette contains the API key, expected it to be scrubbed")
+	}
+
+	if !strings.Contains(string(data), `\"default_password\":\"`+govultr.RedactedValue) {
+		t.Errorf("cassette contains %s, expected the default password to be scrubbed", data)
+	}
+
+	replay, err := NewRecorder(path, ModeAuto)
+	if err != nil {
+		t.Fatalf("NewRecorder returned %+v", err)
+	}
+
+	if replay.Mode() != ModeReplay {
+		t.Errorf("NewRecorder mode %v, expected %v with a cassette", replay.Mode(), ModeReplay)
+	}
+
+	client, err := govultr.NewClientWithOptions(
+		govultr.WithTransport(replay),
+		govultr.WithAPIKey("another-key"),
+		govultr.WithBaseURL("https://api.example.com"),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	replayed, replayedList := runCassetteCalls(t, client)
+
+	if !reflect.DeepEqual(replayed, recorded) {
+		t.Errorf("replayed Instance.Get returned %+v, expected %+v", replayed, recorded)
+	}
+
+	if !reflect.DeepEqual(replayedList, recordedList) {
+		t.Errorf("replayed Instance.List returned %+v, expected %+v", replayedList, recordedList)
+	}
+
+	if _, _, err := client.Instance.Get(context.Background(), recorded.ID); err == nil {
+		t.Error("Instance.Get returned no error, expected every interaction to be replayed once")
+	}
+}
+
+func TestRecorder_Unmatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(path, []byte(`{"version":1,"interactions":[]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("NewRecorder returned %+v", err)
+	}
+
+	client, err := govultr.NewClientWithOptions(govultr.WithTransport(rec), govultr.WithRetryLimit(0))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	_, _, err = client.Instance.Get(context.Background(), "missing")
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction left for GET /v2/instances/missing") {
+		t.Errorf("Instance.Get returned %+v, expected an unmatched request error", err)
+	}
+}
+
+func TestRecorder_Scrubber(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scripts.json")
+
+	rec, err := NewRecorder(path, ModeRecord, WithScrubber(func(i *Interaction) {
+		i.Request.Body = strings.ReplaceAll(i.Request.Body, "hunter2", "xxx")
+		i.Response.Body = strings.ReplaceAll(i.Response.Body, "hunter2", "xxx")
+	}))
+	if err != nil {
+		t.Fatalf("NewRecorder returned %+v", err)
+	}
+
+	srv := NewServer()
+	defer srv.Close()
+
+	_, _, err = srv.Client(govultr.WithTransport(rec)).StartupScript.Create(context.Background(), &govultr.StartupScriptReq{
+		Name:   "boot",
+		Script: "echo hunter2",
+	})
+	if err != nil {
+		t.Fatalf("StartupScript.Create returned %+v", err)
+	}
+
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Recorder.Save returned %+v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette returned %+v", err)
+	}
+
+	if strings.Contains(string(data), "hunter2") {
+		t.Errorf("cassette contains the scrubbed value: %s", data)
+	}
+}
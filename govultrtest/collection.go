@@ -0,0 +1,87 @@
+package govultrtest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Page size used when a list request has no per_page
+const defaultPerPage = 100
+
+// collection stores resources by ID and lists them in the order they were created
+type collection[T any] struct {
+	items map[string]*T
+	ids   []string
+}
+
+func newCollection[T any]() *collection[T] {
+	return &collection[T]{items: map[string]*T{}}
+}
+
+func (c *collection[T]) add(id string, item *T) {
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+
+	c.items[id] = item
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+func (c *collection[T]) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+	c.ids = slices.DeleteFunc(c.ids, func(v string) bool { return v == id })
+
+	return true
+}
+
+// list returns copies of the resources that match the filter, nil matches all
+func (c *collection[T]) list(filter func(*T) bool) []T {
+	items := make([]T, 0, len(c.ids))
+	for _, id := range c.ids {
+		item := c.items[id]
+		if filter == nil || filter(item) {
+			items = append(items, *item)
+		}
+	}
+
+	return items
+}
+
+// paginate returns the page of items selected by the per_page and cursor
+// query parameters, along with its meta. Cursors are offsets into the list.
+func paginate[T any](r *http.Request, items []T) ([]T, *govultr.Meta) {
+	query := r.URL.Query()
+
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = defaultPerPage
+	}
+
+	offset, err := strconv.Atoi(query.Get("cursor"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	offset = min(offset, len(items))
+	end := min(offset+perPage, len(items))
+
+	links := &govultr.Links{}
+	if end < len(items) {
+		links.Next = strconv.Itoa(end)
+	}
+	if offset > 0 {
+		links.Prev = strconv.Itoa(max(offset-perPage, 0))
+	}
+
+	return items[offset:end], &govultr.Meta{Total: len(items), Links: links}
+}
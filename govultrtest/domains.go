@@ -0,0 +1,246 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Default TTL of new DNS records
+const defaultTTL = 300
+
+// domain is a DNS domain along with its records
+type domain struct {
+	govultr.Domain
+	records *collection[govultr.DomainRecord]
+}
+
+func (s *Server) registerDomains(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/domains", s.createDomain)
+	mux.HandleFunc("GET /v2/domains", s.listDomains)
+	mux.HandleFunc("GET /v2/domains/{domain}", s.getDomain)
+	mux.HandleFunc("PUT /v2/domains/{domain}", s.updateDomain)
+	mux.HandleFunc("DELETE /v2/domains/{domain}", s.deleteDomain)
+
+	mux.HandleFunc("POST /v2/domains/{domain}/records", s.createRecord)
+	mux.HandleFunc("GET /v2/domains/{domain}/records", s.listRecords)
+	mux.HandleFunc("GET /v2/domains/{domain}/records/{id}", s.getRecord)
+	mux.HandleFunc("PATCH /v2/domains/{domain}/records/{id}", s.updateRecord)
+	mux.HandleFunc("DELETE /v2/domains/{domain}/records/{id}", s.deleteRecord)
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.DomainReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Domain == "" {
+		writeError(w, http.StatusBadRequest, "Invalid domain.")
+		return
+	}
+
+	if _, ok := s.domains.get(req.Domain); ok {
+		writeError(w, http.StatusBadRequest, "Domain already exists.")
+		return
+	}
+
+	dnsSec := req.DNSSec
+	if dnsSec == "" {
+		dnsSec = "disabled"
+	}
+
+	d := &domain{
+		Domain:  govultr.Domain{Domain: req.Domain, DateCreated: now(), DNSSec: dnsSec},
+		records: newCollection[govultr.DomainRecord](),
+	}
+
+	defaults := []govultr.DomainRecord{
+		{Type: "NS", Data: "ns1.vultr.com"},
+		{Type: "NS", Data: "ns2.vultr.com"},
+	}
+	if req.IP != "" {
+		defaults = append(defaults, govultr.DomainRecord{Type: "A", Data: req.IP})
+	}
+
+	for _, record := range defaults {
+		record.ID = newID()
+		record.TTL = defaultTTL
+		d.records.add(record.ID, &record)
+	}
+
+	s.domains.add(d.Domain.Domain, d)
+
+	writeJSON(w, http.StatusOK, map[string]any{"domain": d.Domain})
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	domains := s.domains.list(nil)
+
+	list := make([]govultr.Domain, len(domains))
+	for i := range domains {
+		list[i] = domains[i].Domain
+	}
+
+	page, meta := paginate(r, list)
+	writeJSON(w, http.StatusOK, map[string]any{"domains": page, "meta": meta})
+}
+
+// pathDomain returns the domain in the path, writing a 404 if it doesn't exist
+func (s *Server) pathDomain(w http.ResponseWriter, r *http.Request) (*domain, bool) {
+	d, ok := s.domains.get(r.PathValue("domain"))
+	if !ok {
+		writeNotFound(w, "Domain")
+	}
+
+	return d, ok
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.pathDomain(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"domain": d.Domain})
+}
+
+func (s *Server) updateDomain(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.pathDomain(w, r)
+	if !ok {
+		return
+	}
+
+	req := &govultr.DomainReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.DNSSec != "enabled" && req.DNSSec != "disabled" {
+		writeError(w, http.StatusBadRequest, "Invalid dns_sec, must be enabled or disabled.")
+		return
+	}
+
+	d.DNSSec = req.DNSSec
+	writeNoContent(w)
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request) {
+	if !s.domains.remove(r.PathValue("domain")) {
+		writeNotFound(w, "Domain")
+		return
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) createRecord(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.pathDomain(w, r)
+	if !ok {
+		return
+	}
+
+	req := &govultr.DomainRecordCreateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Type == "" || req.Data == "" {
+		writeError(w, http.StatusBadRequest, "A record type and data are required.")
+		return
+	}
+
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+
+	record := &govultr.DomainRecord{
+		ID:   newID(),
+		Type: req.Type,
+		Name: req.Name,
+		Data: req.Data,
+		TTL:  ttl,
+	}
+	if req.Priority != nil {
+		record.Priority = *req.Priority
+	}
+
+	d.records.add(record.ID, record)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"record": record})
+}
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.pathDomain(w, r)
+	if !ok {
+		return
+	}
+
+	page, meta := paginate(r, d.records.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"records": page, "meta": meta})
+}
+
+// pathRecord returns the record in the path, writing a 404 if it doesn't exist
+func (s *Server) pathRecord(w http.ResponseWriter, r *http.Request) (*domain, *govultr.DomainRecord, bool) {
+	d, ok := s.pathDomain(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	record, ok := d.records.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Record")
+	}
+
+	return d, record, ok
+}
+
+func (s *Server) getRecord(w http.ResponseWriter, r *http.Request) {
+	_, record, ok := s.pathRecord(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"record": record})
+}
+
+func (s *Server) updateRecord(w http.ResponseWriter, r *http.Request) {
+	_, record, ok := s.pathRecord(w, r)
+	if !ok {
+		return
+	}
+
+	req := &govultr.DomainRecordUpdateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Name != nil {
+		record.Name = *req.Name
+	}
+	if req.Type != "" {
+		record.Type = req.Type
+	}
+	if req.Data != "" {
+		record.Data = req.Data
+	}
+	if req.TTL != 0 {
+		record.TTL = req.TTL
+	}
+	if req.Priority != nil {
+		record.Priority = *req.Priority
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteRecord(w http.ResponseWriter, r *http.Request) {
+	d, record, ok := s.pathRecord(w, r)
+	if !ok {
+		return
+	}
+
+	d.records.remove(record.ID)
+	writeNoContent(w)
+}
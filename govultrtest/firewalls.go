@@ -0,0 +1,216 @@
+package govultrtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Most rules a firewall group can have
+const maxFirewallRules = 50
+
+// firewallGroup is a firewall group along with its rules
+type firewallGroup struct {
+	govultr.FirewallGroup
+	rules    *collection[govultr.FirewallRule]
+	nextRule int
+}
+
+func (s *Server) registerFirewalls(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/firewalls", s.createFirewallGroup)
+	mux.HandleFunc("GET /v2/firewalls", s.listFirewallGroups)
+	mux.HandleFunc("GET /v2/firewalls/{id}", s.getFirewallGroup)
+	mux.HandleFunc("PUT /v2/firewalls/{id}", s.updateFirewallGroup)
+	mux.HandleFunc("DELETE /v2/firewalls/{id}", s.deleteFirewallGroup)
+
+	mux.HandleFunc("POST /v2/firewalls/{id}/rules", s.createFirewallRule)
+	mux.HandleFunc("GET /v2/firewalls/{id}/rules", s.listFirewallRules)
+	mux.HandleFunc("GET /v2/firewalls/{id}/rules/{rule}", s.getFirewallRule)
+	mux.HandleFunc("DELETE /v2/firewalls/{id}/rules/{rule}", s.deleteFirewallRule)
+}
+
+func (s *Server) createFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.FirewallGroupReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	created := now()
+	group := &firewallGroup{
+		FirewallGroup: govultr.FirewallGroup{
+			ID:           newID()[:8],
+			Description:  req.Description,
+			DateCreated:  created,
+			DateModified: created,
+			MaxRuleCount: maxFirewallRules,
+		},
+		rules: newCollection[govultr.FirewallRule](),
+	}
+
+	s.firewallGroups.add(group.ID, group)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"firewall_group": group.FirewallGroup})
+}
+
+func (s *Server) listFirewallGroups(w http.ResponseWriter, r *http.Request) {
+	groups := s.firewallGroups.list(nil)
+
+	list := make([]govultr.FirewallGroup, len(groups))
+	for i := range groups {
+		list[i] = groups[i].FirewallGroup
+	}
+
+	page, meta := paginate(r, list)
+	writeJSON(w, http.StatusOK, map[string]any{"firewall_groups": page, "meta": meta})
+}
+
+// pathFirewallGroup returns the group in the path, writing a 404 if it doesn't exist
+func (s *Server) pathFirewallGroup(w http.ResponseWriter, r *http.Request) (*firewallGroup, bool) {
+	group, ok := s.firewallGroups.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Firewall group")
+	}
+
+	return group, ok
+}
+
+func (s *Server) getFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.pathFirewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"firewall_group": group.FirewallGroup})
+}
+
+func (s *Server) updateFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.pathFirewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	req := &govultr.FirewallGroupReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	group.Description = req.Description
+	group.DateModified = now()
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.firewallGroups.remove(id) {
+		writeNotFound(w, "Firewall group")
+		return
+	}
+
+	for _, instance := range s.instances.items {
+		if instance.FirewallGroupID == id {
+			instance.FirewallGroupID = ""
+		}
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) createFirewallRule(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.pathFirewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	req := &govultr.FirewallRuleReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	switch {
+	case req.IPType != "v4" && req.IPType != "v6":
+		writeError(w, http.StatusBadRequest, "Invalid ip_type, must be v4 or v6.")
+		return
+	case req.Protocol == "":
+		writeError(w, http.StatusBadRequest, "Invalid protocol.")
+		return
+	case group.RuleCount >= group.MaxRuleCount:
+		writeError(w, http.StatusBadRequest, "Firewall group has reached the maximum number of rules.")
+		return
+	}
+
+	group.nextRule++
+	rule := &govultr.FirewallRule{
+		ID:         group.nextRule,
+		Action:     "accept",
+		IPType:     req.IPType,
+		Protocol:   req.Protocol,
+		Port:       req.Port,
+		Subnet:     req.Subnet,
+		SubnetSize: req.SubnetSize,
+		Source:     req.Source,
+		Notes:      req.Notes,
+	}
+
+	group.rules.add(strconv.Itoa(rule.ID), rule)
+	group.RuleCount++
+	group.DateModified = now()
+
+	writeJSON(w, http.StatusCreated, map[string]any{"firewall_rule": rule})
+}
+
+func (s *Server) listFirewallRules(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.pathFirewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	page, meta := paginate(r, group.rules.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"firewall_rules": page, "meta": meta})
+}
+
+func (s *Server) getFirewallRule(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.pathFirewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	rule, ok := group.rules.get(r.PathValue("rule"))
+	if !ok {
+		writeNotFound(w, "Firewall rule")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"firewall_rule": rule})
+}
+
+func (s *Server) deleteFirewallRule(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.pathFirewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	if !group.rules.remove(r.PathValue("rule")) {
+		writeNotFound(w, "Firewall rule")
+		return
+	}
+
+	group.RuleCount--
+	group.DateModified = now()
+
+	writeNoContent(w)
+}
+
+// updateFirewallInstanceCounts recounts the instances in every firewall group
+func (s *Server) updateFirewallInstanceCounts() {
+	for _, group := range s.firewallGroups.items {
+		group.InstanceCount = 0
+	}
+
+	for _, instance := range s.instances.items {
+		if group, ok := s.firewallGroups.get(instance.FirewallGroupID); ok {
+			group.InstanceCount++
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package govultrtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Matches the CPU count and memory of plan IDs such as vc2-2c-4gb
+var planSizeRe = regexp.MustCompile(`-(\d+)c-(\d+)gb`)
+
+func (s *Server) registerInstances(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/instances", s.createInstance)
+	mux.HandleFunc("GET /v2/instances", s.listInstances)
+	mux.HandleFunc("GET /v2/instances/{id}", s.getInstance)
+	mux.HandleFunc("PATCH /v2/instances/{id}", s.updateInstance)
+	mux.HandleFunc("DELETE /v2/instances/{id}", s.deleteInstance)
+	mux.HandleFunc("POST /v2/instances/{id}/start", s.powerInstance("running"))
+	mux.HandleFunc("POST /v2/instances/{id}/halt", s.powerInstance("stopped"))
+	mux.HandleFunc("POST /v2/instances/{id}/reboot", s.powerInstance("running"))
+	mux.HandleFunc("GET /v2/instances/{id}/vpcs", s.listInstanceVPCs)
+	mux.HandleFunc("POST /v2/instances/{id}/vpcs/attach", s.attachInstanceVPC)
+	mux.HandleFunc("POST /v2/instances/{id}/vpcs/detach", s.detachInstanceVPC)
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.InstanceCreateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	switch {
+	case req.Region == "":
+		writeError(w, http.StatusBadRequest, "Invalid region.")
+		return
+	case req.Plan == "":
+		writeError(w, http.StatusBadRequest, "Invalid plan.")
+		return
+	case req.OsID == 0 && req.AppID == 0 && req.ISOID == "" && req.SnapshotID == "" && req.ImageID == "":
+		writeError(w, http.StatusBadRequest, "An os_id, app_id, iso_id, snapshot_id or image_id is required.")
+		return
+	}
+
+	if req.FirewallGroupID != "" {
+		if _, ok := s.firewallGroups.get(req.FirewallGroupID); !ok {
+			writeNotFound(w, "Firewall group")
+			return
+		}
+	}
+
+	if req.SnapshotID != "" {
+		if _, ok := s.snapshots.get(req.SnapshotID); !ok {
+			writeNotFound(w, "Snapshot")
+			return
+		}
+	}
+
+	if !s.validVPCs(w, req.AttachVPC) {
+		return
+	}
+
+	vcpus, ramGB := 1, 1
+	if m := planSizeRe.FindStringSubmatch(req.Plan); m != nil {
+		vcpus, _ = strconv.Atoi(m[1])
+		ramGB, _ = strconv.Atoi(m[2])
+	}
+
+	hostname := req.Hostname
+	if hostname == "" {
+		hostname = req.Label
+	}
+
+	n := s.next()
+	instance := &govultr.Instance{
+		ID:               newID(),
+		Os:               fmt.Sprintf("OS %d", req.OsID),
+		OsID:             req.OsID,
+		AppID:            req.AppID,
+		ImageID:          req.ImageID,
+		SnapshotID:       req.SnapshotID,
+		RAM:              ramGB * 1024, //nolint:mnd
+		Disk:             ramGB * 25,   //nolint:mnd
+		VCPUCount:        vcpus,
+		Plan:             req.Plan,
+		Region:           req.Region,
+		Label:            req.Label,
+		Hostname:         hostname,
+		Tags:             slices.Clone(req.Tags),
+		FirewallGroupID:  req.FirewallGroupID,
+		MainIP:           "0.0.0.0",
+		NetmaskV4:        "255.255.254.0",
+		GatewayV4:        "0.0.0.0",
+		DateCreated:      now(),
+		Status:           "pending",
+		PowerStatus:      "running",
+		ServerStatus:     "none",
+		AllowedBandwidth: 1000, //nolint:mnd
+		VPCOnly:          req.VPCOnly != nil && *req.VPCOnly,
+		UserScheme:       req.UserScheme,
+		Features:         []string{},
+	}
+	if instance.Tags == nil {
+		instance.Tags = []string{}
+	}
+
+	s.instances.add(instance.ID, instance)
+	s.updateFirewallInstanceCounts()
+	s.vpcAttachments[instance.ID] = slices.Clone(req.AttachVPC)
+
+	id := instance.ID
+	s.provision(func() {
+		if i, ok := s.instances.get(id); ok {
+			i.Status, i.ServerStatus = "active", "ok"
+			i.MainIP = fmt.Sprintf("192.0.2.%d", n%254+1) //nolint:mnd
+			i.GatewayV4 = "192.0.2.254"
+		}
+	})
+
+	created := *instance
+	created.DefaultPassword = "fake-password-" + strconv.Itoa(n)
+	writeJSON(w, http.StatusAccepted, map[string]any{"instance": created})
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	instances := s.instances.list(func(i *govultr.Instance) bool {
+		return (query.Get("label") == "" || i.Label == query.Get("label")) &&
+			(query.Get("region") == "" || i.Region == query.Get("region")) &&
+			(query.Get("main_ip") == "" || i.MainIP == query.Get("main_ip")) &&
+			(query.Get("tag") == "" || slices.Contains(i.Tags, query.Get("tag")))
+	})
+
+	page, meta := paginate(r, instances)
+	writeJSON(w, http.StatusOK, map[string]any{"instances": page, "meta": meta})
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.instances.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"instance": instance})
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.instances.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	req := &govultr.InstanceUpdateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.FirewallGroupID != "" {
+		if _, ok := s.firewallGroups.get(req.FirewallGroupID); !ok {
+			writeNotFound(w, "Firewall group")
+			return
+		}
+		instance.FirewallGroupID = req.FirewallGroupID
+		s.updateFirewallInstanceCounts()
+	}
+
+	if !s.validVPCs(w, req.AttachVPC) {
+		return
+	}
+
+	for _, vpcID := range req.AttachVPC {
+		if !slices.Contains(s.vpcAttachments[instance.ID], vpcID) {
+			s.vpcAttachments[instance.ID] = append(s.vpcAttachments[instance.ID], vpcID)
+		}
+	}
+	s.vpcAttachments[instance.ID] = slices.DeleteFunc(s.vpcAttachments[instance.ID], func(vpcID string) bool {
+		return slices.Contains(req.DetachVPC, vpcID)
+	})
+
+	if req.Plan != "" {
+		instance.Plan = req.Plan
+	}
+	if req.Label != "" {
+		instance.Label = req.Label
+	}
+	if req.Tags != nil {
+		instance.Tags = slices.Clone(req.Tags)
+	}
+	if req.UserScheme != "" {
+		instance.UserScheme = req.UserScheme
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]any{"instance": instance})
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.instances.remove(id) {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	for _, block := range s.blocks.items {
+		if block.AttachedToInstance == id {
+			detachBlock(block)
+		}
+	}
+
+	for _, ip := range s.reservedIPs.items {
+		if ip.InstanceID == id {
+			ip.InstanceID = ""
+		}
+	}
+
+	for _, lb := range s.loadBalancers.items {
+		lb.Instances = slices.DeleteFunc(lb.Instances, func(instanceID string) bool { return instanceID == id })
+	}
+
+	delete(s.vpcAttachments, id)
+
+	s.updateFirewallInstanceCounts()
+	writeNoContent(w)
+}
+
+func (s *Server) powerInstance(powerStatus govultr.PowerStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		instance, ok := s.instances.get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "Instance")
+			return
+		}
+
+		instance.PowerStatus = powerStatus
+		writeNoContent(w)
+	}
+}
+
+// validVPCs checks that every VPC in ids exists, writing a 404 if one doesn't
+func (s *Server) validVPCs(w http.ResponseWriter, ids []string) bool {
+	for _, id := range ids {
+		if _, ok := s.vpcs.get(id); !ok {
+			writeNotFound(w, "VPC")
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) listInstanceVPCs(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.instances.get(id); !ok {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	vpcs := make([]govultr.VPCInfo, 0, len(s.vpcAttachments[id]))
+	for i, vpcID := range s.vpcAttachments[id] {
+		vpcs = append(vpcs, govultr.VPCInfo{
+			ID:         vpcID,
+			MacAddress: fmt.Sprintf("5a:00:00:00:00:%02x", i),
+			IPAddress:  fmt.Sprintf("10.0.0.%d", i+2), //nolint:mnd
+		})
+	}
+
+	page, meta := paginate(r, vpcs)
+	writeJSON(w, http.StatusOK, map[string]any{"vpcs": page, "meta": meta})
+}
+
+// instanceVPCReq returns the instance and VPC of an attach or detach
+// request, writing an error if either doesn't exist
+func (s *Server) instanceVPCReq(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	id := r.PathValue("id")
+	if _, ok := s.instances.get(id); !ok {
+		writeNotFound(w, "Instance")
+		return "", "", false
+	}
+
+	req := &struct {
+		VPCID string `json:"vpc_id"`
+	}{}
+	if !decode(w, r, req) || !s.validVPCs(w, []string{req.VPCID}) {
+		return "", "", false
+	}
+
+	return id, req.VPCID, true
+}
+
+func (s *Server) attachInstanceVPC(w http.ResponseWriter, r *http.Request) {
+	id, vpcID, ok := s.instanceVPCReq(w, r)
+	if !ok {
+		return
+	}
+
+	if slices.Contains(s.vpcAttachments[id], vpcID) {
+		writeError(w, http.StatusBadRequest, "VPC is already attached.")
+		return
+	}
+
+	s.vpcAttachments[id] = append(s.vpcAttachments[id], vpcID)
+	writeNoContent(w)
+}
+
+func (s *Server) detachInstanceVPC(w http.ResponseWriter, r *http.Request) {
+	id, vpcID, ok := s.instanceVPCReq(w, r)
+	if !ok {
+		return
+	}
+
+	i := slices.Index(s.vpcAttachments[id], vpcID)
+	if i < 0 {
+		writeError(w, http.StatusBadRequest, "VPC is not attached.")
+		return
+	}
+
+	s.vpcAttachments[id] = slices.Delete(s.vpcAttachments[id], i, i+1)
+	writeNoContent(w)
+}
@@ -0,0 +1,324 @@
+package govultrtest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Kubernetes versions the fake API offers
+var kubernetesVersions = []string{"v1.33.0+1", "v1.32.4+1", "v1.31.8+1"}
+
+func (s *Server) registerKubernetes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v2/kubernetes/versions", s.listKubernetesVersions)
+
+	mux.HandleFunc("POST /v2/kubernetes/clusters", s.createCluster)
+	mux.HandleFunc("GET /v2/kubernetes/clusters", s.listClusters)
+	mux.HandleFunc("GET /v2/kubernetes/clusters/{id}", s.getCluster)
+	mux.HandleFunc("PUT /v2/kubernetes/clusters/{id}", s.updateCluster)
+	mux.HandleFunc("DELETE /v2/kubernetes/clusters/{id}", s.deleteCluster)
+	mux.HandleFunc("DELETE /v2/kubernetes/clusters/{id}/delete-with-linked-resources", s.deleteCluster)
+	mux.HandleFunc("GET /v2/kubernetes/clusters/{id}/config", s.getKubeConfig)
+
+	mux.HandleFunc("POST /v2/kubernetes/clusters/{id}/node-pools", s.createNodePool)
+	mux.HandleFunc("GET /v2/kubernetes/clusters/{id}/node-pools", s.listNodePools)
+	mux.HandleFunc("GET /v2/kubernetes/clusters/{id}/node-pools/{pool}", s.getNodePool)
+	mux.HandleFunc("PATCH /v2/kubernetes/clusters/{id}/node-pools/{pool}", s.updateNodePool)
+	mux.HandleFunc("DELETE /v2/kubernetes/clusters/{id}/node-pools/{pool}", s.deleteNodePool)
+}
+
+func (s *Server) listKubernetesVersions(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, govultr.Versions{Versions: kubernetesVersions})
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.ClusterReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	switch {
+	case req.Region == "":
+		writeError(w, http.StatusBadRequest, "Invalid region.")
+		return
+	case !slices.Contains(kubernetesVersions, req.Version):
+		writeError(w, http.StatusBadRequest, "Invalid version.")
+		return
+	case len(req.NodePools) == 0:
+		writeError(w, http.StatusBadRequest, "At least one node pool is required.")
+		return
+	}
+
+	n := s.next()
+	cluster := &govultr.Cluster{
+		ID:              newID(),
+		Label:           req.Label,
+		DateCreated:     now(),
+		ClusterSubnet:   "10.244.0.0/16",
+		ServiceSubnet:   "10.96.0.0/12",
+		Version:         req.Version,
+		Region:          req.Region,
+		Status:          "pending",
+		HAControlPlanes: req.HAControlPlanes,
+		NodePools:       []govultr.NodePool{},
+	}
+	if req.OIDCConfig != nil {
+		cluster.OIDCConfig = *req.OIDCConfig
+	}
+
+	for i := range req.NodePools {
+		cluster.NodePools = append(cluster.NodePools, s.newNodePool(cluster.ID, &req.NodePools[i]))
+	}
+
+	s.clusters.add(cluster.ID, cluster)
+
+	id := cluster.ID
+	s.provision(func() {
+		if c, ok := s.clusters.get(id); ok {
+			c.Status = "active"
+			c.IP = fmt.Sprintf("203.0.113.%d", n%254+1) //nolint:mnd
+			c.Endpoint = c.ID + ".vultr-k8s.com"
+		}
+	})
+
+	writeJSON(w, http.StatusCreated, map[string]any{"vke_cluster": cluster})
+}
+
+// newNodePool returns a pending node pool with pending nodes
+func (s *Server) newNodePool(clusterID string, req *govultr.NodePoolReq) govultr.NodePool {
+	created := now()
+	pool := govultr.NodePool{
+		ID:          newID(),
+		DateCreated: created,
+		DateUpdated: created,
+		Label:       req.Label,
+		Plan:        req.Plan,
+		Status:      "pending",
+		MinNodes:    req.MinNodes,
+		MaxNodes:    req.MaxNodes,
+		AutoScaler:  req.AutoScaler != nil && *req.AutoScaler,
+		UserData:    req.UserData,
+		Tag:         req.Tag,
+		Labels:      req.Labels,
+		Taints:      slices.Clone(req.Taints),
+		Nodes:       []govultr.Node{},
+	}
+
+	s.resizeNodePool(clusterID, &pool, req.NodeQuantity)
+
+	return pool
+}
+
+// resizeNodePool adds or removes nodes until the pool has quantity of them.
+// New nodes are pending until they finish provisioning.
+func (s *Server) resizeNodePool(clusterID string, pool *govultr.NodePool, quantity int) {
+	if quantity < len(pool.Nodes) {
+		pool.Nodes = pool.Nodes[:quantity]
+	}
+
+	for len(pool.Nodes) < quantity {
+		pool.Nodes = append(pool.Nodes, govultr.Node{
+			ID:          newID(),
+			DateCreated: now(),
+			Label:       fmt.Sprintf("%s-%d", pool.Label, s.next()),
+			Status:      "pending",
+		})
+	}
+
+	pool.NodeQuantity = quantity
+	pool.Status = "pending"
+	pool.DateUpdated = now()
+
+	poolID := pool.ID
+	s.provision(func() {
+		c, ok := s.clusters.get(clusterID)
+		if !ok {
+			return
+		}
+
+		for i := range c.NodePools {
+			if c.NodePools[i].ID != poolID {
+				continue
+			}
+
+			c.NodePools[i].Status = "active"
+			for j := range c.NodePools[i].Nodes {
+				c.NodePools[i].Nodes[j].Status = "active"
+			}
+		}
+	})
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.clusters.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"vke_clusters": page, "meta": meta})
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Cluster")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"vke_cluster": cluster})
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Cluster")
+		return
+	}
+
+	req := &govultr.ClusterReqUpdate{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Label != "" {
+		cluster.Label = req.Label
+	}
+	if req.OIDCConfig != nil {
+		cluster.OIDCConfig = *req.OIDCConfig
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	if !s.clusters.remove(r.PathValue("id")) {
+		writeNotFound(w, "Cluster")
+		return
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) getKubeConfig(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Cluster")
+		return
+	}
+
+	config := fmt.Sprintf("apiVersion: v1\nkind: Config\nclusters:\n- name: %s\n  cluster:\n    server: https://%s:6443\n",
+		cluster.ID, cluster.Endpoint)
+
+	writeJSON(w, http.StatusOK, govultr.KubeConfig{KubeConfig: base64.StdEncoding.EncodeToString([]byte(config))})
+}
+
+func (s *Server) createNodePool(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Cluster")
+		return
+	}
+
+	req := &govultr.NodePoolReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Plan == "" || req.NodeQuantity <= 0 {
+		writeError(w, http.StatusBadRequest, "A plan and node_quantity are required.")
+		return
+	}
+
+	cluster.NodePools = append(cluster.NodePools, s.newNodePool(cluster.ID, req))
+
+	writeJSON(w, http.StatusCreated, map[string]any{"node_pool": cluster.NodePools[len(cluster.NodePools)-1]})
+}
+
+func (s *Server) listNodePools(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Cluster")
+		return
+	}
+
+	page, meta := paginate(r, cluster.NodePools)
+	writeJSON(w, http.StatusOK, map[string]any{"node_pools": page, "meta": meta})
+}
+
+// pathNodePool returns the cluster and the index of the node pool in the
+// path, writing a 404 if either doesn't exist
+func (s *Server) pathNodePool(w http.ResponseWriter, r *http.Request) (*govultr.Cluster, int, bool) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Cluster")
+		return nil, 0, false
+	}
+
+	i := slices.IndexFunc(cluster.NodePools, func(pool govultr.NodePool) bool {
+		return pool.ID == r.PathValue("pool")
+	})
+	if i < 0 {
+		writeNotFound(w, "Node pool")
+		return nil, 0, false
+	}
+
+	return cluster, i, true
+}
+
+func (s *Server) getNodePool(w http.ResponseWriter, r *http.Request) {
+	cluster, i, ok := s.pathNodePool(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"node_pool": cluster.NodePools[i]})
+}
+
+func (s *Server) updateNodePool(w http.ResponseWriter, r *http.Request) {
+	cluster, i, ok := s.pathNodePool(w, r)
+	if !ok {
+		return
+	}
+
+	req := &govultr.NodePoolReqUpdate{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	pool := &cluster.NodePools[i]
+	if req.NodeQuantity > 0 && req.NodeQuantity != pool.NodeQuantity {
+		s.resizeNodePool(cluster.ID, pool, req.NodeQuantity)
+	}
+	if req.Tag != nil {
+		pool.Tag = *req.Tag
+	}
+	if req.MinNodes != 0 {
+		pool.MinNodes = req.MinNodes
+	}
+	if req.MaxNodes != 0 {
+		pool.MaxNodes = req.MaxNodes
+	}
+	if req.AutoScaler != nil {
+		pool.AutoScaler = *req.AutoScaler
+	}
+	if req.Labels != nil {
+		pool.Labels = req.Labels
+	}
+	if req.Taints != nil {
+		pool.Taints = slices.Clone(req.Taints)
+	}
+	if req.UserData != nil {
+		pool.UserData = *req.UserData
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]any{"node_pool": pool})
+}
+
+func (s *Server) deleteNodePool(w http.ResponseWriter, r *http.Request) {
+	cluster, i, ok := s.pathNodePool(w, r)
+	if !ok {
+		return
+	}
+
+	cluster.NodePools = slices.Delete(cluster.NodePools, i, i+1)
+	writeNoContent(w)
+}
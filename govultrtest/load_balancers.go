@@ -0,0 +1,160 @@
+package govultrtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerLoadBalancers(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/load-balancers", s.createLoadBalancer)
+	mux.HandleFunc("GET /v2/load-balancers", s.listLoadBalancers)
+	mux.HandleFunc("GET /v2/load-balancers/{id}", s.getLoadBalancer)
+	mux.HandleFunc("PATCH /v2/load-balancers/{id}", s.updateLoadBalancer)
+	mux.HandleFunc("DELETE /v2/load-balancers/{id}", s.deleteLoadBalancer)
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.LoadBalancerReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Region == "" {
+		writeError(w, http.StatusBadRequest, "Invalid region.")
+		return
+	}
+
+	if !s.validLoadBalancerReq(w, req) {
+		return
+	}
+
+	n := s.next()
+	lb := &govultr.LoadBalancer{
+		ID:          newID(),
+		DateCreated: now(),
+		Region:      req.Region,
+		Label:       req.Label,
+		Status:      "pending",
+		Instances:   []string{},
+		Nodes:       max(req.Nodes, 1),
+		HealthCheck: &govultr.HealthCheck{Protocol: "http", Port: 80, Path: "/", CheckInterval: 15, ResponseTimeout: 5}, //nolint:mnd
+		GenericInfo: &govultr.GenericInfo{BalancingAlgorithm: "roundrobin"},
+	}
+	s.applyLoadBalancerReq(lb, req)
+
+	s.loadBalancers.add(lb.ID, lb)
+
+	id := lb.ID
+	s.provision(func() {
+		if l, ok := s.loadBalancers.get(id); ok {
+			l.Status = "active"
+			l.IPV4 = fmt.Sprintf("198.18.0.%d", n%254+1) //nolint:mnd
+		}
+	})
+
+	writeJSON(w, http.StatusAccepted, map[string]any{"load_balancer": lb})
+}
+
+// validLoadBalancerReq checks the instances and VPC of a request exist,
+// writing a 404 if they don't
+func (s *Server) validLoadBalancerReq(w http.ResponseWriter, req *govultr.LoadBalancerReq) bool {
+	for _, id := range req.Instances {
+		if _, ok := s.instances.get(id); !ok {
+			writeNotFound(w, "Instance")
+			return false
+		}
+	}
+
+	if req.VPC != nil && *req.VPC != "" {
+		if _, ok := s.vpcs.get(*req.VPC); !ok {
+			writeNotFound(w, "VPC")
+			return false
+		}
+	}
+
+	return true
+}
+
+// applyLoadBalancerReq copies the fields set in req to lb
+func (s *Server) applyLoadBalancerReq(lb *govultr.LoadBalancer, req *govultr.LoadBalancerReq) {
+	if req.Label != "" {
+		lb.Label = req.Label
+	}
+	if req.Instances != nil {
+		lb.Instances = slices.Clone(req.Instances)
+	}
+	if req.HealthCheck != nil {
+		check := *req.HealthCheck
+		lb.HealthCheck = &check
+	}
+	if req.BalancingAlgorithm != "" {
+		lb.GenericInfo.BalancingAlgorithm = req.BalancingAlgorithm
+	}
+	if req.VPC != nil {
+		lb.GenericInfo.VPC = *req.VPC
+	}
+
+	if req.ForwardingRules != nil {
+		lb.ForwardingRules = make([]govultr.ForwardingRule, len(req.ForwardingRules))
+		for i, rule := range req.ForwardingRules {
+			rule.RuleID = strconv.Itoa(s.next())
+			lb.ForwardingRules[i] = rule
+		}
+	}
+
+	if req.FirewallRules != nil {
+		lb.FirewallRules = make([]govultr.LBFirewallRule, len(req.FirewallRules))
+		for i, rule := range req.FirewallRules {
+			rule.RuleID = strconv.Itoa(s.next())
+			lb.FirewallRules[i] = rule
+		}
+	}
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.loadBalancers.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"load_balancers": page, "meta": meta})
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	lb, ok := s.loadBalancers.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Load balancer")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"load_balancer": lb})
+}
+
+func (s *Server) updateLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	lb, ok := s.loadBalancers.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Load balancer")
+		return
+	}
+
+	req := &govultr.LoadBalancerReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if !s.validLoadBalancerReq(w, req) {
+		return
+	}
+
+	s.applyLoadBalancerReq(lb, req)
+	writeNoContent(w)
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	if !s.loadBalancers.remove(r.PathValue("id")) {
+		writeNotFound(w, "Load balancer")
+		return
+	}
+
+	writeNoContent(w)
+}
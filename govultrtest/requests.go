@@ -0,0 +1,189 @@
+package govultrtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Request is a request received by the fake API
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+
+	// Pattern is the route that handled the request, such as
+	// "GET /v2/instances/{id}"
+	Pattern string
+}
+
+// Decode unmarshals the JSON body of the request into v
+func (r Request) Decode(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Fault makes the fake API misbehave for matching requests
+type Fault struct {
+	// Method of the requests to affect, all methods when empty
+	Method string
+
+	// Path prefix of the requests to affect, such as "/v2/instances", all
+	// paths when empty
+	Path string
+
+	// Latency is added before the request is handled
+	Latency time.Duration
+
+	// Status is returned instead of handling the request, such as 429 or
+	// 503. Zero handles the request normally after the latency.
+	Status int
+
+	// RetryAfter is sent in the Retry-After header of the fault's response
+	RetryAfter time.Duration
+
+	// Times is how many requests are affected before the fault is removed,
+	// zero for every request
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter.Round(time.Second)/time.Second)))
+	}
+
+	writeError(w, f.Status, http.StatusText(f.Status))
+}
+
+// InjectFault adds a fault. When several faults match a request the one
+// injected first is used.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// record saves the request and returns the fault to apply to it, if any
+func (s *Server) record(r *http.Request, pattern string, body []byte) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method:  r.Method,
+		Path:    r.URL.Path,
+		Query:   r.URL.Query(),
+		Header:  r.Header.Clone(),
+		Body:    body,
+		Pattern: pattern,
+	})
+
+	for i, fault := range s.faults {
+		if !fault.matches(r) {
+			continue
+		}
+
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+
+		return fault
+	}
+
+	return nil
+}
+
+// Requests returns every request received so far, oldest first
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestsTo returns the requests received for the method and path
+func (s *Server) RequestsTo(method, path string) []Request {
+	var matched []Request
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == path {
+			matched = append(matched, r)
+		}
+	}
+
+	return matched
+}
+
+// ResetRequests forgets the requests received so far
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = nil
+}
+
+// AssertRequested fails the test unless the method and path were requested,
+// and returns the last such request
+func (s *Server) AssertRequested(t testing.TB, method, path string) Request {
+	t.Helper()
+
+	requests := s.RequestsTo(method, path)
+	if len(requests) == 0 {
+		t.Errorf("expected a %s %s request, received %s", method, path, s.summary())
+		return Request{}
+	}
+
+	return requests[len(requests)-1]
+}
+
+// AssertNotRequested fails the test if the method and path were requested
+func (s *Server) AssertNotRequested(t testing.TB, method, path string) {
+	t.Helper()
+
+	if n := len(s.RequestsTo(method, path)); n > 0 {
+		t.Errorf("expected no %s %s request, received %d", method, path, n)
+	}
+}
+
+// AssertRequestCount fails the test unless the method and path were
+// requested exactly n times
+func (s *Server) AssertRequestCount(t testing.TB, method, path string, n int) {
+	t.Helper()
+
+	if got := len(s.RequestsTo(method, path)); got != n {
+		t.Errorf("expected %d %s %s requests, received %d", n, method, path, got)
+	}
+}
+
+func (s *Server) summary() string {
+	requests := s.Requests()
+	if len(requests) == 0 {
+		return "none"
+	}
+
+	lines := make([]string, len(requests))
+	for i, r := range requests {
+		lines[i] = r.Method + " " + r.Path
+	}
+
+	return strings.Join(lines, ", ")
+}
@@ -0,0 +1,138 @@
+package govultrtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerReservedIPs(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/reserved-ips", s.createReservedIP)
+	mux.HandleFunc("GET /v2/reserved-ips", s.listReservedIPs)
+	mux.HandleFunc("GET /v2/reserved-ips/{id}", s.getReservedIP)
+	mux.HandleFunc("PATCH /v2/reserved-ips/{id}", s.updateReservedIP)
+	mux.HandleFunc("DELETE /v2/reserved-ips/{id}", s.deleteReservedIP)
+	mux.HandleFunc("POST /v2/reserved-ips/{id}/attach", s.attachReservedIP)
+	mux.HandleFunc("POST /v2/reserved-ips/{id}/detach", s.detachReservedIP)
+}
+
+func (s *Server) createReservedIP(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.ReservedIPReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Region == "" {
+		writeError(w, http.StatusBadRequest, "Invalid region.")
+		return
+	}
+
+	ip := &govultr.ReservedIP{
+		ID:     newID(),
+		Region: req.Region,
+		IPType: req.IPType,
+		Label:  req.Label,
+	}
+
+	switch req.IPType {
+	case "v4":
+		ip.Subnet, ip.SubnetSize = fmt.Sprintf("198.51.100.%d", s.next()%254+1), 32 //nolint:mnd
+	case "v6":
+		ip.Subnet, ip.SubnetSize = fmt.Sprintf("2001:db8:%x::", s.next()), 64 //nolint:mnd
+	default:
+		writeError(w, http.StatusBadRequest, "Invalid ip_type, must be v4 or v6.")
+		return
+	}
+
+	s.reservedIPs.add(ip.ID, ip)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"reserved_ip": ip})
+}
+
+func (s *Server) listReservedIPs(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.reservedIPs.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"reserved_ips": page, "meta": meta})
+}
+
+func (s *Server) getReservedIP(w http.ResponseWriter, r *http.Request) {
+	ip, ok := s.reservedIPs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Reserved IP")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"reserved_ip": ip})
+}
+
+func (s *Server) updateReservedIP(w http.ResponseWriter, r *http.Request) {
+	ip, ok := s.reservedIPs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Reserved IP")
+		return
+	}
+
+	req := &govultr.ReservedIPUpdateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Label != nil {
+		ip.Label = *req.Label
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]any{"reserved_ip": ip})
+}
+
+func (s *Server) deleteReservedIP(w http.ResponseWriter, r *http.Request) {
+	if !s.reservedIPs.remove(r.PathValue("id")) {
+		writeNotFound(w, "Reserved IP")
+		return
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) attachReservedIP(w http.ResponseWriter, r *http.Request) {
+	ip, ok := s.reservedIPs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Reserved IP")
+		return
+	}
+
+	var req struct {
+		InstanceID string `json:"instance_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if _, ok := s.instances.get(req.InstanceID); !ok {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	if ip.InstanceID != "" {
+		writeError(w, http.StatusBadRequest, "Reserved IP is already attached to an instance.")
+		return
+	}
+
+	ip.InstanceID = req.InstanceID
+	writeNoContent(w)
+}
+
+func (s *Server) detachReservedIP(w http.ResponseWriter, r *http.Request) {
+	ip, ok := s.reservedIPs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Reserved IP")
+		return
+	}
+
+	if ip.InstanceID == "" {
+		writeError(w, http.StatusBadRequest, "Reserved IP is not attached to an instance.")
+		return
+	}
+
+	ip.InstanceID = ""
+	writeNoContent(w)
+}
@@ -0,0 +1,270 @@
+// Package govultrtest provides an in-memory fake of the Vultr API for tests.
+//
+// A Server keeps state between requests, so resources created through it can
+// be fetched, listed, updated and deleted like on the real API. Instances,
+// block storage, domains and records, firewall groups and rules, VPCs,
+// reserved IPs, SSH keys, startup scripts, snapshots, Kubernetes clusters and
+// load balancers are supported. Faults such as latency, 429s and 5xx responses can be
+// injected, and every request received is recorded for assertions.
+//
+//	srv := govultrtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{...})
+package govultrtest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Layout of the dates returned by the fake API, the same as the Vultr API
+const dateLayout = "2006-01-02T15:04:05-07:00"
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithProvisioningDelay sets how long new resources stay pending before they
+// become active. By default they are pending in the create response and
+// active from the next request on.
+func WithProvisioningDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.delay = delay
+	}
+}
+
+// WithAPIKey makes the server reject requests without the API key as a
+// bearer token with a 401
+func WithAPIKey(apiKey string) Option {
+	return func(s *Server) {
+		s.apiKey = apiKey
+	}
+}
+
+// Server is a stateful fake of the Vultr API running on a local HTTP server
+type Server struct {
+	server *httptest.Server
+	delay  time.Duration
+	apiKey string
+
+	mu       sync.Mutex
+	requests []Request
+	faults   []*Fault
+	pending  []pendingChange
+	seq      int
+
+	instances      *collection[govultr.Instance]
+	blocks         *collection[govultr.BlockStorage]
+	domains        *collection[domain]
+	firewallGroups *collection[firewallGroup]
+	vpcs           *collection[govultr.VPC]
+	reservedIPs    *collection[govultr.ReservedIP]
+	sshKeys        *collection[govultr.SSHKey]
+	scripts        *collection[govultr.StartupScript]
+	snapshots      *collection[govultr.Snapshot]
+	clusters       *collection[govultr.Cluster]
+	loadBalancers  *collection[govultr.LoadBalancer]
+
+	// IDs of the VPCs attached to each instance
+	vpcAttachments map[string][]string
+}
+
+// pendingChange is applied once a resource has finished provisioning
+type pendingChange struct {
+	at    time.Time
+	apply func()
+}
+
+// NewServer starts a fake Vultr API. Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		instances:      newCollection[govultr.Instance](),
+		blocks:         newCollection[govultr.BlockStorage](),
+		domains:        newCollection[domain](),
+		firewallGroups: newCollection[firewallGroup](),
+		vpcs:           newCollection[govultr.VPC](),
+		reservedIPs:    newCollection[govultr.ReservedIP](),
+		sshKeys:        newCollection[govultr.SSHKey](),
+		scripts:        newCollection[govultr.StartupScript](),
+		snapshots:      newCollection[govultr.Snapshot](),
+		clusters:       newCollection[govultr.Cluster](),
+		loadBalancers:  newCollection[govultr.LoadBalancer](),
+		vpcAttachments: map[string][]string{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	s.registerInstances(mux)
+	s.registerBlockStorage(mux)
+	s.registerDomains(mux)
+	s.registerFirewalls(mux)
+	s.registerVPCs(mux)
+	s.registerReservedIPs(mux)
+	s.registerSSHKeys(mux)
+	s.registerStartupScripts(mux)
+	s.registerSnapshots(mux)
+	s.registerKubernetes(mux)
+	s.registerLoadBalancers(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Invalid API endpoint")
+	})
+
+	s.server = httptest.NewServer(s.handler(mux))
+
+	return s
+}
+
+// URL returns the base URL of the fake API
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a govultr client that talks to the fake API. It retries
+// quickly so injected faults don't slow tests down. Options are applied last.
+func (s *Server) Client(opts ...govultr.ClientOption) *govultr.Client {
+	base := []govultr.ClientOption{
+		govultr.WithBaseURL(s.URL()),
+		govultr.WithRetryWait(time.Millisecond, 10*time.Millisecond),
+	}
+	if s.apiKey != "" {
+		base = append(base, govultr.WithAPIKey(s.apiKey))
+	}
+
+	client, err := govultr.NewClientWithOptions(append(base, opts...)...)
+	if err != nil {
+		panic(fmt.Sprintf("govultrtest: %v", err))
+	}
+
+	return client
+}
+
+// handler records requests, injects faults and checks the API key before a
+// request reaches the routes
+func (s *Server) handler(mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		_, pattern := mux.Handler(r)
+		fault := s.record(r, pattern, body)
+
+		if fault != nil {
+			if fault.Latency > 0 {
+				select {
+				case <-time.After(fault.Latency):
+				case <-r.Context().Done():
+					return
+				}
+			}
+
+			if fault.Status != 0 {
+				fault.write(w)
+				return
+			}
+		}
+
+		if s.apiKey != "" && r.Header.Get("Authorization") != "Bearer "+s.apiKey {
+			writeError(w, http.StatusUnauthorized, "Invalid API token.")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.settle()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// settle applies the changes of resources that finished provisioning
+func (s *Server) settle() {
+	now := time.Now()
+
+	remaining := s.pending[:0]
+	for _, change := range s.pending {
+		if now.Before(change.at) {
+			remaining = append(remaining, change)
+			continue
+		}
+		change.apply()
+	}
+
+	s.pending = remaining
+}
+
+// provision schedules apply to run once a new resource finishes provisioning
+func (s *Server) provision(apply func()) {
+	s.pending = append(s.pending, pendingChange{at: time.Now().Add(s.delay), apply: apply})
+}
+
+// newID returns a random UUID like the ones the API uses
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+
+	b[6] = (b[6] & 0x0f) | 0x40 //nolint:mnd
+	b[8] = (b[8] & 0x3f) | 0x80 //nolint:mnd
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// next returns a server wide sequence number, used for IPs and similar values
+func (s *Server) next() int {
+	s.seq++
+	return s.seq
+}
+
+func now() govultr.Timestamp {
+	return govultr.Timestamp(time.Now().UTC().Format(dateLayout))
+}
+
+// decode reads a JSON request body, writing a 400 if it is invalid
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "Invalid JSON: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format the Vultr API uses
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{"error": message, "status": status})
+}
+
+func writeNotFound(w http.ResponseWriter, kind string) {
+	writeError(w, http.StatusNotFound, kind+" not found.")
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
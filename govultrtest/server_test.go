@@ -0,0 +1,510 @@
+package govultrtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+var fastWait = &govultr.WaitOptions{PollInterval: time.Millisecond, Timeout: time.Second}
+
+func TestServer_Instances(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr",
+		Plan:   "vc2-2c-4gb",
+		OsID:   2284,
+		Label:  "web",
+		Tags:   []string{"prod"},
+	})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if instance.Status != "pending" || instance.DefaultPassword == "" || instance.VCPUCount != 2 || instance.RAM != 4096 {
+		t.Errorf("Instance.Create returned %+v, expected a pending 2 CPU 4GB instance with a password", instance)
+	}
+
+	active, err := client.WaitForInstanceActive(ctx, instance.ID, fastWait)
+	if err != nil {
+		t.Fatalf("WaitForInstanceActive returned %+v", err)
+	}
+
+	if active.MainIP == "0.0.0.0" || active.DefaultPassword != "" {
+		t.Errorf("Instance.Get returned %+v, expected an IP and no password", active)
+	}
+
+	if _, _, err := client.Instance.Update(ctx, instance.ID, &govultr.InstanceUpdateReq{Label: "api"}); err != nil {
+		t.Fatalf("Instance.Update returned %+v", err)
+	}
+
+	instances, meta, _, err := client.Instance.List(ctx, &govultr.ListOptions{Tag: "prod"})
+	if err != nil {
+		t.Fatalf("Instance.List returned %+v", err)
+	}
+
+	if len(instances) != 1 || instances[0].Label != "api" || meta.Total != 1 {
+		t.Errorf("Instance.List returned %+v, expected the relabeled instance", instances)
+	}
+
+	if err := client.Instance.Halt(ctx, instance.ID); err != nil {
+		t.Fatalf("Instance.Halt returned %+v", err)
+	}
+
+	if err := client.Instance.Delete(ctx, instance.ID); err != nil {
+		t.Fatalf("Instance.Delete returned %+v", err)
+	}
+
+	if _, _, err := client.Instance.Get(ctx, instance.ID); !govultr.IsNotFound(err) {
+		t.Errorf("Instance.Get returned %+v, expected not found", err)
+	}
+
+	req := srv.AssertRequested(t, http.MethodPost, "/v2/instances")
+	var body govultr.InstanceCreateReq
+	if err := req.Decode(&body); err != nil || body.Label != "web" {
+		t.Errorf("Request body = %s, expected the create request", req.Body)
+	}
+
+	if req.Pattern != "POST /v2/instances" {
+		t.Errorf("Request pattern = %q, expected %q", req.Pattern, "POST /v2/instances")
+	}
+
+	srv.AssertRequestCount(t, http.MethodDelete, "/v2/instances/"+instance.ID, 1)
+	srv.AssertNotRequested(t, http.MethodPost, "/v2/instances/"+instance.ID+"/reboot")
+}
+
+func TestServer_InstanceValidation(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, _, err := srv.Client().Instance.Create(context.Background(), &govultr.InstanceCreateReq{Plan: "vc2-1c-1gb", OsID: 2284})
+
+	var apiErr *govultr.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "Invalid region." {
+		t.Errorf("Instance.Create returned %+v, expected an invalid region error", err)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if _, _, err := client.SSHKey.Create(ctx, &govultr.SSHKeyReq{Name: name, SSHKey: "ssh-ed25519 AAAA"}); err != nil {
+			t.Fatalf("SSHKey.Create returned %+v", err)
+		}
+	}
+
+	keys, err := govultr.CollectAll(ctx, &govultr.ListOptions{PerPage: 2}, client.SSHKey.List)
+	if err != nil {
+		t.Fatalf("CollectAll returned %+v", err)
+	}
+
+	if len(keys) != 5 || keys[0].Name != "a" || keys[4].Name != "e" {
+		t.Errorf("CollectAll returned %+v, expected the 5 keys in order", keys)
+	}
+
+	srv.AssertRequestCount(t, http.MethodGet, "/v2/ssh-keys", 3)
+}
+
+func TestServer_Faults(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	srv.InjectFault(Fault{Method: http.MethodGet, Path: "/v2/vpcs", Status: http.StatusTooManyRequests, Times: 2})
+
+	if _, _, _, err := client.VPC.List(ctx, nil); err != nil {
+		t.Fatalf("VPC.List returned %+v, expected it to succeed after the retries", err)
+	}
+
+	srv.AssertRequestCount(t, http.MethodGet, "/v2/vpcs", 3)
+
+	srv.InjectFault(Fault{Method: http.MethodPost, Path: "/v2/vpcs", Status: http.StatusServiceUnavailable})
+
+	if _, _, err := client.VPC.Create(ctx, &govultr.VPCReq{Region: "ewr"}); !govultr.IsServerError(err) {
+		t.Errorf("VPC.Create returned %+v, expected a server error", err)
+	}
+
+	srv.ClearFaults()
+	srv.InjectFault(Fault{Path: "/v2/ssh-keys", Latency: time.Second})
+
+	timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	if _, _, _, err := client.SSHKey.List(timeout, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("SSHKey.List returned %+v, expected the deadline to be exceeded", err)
+	}
+}
+
+func TestServer_APIKey(t *testing.T) {
+	srv := NewServer(WithAPIKey("secret"))
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	if _, _, _, err := srv.Client().SSHKey.List(ctx, nil); err != nil {
+		t.Errorf("SSHKey.List returned %+v", err)
+	}
+
+	unauthenticated, err := govultr.NewClientWithOptions(govultr.WithBaseURL(srv.URL()))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	if _, _, _, err := unauthenticated.SSHKey.List(ctx, nil); !govultr.IsUnauthorized(err) {
+		t.Errorf("SSHKey.List returned %+v, expected unauthorized", err)
+	}
+}
+
+func TestServer_ProvisioningDelay(t *testing.T) {
+	srv := NewServer(WithProvisioningDelay(time.Hour))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	block, _, err := client.BlockStorage.Create(ctx, &govultr.BlockStorageCreate{Region: "ewr", SizeGB: 10})
+	if err != nil {
+		t.Fatalf("BlockStorage.Create returned %+v", err)
+	}
+
+	got, _, err := client.BlockStorage.Get(ctx, block.ID)
+	if err != nil || got.Status != "pending" {
+		t.Errorf("BlockStorage.Get returned %+v, %+v, expected a pending block", got, err)
+	}
+}
+
+func TestServer_BlockStorage(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284, Label: "db"})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	block, _, err := client.BlockStorage.Create(ctx, &govultr.BlockStorageCreate{Region: "ewr", SizeGB: 10, Label: "data"})
+	if err != nil {
+		t.Fatalf("BlockStorage.Create returned %+v", err)
+	}
+
+	if _, err := client.WaitForBlockStorageActive(ctx, block.ID, fastWait); err != nil {
+		t.Fatalf("WaitForBlockStorageActive returned %+v", err)
+	}
+
+	if err := client.BlockStorage.Attach(ctx, block.ID, &govultr.BlockStorageAttach{InstanceID: instance.ID}); err != nil {
+		t.Fatalf("BlockStorage.Attach returned %+v", err)
+	}
+
+	if err := client.BlockStorage.Update(ctx, block.ID, &govultr.BlockStorageUpdate{SizeGB: 5}); !govultr.IsBadRequest(err) {
+		t.Errorf("BlockStorage.Update returned %+v, expected shrinking to fail", err)
+	}
+
+	if err := client.Instance.Delete(ctx, instance.ID); err != nil {
+		t.Fatalf("Instance.Delete returned %+v", err)
+	}
+
+	got, _, err := client.BlockStorage.Get(ctx, block.ID)
+	if err != nil || got.AttachedToInstance != "" || got.Status != "active" {
+		t.Errorf("BlockStorage.Get returned %+v, %+v, expected an active detached block", got, err)
+	}
+}
+
+func TestServer_Domains(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	if _, _, err := client.Domain.Create(ctx, &govultr.DomainReq{Domain: "example.com", IP: "192.0.2.1"}); err != nil {
+		t.Fatalf("Domain.Create returned %+v", err)
+	}
+
+	if _, _, err := client.Domain.Create(ctx, &govultr.DomainReq{Domain: "example.com"}); !govultr.IsBadRequest(err) {
+		t.Errorf("Domain.Create returned %+v, expected a duplicate to fail", err)
+	}
+
+	record, _, err := client.DomainRecord.Create(ctx, "example.com", &govultr.DomainRecordCreateReq{Name: "www", Type: "CNAME", Data: "example.com"})
+	if err != nil {
+		t.Fatalf("DomainRecord.Create returned %+v", err)
+	}
+
+	if err := client.DomainRecord.Update(ctx, "example.com", record.ID, &govultr.DomainRecordUpdateReq{TTL: 60}); err != nil {
+		t.Fatalf("DomainRecord.Update returned %+v", err)
+	}
+
+	records, _, _, err := client.DomainRecord.List(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatalf("DomainRecord.List returned %+v", err)
+	}
+
+	if len(records) != 4 || records[3].TTL != 60 || records[2].Data != "192.0.2.1" {
+		t.Errorf("DomainRecord.List returned %+v, expected the NS, A and updated CNAME records", records)
+	}
+
+	if err := client.Domain.Delete(ctx, "example.com"); err != nil {
+		t.Fatalf("Domain.Delete returned %+v", err)
+	}
+
+	if _, _, _, err := client.DomainRecord.List(ctx, "example.com", nil); !govultr.IsNotFound(err) {
+		t.Errorf("DomainRecord.List returned %+v, expected not found", err)
+	}
+}
+
+func TestServer_Firewalls(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	group, _, err := client.FirewallGroup.Create(ctx, &govultr.FirewallGroupReq{Description: "web"})
+	if err != nil {
+		t.Fatalf("FirewallGroup.Create returned %+v", err)
+	}
+
+	rule, _, err := client.FirewallRule.Create(ctx, group.ID, &govultr.FirewallRuleReq{
+		IPType: "v4", Protocol: "tcp", Subnet: "0.0.0.0", SubnetSize: 0, Port: "443",
+	})
+	if err != nil {
+		t.Fatalf("FirewallRule.Create returned %+v", err)
+	}
+
+	if _, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284, FirewallGroupID: group.ID,
+	}); err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	got, _, err := client.FirewallGroup.Get(ctx, group.ID)
+	if err != nil || got.RuleCount != 1 || got.InstanceCount != 1 {
+		t.Errorf("FirewallGroup.Get returned %+v, %+v, expected 1 rule and 1 instance", got, err)
+	}
+
+	if err := client.FirewallRule.Delete(ctx, group.ID, rule.ID); err != nil {
+		t.Fatalf("FirewallRule.Delete returned %+v", err)
+	}
+
+	if _, _, err := client.FirewallRule.Get(ctx, group.ID, rule.ID); !govultr.IsNotFound(err) {
+		t.Errorf("FirewallRule.Get returned %+v, expected not found", err)
+	}
+}
+
+func TestServer_ReservedIPs(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	ip, _, err := client.ReservedIP.Create(ctx, &govultr.ReservedIPReq{Region: "ewr", IPType: "v4", Label: "lb"})
+	if err != nil {
+		t.Fatalf("ReservedIP.Create returned %+v", err)
+	}
+
+	if err := client.ReservedIP.Attach(ctx, ip.ID, instance.ID); err != nil {
+		t.Fatalf("ReservedIP.Attach returned %+v", err)
+	}
+
+	if err := client.ReservedIP.Attach(ctx, ip.ID, instance.ID); !govultr.IsBadRequest(err) {
+		t.Errorf("ReservedIP.Attach returned %+v, expected attaching twice to fail", err)
+	}
+
+	label := "frontend"
+	updated, _, err := client.ReservedIP.Update(ctx, ip.ID, &govultr.ReservedIPUpdateReq{Label: &label})
+	if err != nil || updated.Label != label || updated.InstanceID != instance.ID {
+		t.Errorf("ReservedIP.Update returned %+v, %+v, expected the attached relabeled IP", updated, err)
+	}
+}
+
+func TestServer_SnapshotsAndScripts(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	snapshot, _, err := client.Snapshot.Create(ctx, &govultr.SnapshotReq{InstanceID: instance.ID, Description: "nightly"})
+	if err != nil {
+		t.Fatalf("Snapshot.Create returned %+v", err)
+	}
+
+	complete, err := client.WaitForSnapshotComplete(ctx, snapshot.ID, fastWait)
+	if err != nil || complete.Size == 0 {
+		t.Errorf("WaitForSnapshotComplete returned %+v, %+v, expected a sized snapshot", complete, err)
+	}
+
+	script, _, err := client.StartupScript.Create(ctx, &govultr.StartupScriptReq{Name: "init", Script: "ZWNobyBoaQ=="})
+	if err != nil || script.Type != "boot" {
+		t.Fatalf("StartupScript.Create returned %+v, %+v, expected a boot script", script, err)
+	}
+
+	if err := client.StartupScript.Update(ctx, script.ID, &govultr.StartupScriptReq{Name: "setup"}); err != nil {
+		t.Fatalf("StartupScript.Update returned %+v", err)
+	}
+
+	got, _, err := client.StartupScript.Get(ctx, script.ID)
+	if err != nil || got.Name != "setup" || got.Script != script.Script {
+		t.Errorf("StartupScript.Get returned %+v, %+v, expected the renamed script", got, err)
+	}
+}
+
+func TestServer_Kubernetes(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	cluster, _, err := client.Kubernetes.CreateCluster(ctx, &govultr.ClusterReq{
+		Label:     "k8s",
+		Region:    "ewr",
+		Version:   "v1.33.0+1",
+		NodePools: []govultr.NodePoolReq{{Label: "workers", Plan: "vc2-2c-4gb", NodeQuantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("Kubernetes.CreateCluster returned %+v", err)
+	}
+
+	if _, err := client.WaitForKubernetesClusterActive(ctx, cluster.ID, fastWait); err != nil {
+		t.Fatalf("WaitForKubernetesClusterActive returned %+v", err)
+	}
+
+	poolID := cluster.NodePools[0].ID
+	pool, _, err := client.Kubernetes.UpdateNodePool(ctx, cluster.ID, poolID, &govultr.NodePoolReqUpdate{NodeQuantity: 3})
+	if err != nil || len(pool.Nodes) != 3 || pool.Status != "pending" {
+		t.Fatalf("Kubernetes.UpdateNodePool returned %+v, %+v, expected 3 pending nodes", pool, err)
+	}
+
+	ready, err := client.WaitForNodePoolReady(ctx, cluster.ID, poolID, fastWait)
+	if err != nil || ready.NodeQuantity != 3 {
+		t.Errorf("WaitForNodePoolReady returned %+v, %+v, expected 3 active nodes", ready, err)
+	}
+
+	config, _, err := client.Kubernetes.GetKubeConfig(ctx, cluster.ID)
+	if err != nil || config.KubeConfig == "" {
+		t.Errorf("Kubernetes.GetKubeConfig returned %+v, %+v, expected a config", config, err)
+	}
+
+	if err := client.Kubernetes.DeleteCluster(ctx, cluster.ID); err != nil {
+		t.Fatalf("Kubernetes.DeleteCluster returned %+v", err)
+	}
+
+	if _, _, err := client.Kubernetes.GetCluster(ctx, cluster.ID); !govultr.IsNotFound(err) {
+		t.Errorf("Kubernetes.GetCluster returned %+v, expected not found", err)
+	}
+}
+
+func TestServer_InstanceVPCs(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	vpc, _, err := client.VPC.Create(ctx, &govultr.VPCReq{Region: "ewr", Description: "private"})
+	if err != nil {
+		t.Fatalf("VPC.Create returned %+v", err)
+	}
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284, AttachVPC: []string{vpc.ID},
+	})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	vpcs, _, _, err := client.Instance.ListVPCInfo(ctx, instance.ID, nil)
+	if err != nil || len(vpcs) != 1 || vpcs[0].ID != vpc.ID {
+		t.Errorf("Instance.ListVPCInfo returned %+v, %+v, expected the attached VPC", vpcs, err)
+	}
+
+	if err := client.VPC.Delete(ctx, vpc.ID); !govultr.IsBadRequest(err) {
+		t.Errorf("VPC.Delete returned %+v, expected a 400 while attached", err)
+	}
+
+	if err := client.Instance.DetachVPC(ctx, instance.ID, vpc.ID); err != nil {
+		t.Fatalf("Instance.DetachVPC returned %+v", err)
+	}
+
+	if err := client.Instance.DetachVPC(ctx, instance.ID, vpc.ID); !govultr.IsBadRequest(err) {
+		t.Errorf("Instance.DetachVPC returned %+v, expected a 400 when not attached", err)
+	}
+
+	if err := client.Instance.AttachVPC(ctx, instance.ID, "missing"); !govultr.IsNotFound(err) {
+		t.Errorf("Instance.AttachVPC returned %+v, expected a 404 for a missing VPC", err)
+	}
+}
+
+func TestServer_LoadBalancers(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	lb, _, err := client.LoadBalancer.Create(ctx, &govultr.LoadBalancerReq{
+		Region:          "ewr",
+		Label:           "web",
+		Instances:       []string{instance.ID},
+		ForwardingRules: []govultr.ForwardingRule{{FrontendProtocol: "http", FrontendPort: 80, BackendProtocol: "http", BackendPort: 8080}},
+	})
+	if err != nil {
+		t.Fatalf("LoadBalancer.Create returned %+v", err)
+	}
+
+	if lb.Status != "pending" || len(lb.ForwardingRules) != 1 || lb.ForwardingRules[0].RuleID == "" {
+		t.Errorf("LoadBalancer.Create returned %+v, expected a pending load balancer with a forwarding rule", lb)
+	}
+
+	if err := client.LoadBalancer.Update(ctx, lb.ID, &govultr.LoadBalancerReq{Instances: []string{"missing"}}); !govultr.IsNotFound(err) {
+		t.Errorf("LoadBalancer.Update returned %+v, expected a 404 for a missing instance", err)
+	}
+
+	if err := client.Instance.Delete(ctx, instance.ID); err != nil {
+		t.Fatalf("Instance.Delete returned %+v", err)
+	}
+
+	lbs, _, _, err := client.LoadBalancer.List(ctx, nil)
+	if err != nil || len(lbs) != 1 || len(lbs[0].Instances) != 0 {
+		t.Errorf("LoadBalancer.List returned %+v, %+v, expected the deleted instance to be removed", lbs, err)
+	}
+
+	if err := client.LoadBalancer.Delete(ctx, lb.ID); err != nil {
+		t.Fatalf("LoadBalancer.Delete returned %+v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Bytes in a GB, snapshot sizes are in bytes
+const bytesPerGB = 1 << 30
+
+func (s *Server) registerSnapshots(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/snapshots", s.createSnapshot)
+	mux.HandleFunc("POST /v2/snapshots/create-from-url", s.createSnapshotFromURL)
+	mux.HandleFunc("GET /v2/snapshots", s.listSnapshots)
+	mux.HandleFunc("GET /v2/snapshots/{id}", s.getSnapshot)
+	mux.HandleFunc("DELETE /v2/snapshots/{id}", s.deleteSnapshot)
+}
+
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.SnapshotReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	instance, ok := s.instances.get(req.InstanceID)
+	if !ok {
+		writeNotFound(w, "Instance")
+		return
+	}
+
+	snapshot := s.addSnapshot(req.Description, instance.Disk, instance.OsID, instance.AppID)
+	writeJSON(w, http.StatusCreated, map[string]any{"snapshot": snapshot})
+}
+
+func (s *Server) createSnapshotFromURL(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.SnapshotURLReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.URL == "" {
+		writeError(w, http.StatusBadRequest, "Invalid url.")
+		return
+	}
+
+	snapshot := s.addSnapshot(req.Description, 1, 0, 0)
+	writeJSON(w, http.StatusCreated, map[string]any{"snapshot": snapshot})
+}
+
+// addSnapshot stores a new pending snapshot of a disk of diskGB
+func (s *Server) addSnapshot(description string, diskGB, osID, appID int) *govultr.Snapshot {
+	snapshot := &govultr.Snapshot{
+		ID:          newID(),
+		DateCreated: now(),
+		Description: description,
+		Status:      "pending",
+		OsID:        osID,
+		AppID:       appID,
+	}
+
+	s.snapshots.add(snapshot.ID, snapshot)
+
+	id := snapshot.ID
+	s.provision(func() {
+		if snap, ok := s.snapshots.get(id); ok {
+			snap.Status = "complete"
+			snap.Size = diskGB * bytesPerGB
+			snap.CompressedSize = snap.Size / 2 //nolint:mnd
+		}
+	})
+
+	return snapshot
+}
+
+func (s *Server) listSnapshots(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	snapshots := s.snapshots.list(func(snap *govultr.Snapshot) bool {
+		return query.Get("description") == "" || snap.Description == query.Get("description")
+	})
+
+	page, meta := paginate(r, snapshots)
+	writeJSON(w, http.StatusOK, map[string]any{"snapshots": page, "meta": meta})
+}
+
+func (s *Server) getSnapshot(w http.ResponseWriter, r *http.Request) {
+	snapshot, ok := s.snapshots.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Snapshot")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"snapshot": snapshot})
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
+	if !s.snapshots.remove(r.PathValue("id")) {
+		writeNotFound(w, "Snapshot")
+		return
+	}
+
+	writeNoContent(w)
+}
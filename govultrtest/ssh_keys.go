@@ -0,0 +1,84 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerSSHKeys(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/ssh-keys", s.createSSHKey)
+	mux.HandleFunc("GET /v2/ssh-keys", s.listSSHKeys)
+	mux.HandleFunc("GET /v2/ssh-keys/{id}", s.getSSHKey)
+	mux.HandleFunc("PATCH /v2/ssh-keys/{id}", s.updateSSHKey)
+	mux.HandleFunc("DELETE /v2/ssh-keys/{id}", s.deleteSSHKey)
+}
+
+func (s *Server) createSSHKey(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.SSHKeyReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Name == "" || req.SSHKey == "" {
+		writeError(w, http.StatusBadRequest, "A name and ssh_key are required.")
+		return
+	}
+
+	key := &govultr.SSHKey{
+		ID:          newID(),
+		Name:        req.Name,
+		SSHKey:      req.SSHKey,
+		DateCreated: now(),
+	}
+
+	s.sshKeys.add(key.ID, key)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"ssh_key": key})
+}
+
+func (s *Server) listSSHKeys(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.sshKeys.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"ssh_keys": page, "meta": meta})
+}
+
+func (s *Server) getSSHKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.sshKeys.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "SSH key")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"ssh_key": key})
+}
+
+func (s *Server) updateSSHKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.sshKeys.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "SSH key")
+		return
+	}
+
+	req := &govultr.SSHKeyReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Name != "" {
+		key.Name = req.Name
+	}
+	if req.SSHKey != "" {
+		key.SSHKey = req.SSHKey
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteSSHKey(w http.ResponseWriter, r *http.Request) {
+	if !s.sshKeys.remove(r.PathValue("id")) {
+		writeNotFound(w, "SSH key")
+		return
+	}
+
+	writeNoContent(w)
+}
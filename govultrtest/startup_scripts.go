@@ -0,0 +1,101 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerStartupScripts(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/startup-scripts", s.createStartupScript)
+	mux.HandleFunc("GET /v2/startup-scripts", s.listStartupScripts)
+	mux.HandleFunc("GET /v2/startup-scripts/{id}", s.getStartupScript)
+	mux.HandleFunc("PATCH /v2/startup-scripts/{id}", s.updateStartupScript)
+	mux.HandleFunc("DELETE /v2/startup-scripts/{id}", s.deleteStartupScript)
+}
+
+func (s *Server) createStartupScript(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.StartupScriptReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Name == "" || req.Script == "" {
+		writeError(w, http.StatusBadRequest, "A name and script are required.")
+		return
+	}
+
+	scriptType := req.Type
+	switch scriptType {
+	case "":
+		scriptType = "boot"
+	case "boot", "pxe":
+	default:
+		writeError(w, http.StatusBadRequest, "Invalid type, must be boot or pxe.")
+		return
+	}
+
+	created := now()
+	script := &govultr.StartupScript{
+		ID:           newID(),
+		DateCreated:  created,
+		DateModified: created,
+		Name:         req.Name,
+		Type:         scriptType,
+		Script:       req.Script,
+	}
+
+	s.scripts.add(script.ID, script)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"startup_script": script})
+}
+
+func (s *Server) listStartupScripts(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.scripts.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"startup_scripts": page, "meta": meta})
+}
+
+func (s *Server) getStartupScript(w http.ResponseWriter, r *http.Request) {
+	script, ok := s.scripts.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Startup script")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"startup_script": script})
+}
+
+func (s *Server) updateStartupScript(w http.ResponseWriter, r *http.Request) {
+	script, ok := s.scripts.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "Startup script")
+		return
+	}
+
+	req := &govultr.StartupScriptReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Name != "" {
+		script.Name = req.Name
+	}
+	if req.Type != "" {
+		script.Type = req.Type
+	}
+	if req.Script != "" {
+		script.Script = req.Script
+	}
+	script.DateModified = now()
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteStartupScript(w http.ResponseWriter, r *http.Request) {
+	if !s.scripts.remove(r.PathValue("id")) {
+		writeNotFound(w, "Startup script")
+		return
+	}
+
+	writeNoContent(w)
+}
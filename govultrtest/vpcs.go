@@ -0,0 +1,99 @@
+package govultrtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Subnet mask of VPCs created without a subnet
+const defaultVPCSubnetMask = 20
+
+func (s *Server) registerVPCs(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/vpcs", s.createVPC)
+	mux.HandleFunc("GET /v2/vpcs", s.listVPCs)
+	mux.HandleFunc("GET /v2/vpcs/{id}", s.getVPC)
+	mux.HandleFunc("PUT /v2/vpcs/{id}", s.updateVPC)
+	mux.HandleFunc("DELETE /v2/vpcs/{id}", s.deleteVPC)
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	req := &govultr.VPCReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Region == "" {
+		writeError(w, http.StatusBadRequest, "Invalid region.")
+		return
+	}
+
+	subnet, mask := req.V4Subnet, req.V4SubnetMask
+	if subnet == "" {
+		subnet = fmt.Sprintf("10.%d.0.0", s.next()%256) //nolint:mnd
+		mask = defaultVPCSubnetMask
+	}
+
+	vpc := &govultr.VPC{
+		ID:           newID(),
+		Region:       req.Region,
+		Description:  req.Description,
+		V4Subnet:     subnet,
+		V4SubnetMask: mask,
+		DateCreated:  now(),
+	}
+
+	s.vpcs.add(vpc.ID, vpc)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"vpc": vpc})
+}
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request) {
+	page, meta := paginate(r, s.vpcs.list(nil))
+	writeJSON(w, http.StatusOK, map[string]any{"vpcs": page, "meta": meta})
+}
+
+func (s *Server) getVPC(w http.ResponseWriter, r *http.Request) {
+	vpc, ok := s.vpcs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "VPC")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"vpc": vpc})
+}
+
+func (s *Server) updateVPC(w http.ResponseWriter, r *http.Request) {
+	vpc, ok := s.vpcs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "VPC")
+		return
+	}
+
+	req := &govultr.VPCReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	vpc.Description = req.Description
+	writeNoContent(w)
+}
+
+func (s *Server) deleteVPC(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	for _, vpcs := range s.vpcAttachments {
+		if slices.Contains(vpcs, id) {
+			writeError(w, http.StatusBadRequest, "VPC is attached to an instance.")
+			return
+		}
+	}
+
+	if !s.vpcs.remove(id) {
+		writeNotFound(w, "VPC")
+		return
+	}
+
+	writeNoContent(w)
+}
@@ -26,10 +26,10 @@ type InferenceServiceHandler struct {
 
 // Inference represents a Serverless Inference subscription
 type Inference struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	Label       string `json:"label"`
-	APIKey      string `json:"api_key"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	Label       string    `json:"label"`
+	APIKey      string    `json:"api_key"`
 }
 
 // inferenceSubsBase holds the entire List API response
@@ -1,57 +1,468 @@
 package govultr
 
 import (
-	"fmt"
-	"io"
 	"net/http"
 	"reflect"
 	"testing"
 )
 
-const (
-	defaultInstanceListResponse string = `{
-		"instance": {
-			"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+func TestInstanceServiceHandler_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"instances": [
+		{
+			"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
 			"os": "CentOS SELinux 8 x64",
 			"ram": 2048,
-			"disk": 60,
-			"main_ip": "123.123.123.123",
-			"vcpu_count": 2,
-			"region": "ewr",
-			"plan": "vc2-1c-2gb",
-			"date_created": "2013-12-19 14:45:41",
+			"disk": 55,
+			"main_ip": "192.0.2.123",
+			"vcpu_count": 1,
+			"region": "atl",
+			"plan": "vc2-6c-16gb",
+			"date_created": "2020-10-10T01:56:20+00:00",
 			"status": "active",
 			"allowed_bandwidth": 2000,
-			"netmask_v4": "255.255.255.248",
-			"gateway_v4": "123.123.123.1",
+			"netmask_v4": "255.255.252.0",
+			"gateway_v4": "192.0.2.1",
 			"power_status": "running",
 			"server_status": "ok",
-			"v6_network": "2001:DB8:1000::",
-			"v6_main_ip": "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
+			"v6_network": "2001:0db8:1112:18fb::",
+			"v6_main_ip": "2001:0db8:1112:18fb:0200:00ff:fe00:0000",
 			"v6_network_size": 64,
-			"label": "my new server",
-			"internal_ip": "10.99.0.10",
-			"kvm": "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-			"default_password" : "nreqnusibni",
-			"tags": ["my tag"],
-			"os_id": 362,
+			"label": "Example Instance",
+			"internal_ip": "",
+			"vpc_only": false,
+			"vpcs": [
+			{
+				"id": "775e26b3-f67d-46b7-87ed-1a0457fb3a5e",
+				"version": 1,
+				"subnet": "10.1.96.3"
+			},
+			{
+				"id": "090a49c0-a1a2-4aab-a263-5d58f180c905",
+				"version": 2,
+				"subnet": "10.1.128.3"
+			}
+			],
+			"kvm": "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+			"hostname": "my_hostname",
+			"os_id": 215,
 			"app_id": 0,
-			"firewall_group_id": "1234",
+			"image_id": "",
+			"snapshot_id": "",
+			"firewall_group_id": "",
 			"features": [
-				"auto_backups", "ipv6"
-			]
+				"ddos_protection",
+				"ipv6",
+				"auto_backups"
+			],
+			"tags": [
+				"a tag",
+				"another"
+			],
+			"user_scheme": "root",
+			"pending_charges": 5.42
 		}
-	}`
-)
+	],
+	"meta": {
+		"total": 3,
+		"links": {
+			"next": "WxYzExampleNext",
+			"prev": ""
+		}
+	}
+}`))
+
+	server, meta, _, err := client.Instance.List(ctx, nil)
+	if err != nil {
+		t.Errorf("Instance.List returned %+v", err)
+	}
+
+	expected := []Instance{
+		{
+			ID:               "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+			Os:               "CentOS SELinux 8 x64",
+			RAM:              2048,
+			Disk:             55,
+			Plan:             "vc2-6c-16gb",
+			MainIP:           "192.0.2.123",
+			VPCOnly:          false,
+			VCPUCount:        1,
+			Region:           "atl",
+			DateCreated:      "2020-10-10T01:56:20+00:00",
+			Status:           "active",
+			AllowedBandwidth: 2000,
+			NetmaskV4:        "255.255.252.0",
+			GatewayV4:        "192.0.2.1",
+			PowerStatus:      "running",
+			ServerStatus:     "ok",
+			V6Network:        "2001:0db8:1112:18fb::",
+			V6MainIP:         "2001:0db8:1112:18fb:0200:00ff:fe00:0000",
+			V6NetworkSize:    64,
+			Label:            "Example Instance",
+			InternalIP:       "",
+			KVM:              "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+			OsID:             215,
+			AppID:            0,
+			ImageID:          "",
+			SnapshotID:       "",
+			FirewallGroupID:  "",
+			Features:         []string{"ddos_protection", "ipv6", "auto_backups"},
+			Hostname:         "my_hostname",
+			Tags:             []string{"a tag", "another"},
+			UserScheme:       "root",
+		},
+	}
+
+	if !reflect.DeepEqual(server, expected) {
+		t.Errorf("Instance.List returned %+v, expected %+v", server, expected)
+	}
+
+	expectedMeta := &Meta{
+		Total: 3,
+		Links: &Links{
+			Next: "WxYzExampleNext",
+			Prev: "",
+		},
+	}
+
+	if !reflect.DeepEqual(meta, expectedMeta) {
+		t.Errorf("Instance.List meta returned %+v, expected %+v", meta, expectedMeta)
+	}
+}
 
-func TestServerServiceHandler_GetBackupSchedule(t *testing.T) {
+func TestInstanceServiceHandler_Get(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/backup-schedule", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"backup_schedule":{"enabled": true,"type": "weekly","next_scheduled_time_utc": "2016-05-07 08:00:00","hour": 8,"dow": 6,"dom": 0}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/cb676a46-66fd-4dfb-b839-443f2e6c0b60", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"instance": {
+		"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+		"os": "CentOS SELinux 8 x64",
+		"ram": 2048,
+		"disk": 55,
+		"main_ip": "192.0.2.123",
+		"vcpu_count": 1,
+		"region": "atl",
+		"plan": "vc2-6c-16gb",
+		"date_created": "2020-10-10T01:56:20+00:00",
+		"status": "active",
+		"allowed_bandwidth": 2000,
+		"netmask_v4": "255.255.252.0",
+		"gateway_v4": "192.0.2.1",
+		"power_status": "running",
+		"server_status": "ok",
+		"v6_network": "2001:0db8:1112:18fb::",
+		"v6_main_ip": "2001:0db8:1112:18fb:0200:00ff:fe00:0000",
+		"v6_network_size": 64,
+		"label": "Example Instance",
+		"internal_ip": "",
+		"vpc_only": false,
+		"vpcs": [
+		{
+			"id": "775e26b3-f67d-46b7-87ed-1a0457fb3a5e",
+			"version": 1,
+			"subnet": "10.1.96.3"
+		},
+		{
+			"id": "090a49c0-a1a2-4aab-a263-5d58f180c905",
+			"version": 2,
+			"subnet": "10.1.128.3"
+		}
+		],
+		"kvm": "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		"hostname": "my_hostname",
+		"os_id": 215,
+		"app_id": 0,
+		"image_id": "",
+		"snapshot_id": "",
+		"firewall_group_id": "",
+		"features": [
+			"ddos_protection",
+			"ipv6",
+			"auto_backups"
+		],
+		"tags": [
+			"a tag",
+			"another"
+		],
+		"user_scheme": "root",
+		"pending_charges": 5.42
+	}
+}`))
+
+	inst, _, err := client.Instance.Get(ctx, "cb676a46-66fd-4dfb-b839-443f2e6c0b60")
+	if err != nil {
+		t.Errorf("Instance.Get returned %+v", err)
+	}
+
+	expected := &Instance{
+		ID:               "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+		Os:               "CentOS SELinux 8 x64",
+		RAM:              2048,
+		Disk:             55,
+		Plan:             "vc2-6c-16gb",
+		MainIP:           "192.0.2.123",
+		VPCOnly:          false,
+		VCPUCount:        1,
+		Region:           "atl",
+		DateCreated:      "2020-10-10T01:56:20+00:00",
+		Status:           "active",
+		AllowedBandwidth: 2000,
+		NetmaskV4:        "255.255.252.0",
+		GatewayV4:        "192.0.2.1",
+		PowerStatus:      "running",
+		ServerStatus:     "ok",
+		V6Network:        "2001:0db8:1112:18fb::",
+		V6MainIP:         "2001:0db8:1112:18fb:0200:00ff:fe00:0000",
+		V6NetworkSize:    64,
+		Label:            "Example Instance",
+		InternalIP:       "",
+		KVM:              "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		OsID:             215,
+		AppID:            0,
+		ImageID:          "",
+		SnapshotID:       "",
+		FirewallGroupID:  "",
+		Features:         []string{"ddos_protection", "ipv6", "auto_backups"},
+		Hostname:         "my_hostname",
+		Tags:             []string{"a tag", "another"},
+		UserScheme:       "root",
+	}
+
+	if !reflect.DeepEqual(inst, expected) {
+		t.Errorf("Instance.Get returned %+v, expected %+v", inst, expected)
+	}
+}
+
+func TestInstanceServiceHandler_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"instance": {
+		"id": "4f0f12e5-1f84-404f-aa84-85f431ea5ec2",
+		"os": "CentOS 8 Stream",
+		"ram": 8192,
+		"disk": 0,
+		"main_ip": "0.0.0.0",
+		"vcpu_count": 1,
+		"region": "ewr",
+		"plan": "vc2-4c-8gb",
+		"date_created": "2021-09-14T13:22:20+00:00",
+		"status": "pending",
+		"allowed_bandwidth": 2000,
+		"netmask_v4": "",
+		"gateway_v4": "0.0.0.0",
+		"power_status": "running",
+		"server_status": "none",
+		"v6_network": "",
+		"v6_main_ip": "",
+		"v6_network_size": 0,
+		"label": "Example Instance",
+		"internal_ip": "",
+		"vpc_only": false,
+		"kvm": "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		"hostname": "my_hostname",
+		"os_id": 215,
+		"app_id": 0,
+		"image_id": "",
+		"snapshot_id": "",
+		"firewall_group_id": "",
+		"features": [],
+		"default_password": "v5{Fkvb#2ycPGwHs",
+		"tags": [
+			"a tag",
+			"another"
+		],
+		"user_scheme": "root"
+	}
+}`))
+
+	options := &InstanceCreateReq{
+		Region:   "ewr",
+		Plan:     "vc2-4c-8gb",
+		Label:    "Example Instance",
+		Backups:  "enabled",
+		OsID:     215,
+		UserData: "QmFzZTY0IEV4YW1wbGUgRGF0YQ==",
+		Hostname: "my_hostname",
+		Tags:     []string{"a tag", "another"},
+	}
+
+	inst, _, err := client.Instance.Create(ctx, options)
+	if err != nil {
+		t.Errorf("Instance.Create returned %+v", err)
+	}
+
+	expected := &Instance{
+		ID:               "4f0f12e5-1f84-404f-aa84-85f431ea5ec2",
+		Os:               "CentOS 8 Stream",
+		RAM:              8192,
+		Disk:             0,
+		Plan:             "vc2-4c-8gb",
+		MainIP:           "0.0.0.0",
+		VPCOnly:          false,
+		VCPUCount:        1,
+		Region:           "ewr",
+		DateCreated:      "2021-09-14T13:22:20+00:00",
+		Status:           "pending",
+		AllowedBandwidth: 2000,
+		NetmaskV4:        "",
+		GatewayV4:        "0.0.0.0",
+		PowerStatus:      "running",
+		ServerStatus:     "none",
+		V6Network:        "",
+		V6MainIP:         "",
+		V6NetworkSize:    0,
+		Label:            "Example Instance",
+		InternalIP:       "",
+		KVM:              "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		OsID:             215,
+		AppID:            0,
+		ImageID:          "",
+		SnapshotID:       "",
+		FirewallGroupID:  "",
+		Hostname:         "my_hostname",
+		Tags:             []string{"a tag", "another"},
+		UserScheme:       "root",
+		DefaultPassword:  "v5{Fkvb#2ycPGwHs",
+		Features:         []string{},
+	}
+
+	if !reflect.DeepEqual(inst, expected) {
+		t.Errorf("Instance.Create returned %+v, expected %+v", inst, expected)
+	}
+}
+
+func TestInstanceServiceHandler_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/4f0f12e5-1f84-404f-aa84-85f431ea5ec2", testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"instance": {
+		"id": "4f0f12e5-1f84-404f-aa84-85f431ea5ec2",
+		"os": "CentOS 8 Stream",
+		"ram": 8192,
+		"disk": 0,
+		"main_ip": "10.2.3.4",
+		"vcpu_count": 1,
+		"region": "ewr",
+		"plan": "vc2-4c-8gb",
+		"date_created": "2021-09-14T13:22:20+00:00",
+		"status": "active",
+		"allowed_bandwidth": 2000,
+		"netmask_v4": "",
+		"gateway_v4": "10.0.0.1",
+		"power_status": "running",
+		"server_status": "none",
+		"v6_network": "",
+		"v6_main_ip": "",
+		"v6_network_size": 0,
+		"label": "Example Instance",
+		"internal_ip": "",
+		"vpc_only": false,
+		"kvm": "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		"hostname": "my_hostname",
+		"os_id": 215,
+		"app_id": 0,
+		"image_id": "",
+		"snapshot_id": "",
+		"firewall_group_id": "a35eac93-9f56-4824-bb4e-bc3ac3814225",
+		"features": [],
+		"default_password": "",
+		"tags": [
+			"my tag"
+		],
+		"user_scheme": "root"
+	}
+}`))
+
+	options := &InstanceUpdateReq{
+		EnableIPv6:      BoolToBoolPtr(true),
+		Tags:            []string{"my tag"},
+		FirewallGroupID: "a35eac93-9f56-4824-bb4e-bc3ac3814225",
+	}
+
+	server, _, err := client.Instance.Update(ctx, "4f0f12e5-1f84-404f-aa84-85f431ea5ec2", options)
+	if err != nil {
+		t.Errorf("Instance.Update returned %+v", err)
+	}
+
+	expected := &Instance{
+		ID:               "4f0f12e5-1f84-404f-aa84-85f431ea5ec2",
+		Os:               "CentOS 8 Stream",
+		RAM:              8192,
+		Disk:             0,
+		Plan:             "vc2-4c-8gb",
+		MainIP:           "10.2.3.4",
+		VPCOnly:          false,
+		VCPUCount:        1,
+		Region:           "ewr",
+		DateCreated:      "2021-09-14T13:22:20+00:00",
+		Status:           "active",
+		AllowedBandwidth: 2000,
+		NetmaskV4:        "",
+		GatewayV4:        "10.0.0.1",
+		PowerStatus:      "running",
+		ServerStatus:     "none",
+		V6Network:        "",
+		V6MainIP:         "",
+		V6NetworkSize:    0,
+		Label:            "Example Instance",
+		InternalIP:       "",
+		KVM:              "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		OsID:             215,
+		AppID:            0,
+		ImageID:          "",
+		SnapshotID:       "",
+		FirewallGroupID:  "a35eac93-9f56-4824-bb4e-bc3ac3814225",
+		Hostname:         "my_hostname",
+		Tags:             []string{"my tag"},
+		UserScheme:       "root",
+		DefaultPassword:  "",
+		Features:         []string{},
+	}
+
+	if !reflect.DeepEqual(server, expected) {
+		t.Errorf("Instance.Update returned %+v, expected %+v", server, expected)
+	}
+}
+
+func TestInstanceServiceHandler_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
+
+	err := client.Instance.Delete(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+
+	if err != nil {
+		t.Errorf("Instance.Delete returned %+v", err)
+	}
+}
+
+func TestInstanceServiceHandler_GetBackupSchedule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/backup-schedule", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"backup_schedule": {
+		"enabled": true,
+		"type": "weekly",
+		"next_scheduled_time_utc": "2016-05-07 08:00:00",
+		"hour": 8,
+		"dow": 6,
+		"dom": 0
+	}
+}`))
 
 	backup, _, err := client.Instance.GetBackupSchedule(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
@@ -72,20 +483,26 @@ func TestServerServiceHandler_GetBackupSchedule(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_SetBackupSchedule(t *testing.T) {
+func TestInstanceServiceHandler_SetBackupSchedule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/backup-schedule", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"backup_schedule":{"enabled": true,"type": "weekly","next_scheduled_time_utc": "2016-05-07 08:00:00","hour": 22,"dow": 2,"dom": 3}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/backup-schedule", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"backup_schedule": {
+		"enabled": true,
+		"type": "weekly",
+		"next_scheduled_time_utc": "2016-05-07 08:00:00",
+		"hour": 22,
+		"dow": 2,
+		"dom": 3
+	}
+}`))
 
 	bs := &BackupScheduleReq{
 		Type: "weekly",
 		Hour: IntToIntPtr(22),
 		Dow:  IntToIntPtr(2),
-		Dom:  3,
 	}
 
 	if _, err := client.Instance.SetBackupSchedule(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", bs); err != nil {
@@ -93,13 +510,11 @@ func TestServerServiceHandler_SetBackupSchedule(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_RestoreBackup(t *testing.T) {
+func TestInstanceServiceHandler_RestoreBackup(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/restore", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/restore", testJSONResponseHandlerFunc(http.StatusAccepted, ""))
 
 	restoreReq := &RestoreReq{
 		BackupID: "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
@@ -110,18 +525,11 @@ func TestServerServiceHandler_RestoreBackup(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_RestoreSnapshot(t *testing.T) {
+func TestInstanceServiceHandler_RestoreSnapshot(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/restore", func(writer http.ResponseWriter, request *http.Request) {
-		body, err := io.ReadAll(request.Body)
-		if err != nil || len(body) == 0 {
-			http.Error(writer, "can't read body", http.StatusBadRequest)
-			return
-		}
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/restore", testJSONResponseHandlerFunc(http.StatusAccepted, ""))
 
 	restoreReq := &RestoreReq{
 		SnapshotID: "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
@@ -132,14 +540,17 @@ func TestServerServiceHandler_RestoreSnapshot(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_Neighbors(t *testing.T) {
+func TestInstanceServiceHandler_Neighbors(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/neighbors", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"neighbors":["14b3e7d6-ffb5-4994-8502-57fcd9db3b33","14b3e7d6-ffb5-4994-8502-57fcd9db3b33"]}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/neighbors", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"neighbors": [
+		"14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+		"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"
+	]
+}`))
 
 	neighbors, _, err := client.Instance.GetNeighbors(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
@@ -155,20 +566,29 @@ func TestServerServiceHandler_Neighbors(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_ListVPCInfo(t *testing.T) {
+func TestInstanceServiceHandler_ListVPCInfo(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/vpcs", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"vpcs": [{"id": "v1-net539626f0798d7","mac_address": "5a:02:00:00:24:e9","ip_address": "10.99.0.3"}],"meta":{"total":1,"links":{"next":"thisismycusror","prev":""}}}`
-		fmt.Fprint(writer, response)
-	})
-
-	options := &ListOptions{
-		PerPage: 1,
-		Cursor:  "",
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/vpcs", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"vpcs": [
+		{
+			"id": "v1-net539626f0798d7", 
+			"mac_address": "5a:02:00:00:24:e9",
+			"ip_address": "10.99.0.3"
+		}
+	],
+	"meta": {
+		"total":1,
+		"links": {
+			"next":"thisismycusror",
+			"prev":""
+		}
 	}
-	vpc, meta, _, err := client.Instance.ListVPCInfo(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", options)
+}`))
+
+	vpc, meta, _, err := client.Instance.ListVPCInfo(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", nil)
 	if err != nil {
 		t.Errorf("Instance.ListVPCInfo returned %+v, ", err)
 	}
@@ -198,14 +618,16 @@ func TestServerServiceHandler_ListVPCInfo(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_GetUserData(t *testing.T) {
+func TestInstanceServiceHandler_GetUserData(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/user-data", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"user_data": {"data" : "ZWNobyBIZWxsbyBXb3JsZA=="}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/user-data", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"user_data": {
+		"data" : "ZWNobyBIZWxsbyBXb3JsZA=="
+	}
+}`))
 
 	userData, _, err := client.Instance.GetUserData(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
@@ -219,14 +641,29 @@ func TestServerServiceHandler_GetUserData(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_ListIPv4(t *testing.T) {
+func TestInstanceServiceHandler_ListIPv4(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{ "ipv4s": [{"ip": "123.123.123.123","netmask": "255.255.255.248","gateway": "123.123.123.1","type": "main_ip","reverse": "host1.example.com"}],"meta":{"total":1,"links":{"next":"thisismycusror","prev":""}}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"ipv4s": [
+		{
+			"ip": "123.123.123.123",
+			"netmask": "255.255.255.248",
+			"gateway": "123.123.123.1",
+			"type": "main_ip",
+			"reverse": "host1.example.com"
+		}
+	],
+	"meta": {
+		"total":1,
+		"links": {
+			"next":"thisismycusror",
+			"prev":""
+		}
+	}
+}`))
 
 	ipv4, meta, _, err := client.Instance.ListIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", nil)
 
@@ -261,14 +698,28 @@ func TestServerServiceHandler_ListIPv4(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_ListIPv6(t *testing.T) {
+func TestInstanceServiceHandler_ListIPv6(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"ipv6s": [{"ip": "2001:DB8:1000::100","network": "2001:DB8:1000::","network_size": 64,"type": "main_ip"}],"meta":{"total":1,"links":{"next":"thisismycusror","prev":""}}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"ipv6s":  [
+		{
+			"ip":  "2001:DB8:1000::100",
+			"network":  "2001:DB8:1000::",
+			"network_size":  64,
+			"type":  "main_ip"
+		}
+	],
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "thisismycusror",
+			"prev": ""
+		}
+	}
+}`))
 
 	ipv6, meta, _, err := client.Instance.ListIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", nil)
 	if err != nil {
@@ -301,14 +752,20 @@ func TestServerServiceHandler_ListIPv6(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_CreateIPv4(t *testing.T) {
+func TestInstanceServiceHandler_CreateIPv4(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{ "ipv4": {"ip": "123.123.123.123","netmask": "255.255.255.248","gateway": "123.123.123.1","type": "main_ip","reverse": "host1.example.com"}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"ipv4": {
+		"ip": "123.123.123.123",
+		"netmask": "255.255.255.248",
+		"gateway": "123.123.123.1",
+		"type": "main_ip",
+		"reverse": "host1.example.com"
+	}
+}`))
 
 	ipv4, _, err := client.Instance.CreateIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", BoolToBoolPtr(false))
 	if err != nil {
@@ -328,13 +785,14 @@ func TestServerServiceHandler_CreateIPv4(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_DestroyIPV4(t *testing.T) {
+func TestInstanceServiceHandler_DestroyIPV4(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4/192.168.0.1", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4/192.168.0.1",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
 	err := client.Instance.DeleteIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "192.168.0.1")
 
@@ -343,23 +801,19 @@ func TestServerServiceHandler_DestroyIPV4(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_GetBandwidth(t *testing.T) {
+func TestInstanceServiceHandler_GetBandwidth(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/bandwidth", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"bandwidth": {
-				"2017-04-01": {
-					"incoming_bytes": 91571055,
-					"outgoing_bytes": 3084731
-				}
-			}
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/bandwidth", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"bandwidth": {
+		"2017-04-01": {
+			"incoming_bytes": 91571055,
+			"outgoing_bytes": 3084731
 		}
-		`
-		fmt.Fprint(writer, response)
-	})
+	}
+}`))
 
 	bandwidth, _, err := client.Instance.GetBandwidth(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
@@ -383,14 +837,19 @@ func TestServerServiceHandler_GetBandwidth(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_ListReverseIPv6(t *testing.T) {
+func TestInstanceServiceHandler_ListReverseIPv6(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6/reverse", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"reverse_ipv6s": [{"ip": "2001:DB8:1000::101","reverse": "host1.example.com"}]}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6/reverse", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"reverse_ipv6s": [
+		{
+			"ip": "2001:DB8:1000::101",
+			"reverse": "host1.example.com"
+		}
+	]
+}`))
 
 	reverseIPV6, _, err := client.Instance.ListReverseIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 
@@ -407,39 +866,42 @@ func TestServerServiceHandler_ListReverseIPv6(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_DefaultReverseIPv4(t *testing.T) {
+func TestInstanceServiceHandler_DefaultReverseIPv4(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4/reverse/default", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4/reverse/default",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
-	if err := client.Instance.DefaultReverseIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "129.123.123.1"); err != nil {
+	if err := client.Instance.DefaultReverseIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "172.123.123.1"); err != nil {
 		t.Errorf("Instance.DefaultReverseIPv4 returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_DeleteReverseIPv6(t *testing.T) {
+func TestInstanceServiceHandler_DeleteReverseIPv6(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6/reverse/2001:19f0:8001:1480:5400:2ff:fe00:8228", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6/reverse/2001:19f0:8001:1480:5400:2ff:fe00:8228",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
 	if err := client.Instance.DeleteReverseIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "2001:19f0:8001:1480:5400:2ff:fe00:8228"); err != nil {
 		t.Errorf("Instance.DeleteReverseIPv6 returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_CreateReverseIPv4(t *testing.T) {
+func TestInstanceServiceHandler_CreateReverseIPv4(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4/reverse", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv4/reverse",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
 	reverseReq := &ReverseIP{
 		IP:      "192.168.0.1",
@@ -451,13 +913,14 @@ func TestServerServiceHandler_CreateReverseIPv4(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_CreateReverseIPv6(t *testing.T) {
+func TestInstanceServiceHandler_CreateReverseIPv6(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6/reverse", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/ipv6/reverse",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
 	reverseReq := &ReverseIP{
 		IP:      "192.168.0.1",
@@ -469,39 +932,36 @@ func TestServerServiceHandler_CreateReverseIPv6(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_Halt(t *testing.T) {
+func TestInstanceServiceHandler_Halt(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/halt", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/halt",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
 	if err := client.Instance.Halt(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.Halt returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_Start(t *testing.T) {
+func TestInstanceServiceHandler_Start(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/start", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/start", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	if err := client.Instance.Start(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.Start returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_Reboot(t *testing.T) {
+func TestInstanceServiceHandler_Reboot(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/reboot", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/reboot", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	err := client.Instance.Reboot(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 
@@ -510,285 +970,96 @@ func TestServerServiceHandler_Reboot(t *testing.T) {
 	}
 }
 
-func TestServerServiceHandler_Reinstall(t *testing.T) {
+func TestInstanceServiceHandler_Reinstall(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/reinstall", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer, defaultInstanceListResponse)
-	})
-
-	instanceRes, _, err := client.Instance.Reinstall(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", nil)
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/reinstall", testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"instance": {
+		"id": "4f0f12e5-1f84-404f-aa84-85f431ea5ec2",
+		"os": "CentOS 8 Stream",
+		"ram": 8192,
+		"disk": 0,
+		"main_ip": "10.2.3.4",
+		"vcpu_count": 1,
+		"region": "ewr",
+		"plan": "vc2-4c-8gb",
+		"date_created": "2021-09-14T13:22:20+00:00",
+		"status": "active",
+		"allowed_bandwidth": 2000,
+		"netmask_v4": "",
+		"gateway_v4": "10.0.0.1",
+		"power_status": "running",
+		"server_status": "none",
+		"v6_network": "",
+		"v6_main_ip": "",
+		"v6_network_size": 0,
+		"label": "Example Instance",
+		"internal_ip": "",
+		"vpc_only": false,
+		"kvm": "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		"hostname": "my_hostname_reinstalled",
+		"os_id": 215,
+		"app_id": 0,
+		"image_id": "",
+		"snapshot_id": "",
+		"firewall_group_id": "a35eac93-9f56-4824-bb4e-bc3ac3814225",
+		"features": [],
+		"default_password": "",
+		"tags": [
+			"my tag"
+		],
+		"user_scheme": "root"
+	}
+}`))
+
+	req := &ReinstallReq{
+		Hostname: "my_hostname_reinstalled",
+	}
+
+	inst, _, err := client.Instance.Reinstall(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", req)
 	if err != nil {
 		t.Errorf("Instance.Reinstall returned %+v", err)
 	}
 
 	expected := &Instance{
-		ID:               "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		Os:               "CentOS SELinux 8 x64",
-		OsID:             362,
-		RAM:              2048,
-		Disk:             60,
-		MainIP:           "123.123.123.123",
-		VCPUCount:        2,
-		Region:           "ewr",
-		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
-		Status:           "active",
-		AllowedBandwidth: 2000,
-		NetmaskV4:        "255.255.255.248",
-		GatewayV4:        "123.123.123.1",
-		PowerStatus:      "running",
-		ServerStatus:     "ok",
-		Plan:             "vc2-1c-2gb",
-		V6Network:        "2001:DB8:1000::",
-		V6MainIP:         "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-		V6NetworkSize:    64,
-		Label:            "my new server",
-		InternalIP:       "10.99.0.10",
-		KVM:              "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-		Tags:             []string{"my tag"},
-		AppID:            0,
-		FirewallGroupID:  "1234",
-		Features:         []string{"auto_backups", "ipv6"},
-	}
-
-	if !reflect.DeepEqual(instanceRes, expected) {
-		t.Errorf("Instance.Create returned %+v, expected %+v", server, expected)
-	}
-}
-
-func TestServerServiceHandler_Delete(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
-
-	err := client.Instance.Delete(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
-
-	if err != nil {
-		t.Errorf("Instance.Delete returned %+v", err)
-	}
-}
-
-func TestServerServiceHandler_Create(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer, defaultInstanceListResponse)
-	})
-
-	options := &InstanceCreateReq{
-		IPXEChainURL:    "test.org",
-		ISOID:           "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		ScriptID:        "213",
-		EnableIPv6:      BoolToBoolPtr(true),
-		Backups:         "enabled",
-		UserData:        "dW5vLWRvcy10cmVz",
-		ActivationEmail: BoolToBoolPtr(true),
-		DDOSProtection:  BoolToBoolPtr(true),
-		SnapshotID:      "12ab",
-		Hostname:        "hostname-3000",
-		Tags:            []string{"my tag"},
-		Label:           "label-extreme",
-		SSHKeys:         []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "dev-preview-abc124"},
-		ReservedIPv4:    "63.209.35.79",
-		FirewallGroupID: "1234",
-		AppID:           1,
-	}
-
-	server, _, err := client.Instance.Create(ctx, options)
-	if err != nil {
-		t.Errorf("Instance.Create returned %+v", err)
-	}
-
-	expected := &Instance{
-		ID:               "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		Os:               "CentOS SELinux 8 x64",
-		OsID:             362,
-		RAM:              2048,
-		Disk:             60,
-		MainIP:           "123.123.123.123",
-		VCPUCount:        2,
+		ID:               "4f0f12e5-1f84-404f-aa84-85f431ea5ec2",
+		Os:               "CentOS 8 Stream",
+		RAM:              8192,
+		Disk:             0,
+		Plan:             "vc2-4c-8gb",
+		MainIP:           "10.2.3.4",
+		VPCOnly:          false,
+		VCPUCount:        1,
 		Region:           "ewr",
-		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
+		DateCreated:      "2021-09-14T13:22:20+00:00",
 		Status:           "active",
 		AllowedBandwidth: 2000,
-		NetmaskV4:        "255.255.255.248",
-		GatewayV4:        "123.123.123.1",
+		NetmaskV4:        "",
+		GatewayV4:        "10.0.0.1",
 		PowerStatus:      "running",
-		ServerStatus:     "ok",
-		Plan:             "vc2-1c-2gb",
-		V6Network:        "2001:DB8:1000::",
-		V6MainIP:         "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-		V6NetworkSize:    64,
-		Label:            "my new server",
-		InternalIP:       "10.99.0.10",
-		KVM:              "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-		Tags:             []string{"my tag"},
+		ServerStatus:     "none",
+		V6Network:        "",
+		V6MainIP:         "",
+		V6NetworkSize:    0,
+		Label:            "Example Instance",
+		InternalIP:       "",
+		KVM:              "https://console.vultr.com/subs/vps/novnc/api.php?data=00example11223344",
+		OsID:             215,
 		AppID:            0,
-		FirewallGroupID:  "1234",
-		Features:         []string{"auto_backups", "ipv6"},
-	}
-
-	if !reflect.DeepEqual(server, expected) {
-		t.Errorf("Instance.Create returned %+v, expected %+v", server, expected)
-	}
-}
-
-func TestServerServiceHandler_List(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
-			"instances": [{
-				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-				"os": "CentOS SELinux 8 x64",
-				"ram": 2048,
-				"disk": 60,
-				"main_ip": "123.123.123.123",
-				"vcpu_count": 2,
-				"region": "ewr",
-				"plan": "vc2-1c-2gb",
-				"date_created": "2013-12-19 14:45:41",
-				"status": "active",
-				"allowed_bandwidth": 2000,
-				"netmask_v4": "255.255.255.248",
-				"gateway_v4": "123.123.123.1",
-				"power_status": "running",
-				"server_status": "ok",
-				"v6_network": "2001:DB8:1000::",
-				"v6_main_ip": "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-				"v6_network_size": 64,
-				"label": "my new server",
-				"internal_ip": "10.99.0.10",
-				"kvm": "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-				"default_password" : "nreqnusibni",
-				"tags": ["my tag"],
-				"os_id": 362,
-				"app_id": 0,
-				"firewall_group_id": "",
-				"features": [
-					"auto_backups"
-				]
-			}],
-			"meta":{
-				"total":1,
-				"links":{
-					"next":"thisismycusror",
-					"prev":""
-				}
-			}
-		}`
-		fmt.Fprint(writer, response)
-	})
-
-	server, meta, _, err := client.Instance.List(ctx, nil)
-	if err != nil {
-		t.Errorf("Instance.List returned %+v", err)
-	}
-
-	features := []string{"auto_backups"}
-
-	expected := []Instance{
-		{
-			ID:               "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-			Os:               "CentOS SELinux 8 x64",
-			OsID:             362,
-			RAM:              2048,
-			Disk:             60,
-			MainIP:           "123.123.123.123",
-			VCPUCount:        2,
-			Region:           "ewr",
-			DefaultPassword:  "nreqnusibni",
-			DateCreated:      "2013-12-19 14:45:41",
-			Status:           "active",
-			AllowedBandwidth: 2000,
-			NetmaskV4:        "255.255.255.248",
-			GatewayV4:        "123.123.123.1",
-			PowerStatus:      "running",
-			ServerStatus:     "ok",
-			Plan:             "vc2-1c-2gb",
-			V6Network:        "2001:DB8:1000::",
-			V6MainIP:         "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-			V6NetworkSize:    64,
-			Label:            "my new server",
-			InternalIP:       "10.99.0.10",
-			KVM:              "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-			Tags:             []string{"my tag"},
-			AppID:            0,
-			FirewallGroupID:  "",
-			Features:         features,
-		},
-	}
-
-	if !reflect.DeepEqual(server, expected) {
-		t.Errorf("Instance.List returned %+v, expected %+v", server, expected)
-	}
-
-	expectedMeta := &Meta{
-		Total: 1,
-		Links: &Links{
-			Next: "thisismycusror",
-			Prev: "",
-		},
-	}
-
-	if !reflect.DeepEqual(meta, expectedMeta) {
-		t.Errorf("Instance.List meta returned %+v, expected %+v", meta, expectedMeta)
-	}
-}
-
-func TestServerServiceHandler_GetServer(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer, defaultInstanceListResponse)
-	})
-
-	server, _, err := client.Instance.Get(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
-	if err != nil {
-		t.Errorf("Instance.GetServer returned %+v", err)
-	}
-
-	expected := &Instance{
-		ID:               "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		Os:               "CentOS SELinux 8 x64",
-		OsID:             362,
-		RAM:              2048,
-		Disk:             60,
-		MainIP:           "123.123.123.123",
-		VCPUCount:        2,
-		Region:           "ewr",
-		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
-		Status:           "active",
-		AllowedBandwidth: 2000,
-		NetmaskV4:        "255.255.255.248",
-		GatewayV4:        "123.123.123.1",
-		PowerStatus:      "running",
-		ServerStatus:     "ok",
-		Plan:             "vc2-1c-2gb",
-		V6Network:        "2001:DB8:1000::",
-		V6MainIP:         "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-		V6NetworkSize:    64,
-		Label:            "my new server",
-		InternalIP:       "10.99.0.10",
-		KVM:              "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
+		ImageID:          "",
+		SnapshotID:       "",
+		FirewallGroupID:  "a35eac93-9f56-4824-bb4e-bc3ac3814225",
+		Hostname:         "my_hostname_reinstalled",
 		Tags:             []string{"my tag"},
-		AppID:            0,
-		FirewallGroupID:  "1234",
-		Features:         []string{"auto_backups", "ipv6"},
+		UserScheme:       "root",
+		DefaultPassword:  "",
+		Features:         []string{},
 	}
 
-	if !reflect.DeepEqual(server, expected) {
-		t.Errorf("Instance.GetServer returned %+v, expected %+v", server, expected)
+	if !reflect.DeepEqual(inst, expected) {
+		t.Errorf("Instance.Reinstall returned %+v, expected %+v", inst, expected)
 	}
 }
 
@@ -796,8 +1067,8 @@ func TestInstanceServiceHandler_GetUpgrades(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/upgrades", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/upgrades", testJSONResponseHandlerFunc(http.StatusOK, `
+{
    "upgrades":{
       "os":[
          {
@@ -819,11 +1090,9 @@ func TestInstanceServiceHandler_GetUpgrades(t *testing.T) {
          "vc2-2c-4gb"
       ]
    }
-}`
-		fmt.Fprint(writer, response)
-	})
+}`))
 
-	server, _, err := client.Instance.GetUpgrades(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+	ups, _, err := client.Instance.GetUpgrades(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
 		t.Errorf("Instance.GetUpgrades returned %+v", err)
 	}
@@ -850,109 +1119,99 @@ func TestInstanceServiceHandler_GetUpgrades(t *testing.T) {
 		},
 	}
 
-	if !reflect.DeepEqual(server, expected) {
-		t.Errorf("Instance.GetUpgrades returned %+v, expected %+v", server, expected)
+	if !reflect.DeepEqual(ups, expected) {
+		t.Errorf("Instance.GetUpgrades returned %+v, expected %+v", ups, expected)
 	}
 }
 
-func TestServerServiceHandler_MassStart(t *testing.T) {
+func TestInstanceServiceHandler_MassStart(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/start", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/start", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	if err := client.Instance.MassStart(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
 		t.Errorf("Instance.MassStart returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_MassReboot(t *testing.T) {
+func TestInstanceServiceHandler_MassReboot(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/reboot", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/reboot", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	if err := client.Instance.MassReboot(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
 		t.Errorf("Instance.MassReboot returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_MassHalt(t *testing.T) {
+func TestInstanceServiceHandler_MassHalt(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/halt", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/halt", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	if err := client.Instance.MassHalt(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
 		t.Errorf("Instance.MassHalt returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_AttachVPC(t *testing.T) {
+func TestInstanceServiceHandler_AttachVPC(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/vpcs/attach", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/vpcs/attach", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	if err := client.Instance.AttachVPC(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.AttachVPC returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_DetachVPC(t *testing.T) {
+func TestInstanceServiceHandler_DetachVPC(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/vpcs/detach", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/vpcs/detach", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
 	if err := client.Instance.DetachVPC(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.DetachVPC returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_ISOAttach(t *testing.T) {
+func TestInstanceServiceHandler_ISOAttach(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/iso/attach", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/iso/attach", testJSONResponseHandlerFunc(http.StatusAccepted, ""))
 
 	if _, err := client.Instance.AttachISO(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.AttachISO returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_ISODetach(t *testing.T) {
+func TestInstanceServiceHandler_ISODetach(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/iso/detach", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/iso/detach", testJSONResponseHandlerFunc(http.StatusAccepted, ""))
 
 	if _, err := client.Instance.DetachISO(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.DetachISO returned %+v", err)
 	}
 }
 
-func TestServerServiceHandler_ISO(t *testing.T) {
+func TestInstanceServiceHandler_ISOStatus(t *testing.T) {
 	setup()
 	defer teardown()
-	ret := `{"iso_status": {"state": "ready","iso_id": "0532a75b-14e8-48b8-b27e-1ebcf382a804"}}`
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/iso", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer, ret)
-	})
+
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/iso", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"iso_status": {
+		"state": "ready",
+		"iso_id": "0532a75b-14e8-48b8-b27e-1ebcf382a804"
+	}
+}`))
 
 	iso, _, err := client.Instance.ISOStatus(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
@@ -968,166 +1227,3 @@ func TestServerServiceHandler_ISO(t *testing.T) {
 		t.Errorf("Instance.ISOStatus returned %+v, expected %+v", iso, expected)
 	}
 }
-
-func TestServerServiceHandler_Update(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer, defaultInstanceListResponse)
-	})
-
-	options := &InstanceUpdateReq{
-		EnableIPv6:      BoolToBoolPtr(true),
-		Backups:         "enabled",
-		UserData:        "dW5vLWRvcy10cmVz",
-		DDOSProtection:  BoolToBoolPtr(true),
-		Tags:            []string{"my tag"},
-		Label:           "label-extreme",
-		FirewallGroupID: "1234",
-		AppID:           1,
-	}
-
-	server, _, err := client.Instance.Update(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", options)
-	if err != nil {
-		t.Errorf("Instance.Update returned %+v", err)
-	}
-
-	expected := &Instance{
-		ID:               "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		Os:               "CentOS SELinux 8 x64",
-		OsID:             362,
-		RAM:              2048,
-		Disk:             60,
-		MainIP:           "123.123.123.123",
-		VCPUCount:        2,
-		Region:           "ewr",
-		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
-		Status:           "active",
-		AllowedBandwidth: 2000,
-		NetmaskV4:        "255.255.255.248",
-		GatewayV4:        "123.123.123.1",
-		PowerStatus:      "running",
-		ServerStatus:     "ok",
-		Plan:             "vc2-1c-2gb",
-		V6Network:        "2001:DB8:1000::",
-		V6MainIP:         "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-		V6NetworkSize:    64,
-		Label:            "my new server",
-		InternalIP:       "10.99.0.10",
-		KVM:              "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-		Tags:             []string{"my tag"},
-		AppID:            0,
-		FirewallGroupID:  "1234",
-		Features:         []string{"auto_backups", "ipv6"},
-	}
-
-	if !reflect.DeepEqual(server, expected) {
-		t.Errorf("Instance.Update returned %+v, expected %+v", server, expected)
-	}
-}
-
-func TestServerServiceHandler_CreateMarketplace(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{
-			"instance": {
-				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-				"os": "CentOS SELinux 8 x64",
-				"ram": 2048,
-				"disk": 60,
-				"main_ip": "123.123.123.123",
-				"vcpu_count": 2,
-				"region": "ewr",
-				"plan": "vc2-1c-2gb",
-				"date_created": "2013-12-19 14:45:41",
-				"status": "active",
-				"allowed_bandwidth": 2000,
-				"netmask_v4": "255.255.255.248",
-				"gateway_v4": "123.123.123.1",
-				"power_status": "running",
-				"server_status": "ok",
-				"v6_network": "2001:DB8:1000::",
-				"v6_main_ip": "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-				"v6_network_size": 64,
-				"label": "my new server",
-				"internal_ip": "10.99.0.10",
-				"kvm": "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-				"default_password" : "nreqnusibni",
-				"tags": ["my tag"],
-				"os_id": 362,
-				"image_id": "test",
-				"app_id": 0,
-				"firewall_group_id": "1234",
-				"features": [
-					"auto_backups", "ipv6"
-				]
-			}
-		}`
-		fmt.Fprint(writer, response)
-	})
-
-	options := &InstanceCreateReq{
-		IPXEChainURL:    "test.org",
-		ISOID:           "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		ScriptID:        "213",
-		EnableIPv6:      BoolToBoolPtr(true),
-		Backups:         "enabled",
-		UserData:        "dW5vLWRvcy10cmVz",
-		ActivationEmail: BoolToBoolPtr(true),
-		DDOSProtection:  BoolToBoolPtr(true),
-		SnapshotID:      "12ab",
-		Hostname:        "hostname-3000",
-		Tags:            []string{"tagger"},
-		Label:           "label-extreme",
-		SSHKeys:         []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "dev-preview-abc124"},
-		ReservedIPv4:    "63.209.35.79",
-		FirewallGroupID: "1234",
-		ImageID:         "test",
-	}
-
-	server, _, err := client.Instance.Create(ctx, options)
-	if err != nil {
-		t.Errorf("Instance.Create returned %+v", err)
-	}
-
-	features := []string{"auto_backups", "ipv6"}
-
-	expected := &Instance{
-		ID:               "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
-		Os:               "CentOS SELinux 8 x64",
-		OsID:             362,
-		RAM:              2048,
-		Disk:             60,
-		MainIP:           "123.123.123.123",
-		VCPUCount:        2,
-		Region:           "ewr",
-		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
-		Status:           "active",
-		AllowedBandwidth: 2000,
-		NetmaskV4:        "255.255.255.248",
-		GatewayV4:        "123.123.123.1",
-		PowerStatus:      "running",
-		ServerStatus:     "ok",
-		Plan:             "vc2-1c-2gb",
-		V6Network:        "2001:DB8:1000::",
-		V6MainIP:         "fd11:1111:1112:1c02:0200:00ff:fe00:0000",
-		V6NetworkSize:    64,
-		Label:            "my new server",
-		InternalIP:       "10.99.0.10",
-		KVM:              "https://my.vultr.com/subs/novnc/api.php?data=eawxFVZw2mXnhGUV",
-		Tags:             []string{"my tag"},
-		AppID:            0,
-		ImageID:          "test",
-		FirewallGroupID:  "1234",
-		Features:         features,
-	}
-
-	if !reflect.DeepEqual(server, expected) {
-		t.Errorf("Instance.Create returned %+v, expected %+v", server, expected)
-	}
-}
@@ -25,13 +25,13 @@ type ISOServiceHandler struct {
 
 // ISO represents ISOs currently available on this account.
 type ISO struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	FileName    string `json:"filename"`
-	Size        int    `json:"size,omitempty"`
-	MD5Sum      string `json:"md5sum,omitempty"`
-	SHA512Sum   string `json:"sha512sum,omitempty"`
-	Status      string `json:"status"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	FileName    string    `json:"filename"`
+	Size        int       `json:"size,omitempty"`
+	MD5Sum      string    `json:"md5sum,omitempty"`
+	SHA512Sum   string    `json:"sha512sum,omitempty"`
+	Status      string    `json:"status"`
 }
 
 // PublicISO represents public ISOs offered in the Vultr ISO library.
@@ -56,7 +56,7 @@ type KubernetesHandler struct {
 type Cluster struct {
 	ID              string            `json:"id"`
 	Label           string            `json:"label"`
-	DateCreated     string            `json:"date_created"`
+	DateCreated     Timestamp         `json:"date_created"`
 	ClusterSubnet   string            `json:"cluster_subnet"`
 	ServiceSubnet   string            `json:"service_subnet"`
 	IP              string            `json:"ip"`
@@ -73,8 +73,8 @@ type Cluster struct {
 // NodePool represents a pool of nodes that are grouped by their label and plan type
 type NodePool struct {
 	ID           string            `json:"id"`
-	DateCreated  string            `json:"date_created"`
-	DateUpdated  string            `json:"date_updated"`
+	DateCreated  Timestamp         `json:"date_created"`
+	DateUpdated  Timestamp         `json:"date_updated"`
 	Label        string            `json:"label"`
 	Plan         string            `json:"plan"`
 	Status       string            `json:"status"`
@@ -84,6 +84,7 @@ type NodePool struct {
 	AutoScaler   bool              `json:"auto_scaler"`
 	UserData     string            `json:"user_data"`
 	Tag          string            `json:"tag"`
+	VPCOnly      bool              `json:"vpc_only"`
 	Labels       map[string]string `json:"labels"`
 	Taints       []Taint           `json:"taints"`
 	Nodes        []Node            `json:"nodes"`
@@ -91,11 +92,11 @@ type NodePool struct {
 
 // Node represents a node that will live within a nodepool
 type Node struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	Label       string `json:"label"`
-	IP          string `json:"ip,omitempty"` // Optional, may not be present in older API responses
-	Status      string `json:"status"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	Label       string    `json:"label"`
+	IP          string    `json:"ip,omitempty"` // Optional, may not be present in older API responses
+	Status      string    `json:"status"`
 }
 
 // KubeConfig will contain the kubeconfig b64 encoded
@@ -130,6 +131,7 @@ type NodePoolReq struct {
 	MinNodes     int               `json:"min_nodes,omitempty"`
 	MaxNodes     int               `json:"max_nodes,omitempty"`
 	AutoScaler   *bool             `json:"auto_scaler"`
+	VPCOnly      *bool             `json:"vpc_only,omitempty"`
 	Labels       map[string]string `json:"labels,omitempty"`
 	Taints       []Taint           `json:"taints,omitempty"`
 	UserData     string            `json:"user_data"`
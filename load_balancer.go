@@ -41,7 +41,7 @@ type LoadBalancerHandler struct {
 // LoadBalancer represent the structure of a load balancer
 type LoadBalancer struct {
 	ID              string           `json:"id,omitempty"`
-	DateCreated     string           `json:"date_created,omitempty"`
+	DateCreated     Timestamp        `json:"date_created,omitempty"`
 	Region          string           `json:"region,omitempty"`
 	Label           string           `json:"label,omitempty"`
 	Status          string           `json:"status,omitempty"`
@@ -57,29 +57,30 @@ type LoadBalancer struct {
 	HTTP3           *bool            `json:"http3,omitempty"`
 	ForwardingRules []ForwardingRule `json:"forwarding_rules,omitempty"`
 	FirewallRules   []LBFirewallRule `json:"firewall_rules,omitempty"`
-	GlobalRegions   []string         `json:"global_regions,omitempty"`
+	GlobalRegions   []LBGlobalRegion `json:"global_regions,omitempty"`
+	NodeIPs         LBNodeIPs        `json:"node_ips,omitempty"`
 }
 
 // LoadBalancerReq gives options for creating or updating a load balancer
 type LoadBalancerReq struct {
-	Region             string           `json:"region,omitempty"`
-	Label              string           `json:"label,omitempty"`
-	Instances          []string         `json:"instances,omitempty"`
-	Nodes              int              `json:"nodes,omitempty"`
-	HealthCheck        *HealthCheck     `json:"health_check,omitempty"`
-	StickySessions     *StickySessions  `json:"sticky_session,omitempty"`
-	ForwardingRules    []ForwardingRule `json:"forwarding_rules,omitempty"`
-	SSL                *SSL             `json:"ssl,omitempty"`
-	AutoSSL            *AutoSSL         `json:"auto_ssl,omitempty"`
-	SSLRedirect        *bool            `json:"ssl_redirect,omitempty"`
-	HTTP2              *bool            `json:"http2,omitempty"`
-	HTTP3              *bool            `json:"http3,omitempty"`
-	ProxyProtocol      *bool            `json:"proxy_protocol,omitempty"`
-	BalancingAlgorithm string           `json:"balancing_algorithm,omitempty"`
-	FirewallRules      []LBFirewallRule `json:"firewall_rules,omitempty"`
-	Timeout            int              `json:"timeout,omitempty"`
-	VPC                *string          `json:"vpc,omitempty"`
-	GlobalRegions      []string         `json:"global_regions,omitempty"`
+	Region             string             `json:"region,omitempty"`
+	Label              string             `json:"label,omitempty"`
+	Instances          []string           `json:"instances,omitempty"`
+	Nodes              int                `json:"nodes,omitempty"`
+	HealthCheck        *HealthCheck       `json:"health_check,omitempty"`
+	StickySessions     *StickySessions    `json:"sticky_session,omitempty"`
+	ForwardingRules    []ForwardingRule   `json:"forwarding_rules,omitempty"`
+	SSL                *SSL               `json:"ssl,omitempty"`
+	AutoSSL            *AutoSSL           `json:"auto_ssl,omitempty"`
+	SSLRedirect        *bool              `json:"ssl_redirect,omitempty"`
+	HTTP2              *bool              `json:"http2,omitempty"`
+	HTTP3              *bool              `json:"http3,omitempty"`
+	ProxyProtocol      *bool              `json:"proxy_protocol,omitempty"`
+	BalancingAlgorithm BalancingAlgorithm `json:"balancing_algorithm,omitempty"`
+	FirewallRules      []LBFirewallRule   `json:"firewall_rules,omitempty"`
+	Timeout            int                `json:"timeout,omitempty"`
+	VPC                *string            `json:"vpc,omitempty"`
+	GlobalRegions      []LBGlobalRegion   `json:"global_regions,omitempty"`
 }
 
 // InstanceList represents instances that are attached to your load balancer
@@ -89,23 +90,23 @@ type InstanceList struct {
 
 // HealthCheck represents your health check configuration for your load balancer.
 type HealthCheck struct {
-	Protocol           string `json:"protocol,omitempty"`
-	Port               int    `json:"port,omitempty"`
-	Path               string `json:"path,omitempty"`
-	CheckInterval      int    `json:"check_interval,omitempty"`
-	ResponseTimeout    int    `json:"response_timeout,omitempty"`
-	UnhealthyThreshold int    `json:"unhealthy_threshold,omitempty"`
-	HealthyThreshold   int    `json:"healthy_threshold,omitempty"`
+	Protocol           LBProtocol `json:"protocol,omitempty"`
+	Port               int        `json:"port,omitempty"`
+	Path               string     `json:"path,omitempty"`
+	CheckInterval      int        `json:"check_interval,omitempty"`
+	ResponseTimeout    int        `json:"response_timeout,omitempty"`
+	UnhealthyThreshold int        `json:"unhealthy_threshold,omitempty"`
+	HealthyThreshold   int        `json:"healthy_threshold,omitempty"`
 }
 
 // GenericInfo represents generic configuration of your load balancer
 type GenericInfo struct {
-	BalancingAlgorithm string          `json:"balancing_algorithm,omitempty"`
-	Timeout            int             `json:"timeout,omitempty"`
-	SSLRedirect        *bool           `json:"ssl_redirect,omitempty"`
-	StickySessions     *StickySessions `json:"sticky_sessions,omitempty"`
-	ProxyProtocol      *bool           `json:"proxy_protocol,omitempty"`
-	VPC                string          `json:"vpc,omitempty"`
+	BalancingAlgorithm BalancingAlgorithm `json:"balancing_algorithm,omitempty"`
+	Timeout            int                `json:"timeout,omitempty"`
+	SSLRedirect        *bool              `json:"ssl_redirect,omitempty"`
+	StickySessions     *StickySessions    `json:"sticky_sessions,omitempty"`
+	ProxyProtocol      *bool              `json:"proxy_protocol,omitempty"`
+	VPC                string             `json:"vpc,omitempty"`
 }
 
 // StickySessions represents cookie for your load balancer
@@ -120,11 +121,11 @@ type ForwardingRules struct {
 
 // ForwardingRule represent a single forwarding rule
 type ForwardingRule struct {
-	RuleID           string `json:"id,omitempty"`
-	FrontendProtocol string `json:"frontend_protocol,omitempty"`
-	FrontendPort     int    `json:"frontend_port,omitempty"`
-	BackendProtocol  string `json:"backend_protocol,omitempty"`
-	BackendPort      int    `json:"backend_port,omitempty"`
+	RuleID           string     `json:"id,omitempty"`
+	FrontendProtocol LBProtocol `json:"frontend_protocol,omitempty"`
+	FrontendPort     int        `json:"frontend_port,omitempty"`
+	BackendProtocol  LBProtocol `json:"backend_protocol,omitempty"`
+	BackendPort      int        `json:"backend_port,omitempty"`
 }
 
 // LBFirewallRule represent a single firewall rule
@@ -141,6 +142,19 @@ type LBFirewallRules struct {
 	Rules []LBFirewallRule `json:"firewall_rules"`
 }
 
+// LBGlobalRegion represents a global load balancer region
+type LBGlobalRegion struct {
+	RegionID string `json:"region_id"`
+	VPCID    string `json:"vpc_id"`
+}
+
+// LBNodeIPs represents lists of LoadBalancer node IPs
+type LBNodeIPs struct {
+	V4      []string `json:"v4,omitempty"`
+	V6      []string `json:"v6,omitempty"`
+	Private []string `json:"private,omitempty"`
+}
+
 // SSL represents valid SSL config
 type SSL struct {
 	PrivateKey     string `json:"private_key,omitempty"`
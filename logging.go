@@ -0,0 +1,130 @@
+package govultr
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// SetLogger sets a structured logger for the client. Every attempt of a
+// request is logged with its method, path, status, attempt number and
+// duration, and retries are logged along with the wait before them. Passing
+// nil turns logging off.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// SetLogBodies enables logging of request and response headers and bodies at
+// the debug level. Credentials in headers and secrets in bodies, such as
+// passwords, API keys and S3 secret keys, are redacted.
+func (c *Client) SetLogBodies(enabled bool) {
+	c.logBodies = enabled
+}
+
+// LoggingMiddleware logs every attempt of a request to the client's logger.
+// It does nothing when no logger is set.
+func (c *Client) LoggingMiddleware(next Handler) Handler {
+	return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		logger := c.logger
+		if logger == nil {
+			return next.RoundTrip(req)
+		}
+
+		ctx := req.Context()
+		attrs := requestLogAttrs(req)
+
+		dumpBodies := c.logBodies && logger.Enabled(ctx, slog.LevelDebug)
+		if dumpBodies {
+			body, err := peekBody(&req.Body)
+			if err != nil {
+				return nil, err
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "vultr api request body", append(attrs,
+				slog.Any("headers", RedactHeaders(req.Header)),
+				slog.String("body", string(RedactBody(body))),
+			)...)
+		}
+
+		start := time.Now()
+		resp, err := next.RoundTrip(req)
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+		if err != nil {
+			logger.LogAttrs(ctx, slog.LevelError, "vultr api request failed", append(attrs, slog.Any("error", err))...)
+			return resp, err
+		}
+
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+		level := slog.LevelInfo
+		if resp.StatusCode >= http.StatusBadRequest {
+			level = slog.LevelWarn
+		}
+		logger.LogAttrs(ctx, level, "vultr api request", attrs...)
+
+		if dumpBodies {
+			body, err := peekBody(&resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "vultr api response body", append(attrs,
+				slog.Any("headers", RedactHeaders(resp.Header)),
+				slog.String("body", string(RedactBody(body))),
+			)...)
+		}
+
+		return resp, nil
+	})
+}
+
+// logRetry logs that a request is about to be retried
+func (c *Client) logRetry(req *http.Request, resp *http.Response, err error, wait time.Duration, remaining int) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := append(requestLogAttrs(req), slog.Duration("wait", wait), slog.Int("remaining", remaining))
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	c.logger.LogAttrs(req.Context(), slog.LevelInfo, "retrying vultr api request", attrs...)
+}
+
+func requestLogAttrs(req *http.Request) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", AttemptFromContext(req.Context())),
+	}
+
+	if operation := OperationFromContext(req.Context()); operation != "" {
+		attrs = append(attrs, slog.String("operation", operation))
+	}
+
+	return attrs
+}
+
+// peekBody reads a body and puts an unread copy back in its place
+func peekBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	buf, err := io.ReadAll(*body)
+	if cerr := (*body).Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(buf))
+
+	return buf, nil
+}
@@ -0,0 +1,119 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func logEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid log line %s: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestClient_SetLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(10 * time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		if attempts == 1 {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"account":{"name":"vultr"}}`)
+	})
+
+	buf := new(bytes.Buffer)
+	client.SetLogger(slog.New(slog.NewJSONHandler(buf, nil)))
+
+	if _, _, err := client.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	entries := logEntries(t, buf)
+	if len(entries) != 3 {
+		t.Fatalf("logged %d entries, expected 3: %s", len(entries), buf.String())
+	}
+
+	expected := []struct {
+		msg     string
+		attempt float64
+		status  float64
+	}{
+		{"vultr api request", 1, http.StatusInternalServerError},
+		{"retrying vultr api request", 1, http.StatusInternalServerError},
+		{"vultr api request", 2, http.StatusOK},
+	}
+
+	for i, e := range expected {
+		entry := entries[i]
+		if entry["msg"] != e.msg || entry["attempt"] != e.attempt || entry["status"] != e.status {
+			t.Errorf("log entry %d = %+v, expected %+v", i, entry, e)
+		}
+
+		if entry["method"] != http.MethodGet || entry["path"] != "/v2/account" || entry["operation"] != "Account.Get" {
+			t.Errorf("log entry %d = %+v, expected the request details", i, entry)
+		}
+	}
+
+	if _, ok := entries[0]["duration"]; !ok {
+		t.Errorf("log entry %+v, expected a duration", entries[0])
+	}
+}
+
+func TestClient_SetLogBodies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", testJSONResponseHandlerFunc(http.StatusOK, `{"instance":{"id":"abc","default_password":"hunter2"}}`))
+
+	buf := new(bytes.Buffer)
+	client.SetLogger(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	client.SetLogBodies(true)
+
+	client.Use(func(next Handler) Handler {
+		return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("Authorization", "Bearer secret-api-key")
+			return next.RoundTrip(req)
+		})
+	})
+
+	instance, _, err := client.Instance.Create(ctx, &InstanceCreateReq{Label: "web", OsID: 2284, UserData: "c2VjcmV0"})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if instance.DefaultPassword != "hunter2" {
+		t.Errorf("Instance.Create returned %+v, expected the response body to be untouched", instance)
+	}
+
+	for _, secret := range []string{"hunter2", "secret-api-key", "c2VjcmV0"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("logs contain %q: %s", secret, buf.String())
+		}
+	}
+
+	if !strings.Contains(buf.String(), `\"label\":\"web\"`) {
+		t.Errorf("logs do not contain the request body: %s", buf.String())
+	}
+}
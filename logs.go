@@ -2,7 +2,13 @@ package govultr
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"iter"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -13,6 +19,8 @@ const logsPath = "/v2/logs"
 // Link: https://www.vultr.com/api/#tag/logs
 type LogsService interface {
 	List(ctx context.Context, options LogsOptions) ([]Log, *LogsMeta, *http.Response, error)
+	Stream(ctx context.Context, options LogsOptions) iter.Seq2[Log, error]
+	Follow(ctx context.Context, options LogsOptions, followOptions *LogsFollowOptions) <-chan LogEvent
 }
 
 // LogsServiceHandler handle interaction with the server methods for the Vultr API
@@ -26,7 +34,7 @@ type Log struct {
 	ResourceType string      `json:"resource_type"`
 	Level        string      `json:"log_level"`
 	Message      string      `json:"message"`
-	Timestamp    string      `json:"timestamp"`
+	Timestamp    Timestamp   `json:"timestamp"`
 	Metadata     LogMetadata `json:"metadata"`
 }
 
@@ -49,19 +57,20 @@ type logsBase struct {
 
 // LogsMeta represent pagination data for log entries
 type LogsMeta struct {
-	ContinueTime    string `json:"continue_time"`
-	ReturnedCount   int    `json:"returned_count"`
-	UnreturnedCount int    `json:"unreturned_count"`
-	TotalCount      int    `json:"total_count"`
+	ContinueTime    Timestamp `json:"continue_time"`
+	ReturnedCount   int       `json:"returned_count"`
+	UnreturnedCount int       `json:"unreturned_count"`
+	TotalCount      int       `json:"total_count"`
 }
 
-// LogsOptions represents the query params for the logs list
+// LogsOptions represents the query params for the logs list. StartTime and
+// EndTime are sent in RFC 3339.
 type LogsOptions struct {
-	StartTime    string `url:"start_time"`
-	EndTime      string `url:"end_time"`
-	LogLevel     string `url:"log_level,omitempty"`
-	ResourceType string `url:"resource_type,omitempty"`
-	ResourceID   string `url:"resource_id,omitempty"`
+	StartTime    time.Time `url:"start_time"`
+	EndTime      time.Time `url:"end_time"`
+	LogLevel     string    `url:"log_level,omitempty"`
+	ResourceType string    `url:"resource_type,omitempty"`
+	ResourceID   string    `url:"resource_id,omitempty"`
 }
 
 // List retrieves logs
@@ -86,3 +95,249 @@ func (l *LogsServiceHandler) List(ctx context.Context, options LogsOptions) ([]L
 
 	return logs.Logs, &logs.Meta, resp, nil
 }
+
+// Stream returns an iterator over every log between StartTime and EndTime.
+// List returns a window of logs at a time, Stream follows
+// LogsMeta.ContinueTime until none are left unreturned. Logs the API returns
+// again at the start of the next window are skipped. EndTime defaults to
+// now. Errors are yielded once and end the iteration.
+func (l *LogsServiceHandler) Stream(ctx context.Context, options LogsOptions) iter.Seq2[Log, error] { //nolint:gocritic
+	if options.EndTime.IsZero() {
+		options.EndTime = time.Now()
+	}
+
+	return l.stream(ctx, options, logSet{})
+}
+
+// stream yields the logs of every window between the start and end time
+// that aren't in seen
+func (l *LogsServiceHandler) stream(ctx context.Context, options LogsOptions, seen logSet) iter.Seq2[Log, error] { //nolint:gocritic
+	return func(yield func(Log, error) bool) {
+		for {
+			logs, meta, _, err := l.List(ctx, options)
+			if err != nil {
+				yield(Log{}, err)
+				return
+			}
+
+			yielded := false
+			for i := range logs {
+				if !seen.add(logs[i]) {
+					continue
+				}
+				yielded = true
+				if !yield(logs[i], nil) {
+					return
+				}
+			}
+
+			if meta == nil || meta.UnreturnedCount == 0 || meta.ContinueTime == "" {
+				return
+			}
+
+			next := meta.ContinueTime.Time()
+			if next.Before(options.StartTime) || (next.Equal(options.StartTime) && !yielded) {
+				return
+			}
+
+			options.StartTime = next
+			seen.prune(next)
+		}
+	}
+}
+
+// LogsFollowOptions configures Follow
+type LogsFollowOptions struct {
+	// Time between polls for new logs, defaults to 5 seconds
+	PollInterval time.Duration
+
+	// How far each poll reaches back before the end of the previous one, to
+	// pick up logs that show up late. Logs already sent are skipped.
+	// Defaults to 1 minute.
+	Overlap time.Duration
+
+	// Only logs matching the filter are sent when set
+	Filter *LogFilter
+}
+
+// LogEvent is a log sent by Follow, or the error that stopped it
+type LogEvent struct {
+	Log Log
+	Err error
+}
+
+// Follow sends the logs from StartTime onwards on the returned channel,
+// then keeps polling for new ones until ctx is done, like tail -f. A zero
+// StartTime starts from now and EndTime is ignored. The channel is closed
+// when ctx is done or after an event with the error that stopped it.
+func (l *LogsServiceHandler) Follow(ctx context.Context, options LogsOptions, followOptions *LogsFollowOptions) <-chan LogEvent { //nolint:gocritic,lll
+	opts := LogsFollowOptions{PollInterval: defaultPollInterval, Overlap: time.Minute}
+	if followOptions != nil {
+		opts.Filter = followOptions.Filter
+		if followOptions.PollInterval > 0 {
+			opts.PollInterval = followOptions.PollInterval
+		}
+		if followOptions.Overlap > 0 {
+			opts.Overlap = followOptions.Overlap
+		}
+	}
+
+	if options.StartTime.IsZero() {
+		options.StartTime = time.Now()
+	}
+
+	events := make(chan LogEvent)
+	go func() {
+		defer close(events)
+
+		send := func(event LogEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		seen := logSet{}
+		for {
+			options.EndTime = time.Now()
+			for log, err := range l.stream(ctx, options, seen) {
+				if err != nil {
+					if ctx.Err() == nil {
+						send(LogEvent{Err: err})
+					}
+					return
+				}
+				if opts.Filter.Match(&log) && !send(LogEvent{Log: log}) {
+					return
+				}
+			}
+
+			// Times are sent to the second, forgetting logs from within the
+			// second of the start would send them again
+			if start := options.EndTime.Add(-opts.Overlap).Truncate(time.Second); start.After(options.StartTime) {
+				options.StartTime = start
+				seen.prune(start)
+			}
+
+			select {
+			case <-time.After(opts.PollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// logSet holds the logs already returned so repeats can be skipped. Logs are
+// compared by all their fields.
+type logSet map[Log]struct{}
+
+// add reports whether log wasn't in the set and adds it
+func (s logSet) add(log Log) bool {
+	if _, ok := s[log]; ok {
+		return false
+	}
+
+	s[log] = struct{}{}
+	return true
+}
+
+// prune forgets the logs from before start, which won't be returned again
+func (s logSet) prune(start time.Time) {
+	for log := range s {
+		if ts, err := log.Timestamp.Parse(); err == nil && ts.Before(start) {
+			delete(s, log)
+		}
+	}
+}
+
+// LogFilter selects logs by level, resource type, user and HTTP status. Empty
+// fields match every log and a nil filter matches everything.
+type LogFilter struct {
+	// Log levels, e.g. "Error", compared without case
+	Levels []string
+
+	// Resource types, e.g. "instances"
+	ResourceTypes []string
+
+	// User IDs or usernames of the users that made the requests
+	Users []string
+
+	// HTTP status codes of the requests
+	HTTPStatusCodes []int
+
+	// Lowest HTTP status code, e.g. 400 for failed requests
+	MinHTTPStatus int
+}
+
+// Match reports whether log passes the filter
+func (f *LogFilter) Match(log *Log) bool {
+	if f == nil {
+		return true
+	}
+
+	containsFold := func(values []string, s string) bool {
+		return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, s) })
+	}
+
+	switch {
+	case len(f.Levels) > 0 && !containsFold(f.Levels, log.Level),
+		len(f.ResourceTypes) > 0 && !containsFold(f.ResourceTypes, log.ResourceType),
+		len(f.Users) > 0 && !slices.Contains(f.Users, log.Metadata.UserID) && !slices.Contains(f.Users, log.Metadata.UserName),
+		len(f.HTTPStatusCodes) > 0 && !slices.Contains(f.HTTPStatusCodes, log.Metadata.HTTPStatusCode),
+		log.Metadata.HTTPStatusCode < f.MinHTTPStatus:
+		return false
+	}
+
+	return true
+}
+
+// FilterLogs returns an iterator over the logs that match filter
+func FilterLogs(logs iter.Seq2[Log, error], filter *LogFilter) iter.Seq2[Log, error] {
+	return func(yield func(Log, error) bool) {
+		for log, err := range logs {
+			if err == nil && !filter.Match(&log) {
+				continue
+			}
+			if !yield(log, err) {
+				return
+			}
+		}
+	}
+}
+
+// LogEvents returns an iterator over the events sent by Follow
+func LogEvents(events <-chan LogEvent) iter.Seq2[Log, error] {
+	return func(yield func(Log, error) bool) {
+		for event := range events {
+			if !yield(event.Log, event.Err) {
+				return
+			}
+		}
+	}
+}
+
+// WriteLogsJSONLines writes every log to w as a line of JSON and returns the
+// number written. It stops at the first error from the iterator or w.
+//
+//	n, err := govultr.WriteLogsJSONLines(f, client.Logs.Stream(ctx, opts))
+func WriteLogsJSONLines(w io.Writer, logs iter.Seq2[Log, error]) (int, error) {
+	enc := json.NewEncoder(w)
+
+	n := 0
+	for log, err := range logs {
+		if err != nil {
+			return n, err
+		}
+		if err := enc.Encode(log); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, nil
+}
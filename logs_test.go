@@ -1,24 +1,62 @@
 package govultr
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestLogsServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
+	expectedQuery := "end_time=2025-08-26T00%3A00%3A10Z&resource_id=xb671a46-66ed-4dfb-b839-543f2c6c0b63&start_time=2025-08-26T00%3A00%3A00Z"
+	handler := testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"logs": [
+		{
+			"resource_id": "xb671a46-66ed-4dfb-b839-543f2c6c0b63",
+			"resource_type": "kubernetes",
+			"log_level": "Debug",
+			"message": "Success",
+			"timestamp": "2025-08-26T00:00:07+00:00",
+			"metadata": {
+				"user_id": "765b8aa0-e134-4b62-88e1-22f40959ffe7",
+				"ip_address": "123.123.123.123",
+				"username": "",
+				"http_status_code": 200,
+				"method": "GET",
+				"request_path": "/v2/kubernetes/clusters/xb671a46-66ed-4dfb-b839-543f2c6c0b63/node-pools",
+				"request_body": "",
+				"query_parameters": ""
+			}
+		}
+	],
+	"meta": {
+		"continue_time": "",
+		"returned_count":1,
+		"unreturned_count":0,
+		"total_count":1
+	}
+}`)
 	mux.HandleFunc("/v2/logs", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"logs":[{"resource_id":"xb671a46-66ed-4dfb-b839-543f2c6c0b63","resource_type":"kubernetes","log_level":"Debug","message":"Success","timestamp":"2025-08-26T00:00:07+00:00","metadata":{"user_id":"765b8aa0-e134-4b62-88e1-22f40959ffe7","ip_address":"123.123.123.123","username":"","http_status_code":200,"method":"GET","request_path":"/v2/kubernetes/clusters/xb671a46-66ed-4dfb-b839-543f2c6c0b63/node-pools","request_body": "","query_parameters": ""}}],"meta":{"continue_time": "","returned_count":1,"unreturned_count":0,"total_count":1}}`
-		fmt.Fprint(writer, response)
+		if query := request.URL.RawQuery; query != expectedQuery {
+			t.Errorf("Logs.List sent query %s, expected %s", query, expectedQuery)
+		}
+		handler(writer, request)
 	})
 
 	logs, meta, _, err := client.Logs.List(ctx, LogsOptions{
-		StartTime:  "2025-08-26T00:00:00Z",
-		EndTime:    "2025-08-26T00:00:10Z",
+		StartTime:  time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2025, 8, 26, 0, 0, 10, 0, time.UTC),
 		ResourceID: "xb671a46-66ed-4dfb-b839-543f2c6c0b63",
 	})
 	if err != nil {
@@ -58,3 +96,202 @@ func TestLogsServiceHandler_List(t *testing.T) {
 		t.Errorf("Logs.List meta returned %+v, expected %+v", meta, expectedMeta)
 	}
 }
+
+// serveLogs serves the logs returned by all between start_time and end_time,
+// at most limit at a time. The continue time is the timestamp of the last log
+// returned, so it is returned again at the start of the next window.
+func serveLogs(t *testing.T, all func() []Log, limit int) *int {
+	requests := 0
+	mux.HandleFunc("/v2/logs", func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+
+		start, err := time.Parse(time.RFC3339, request.URL.Query().Get("start_time"))
+		if err != nil {
+			t.Errorf("Logs.List sent start_time %q", request.URL.Query().Get("start_time"))
+		}
+		end, _ := time.Parse(time.RFC3339, request.URL.Query().Get("end_time"))
+
+		var window []Log
+		for _, log := range all() {
+			if ts := log.Timestamp.Time(); !ts.Before(start) && !ts.After(end) {
+				window = append(window, log)
+			}
+		}
+
+		meta := LogsMeta{TotalCount: len(window)}
+		if len(window) > limit {
+			meta.UnreturnedCount = len(window) - limit
+			window = window[:limit]
+			meta.ContinueTime = window[len(window)-1].Timestamp
+		}
+		meta.ReturnedCount = len(window)
+
+		writer.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(writer).Encode(&logsBase{Logs: window, Meta: meta}); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return &requests
+}
+
+func testLog(ts time.Time, level string, status int) Log {
+	return Log{
+		ResourceID:   "xb671a46-66ed-4dfb-b839-543f2c6c0b63",
+		ResourceType: "instances",
+		Level:        level,
+		Message:      fmt.Sprintf("%s %d", level, status),
+		Timestamp:    NewTimestamp(ts),
+		Metadata:     LogMetadata{UserID: "765b8aa0", UserName: "ops", HTTPStatusCode: status, Method: "GET"},
+	}
+}
+
+func TestLogsServiceHandler_Stream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	start := time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC)
+	var expected []Log
+	for i := range 5 {
+		expected = append(expected, testLog(start.Add(time.Duration(i)*time.Second), "Info", 200+i))
+	}
+	requests := serveLogs(t, func() []Log { return expected }, 2)
+
+	var logs []Log
+	for log, err := range client.Logs.Stream(ctx, LogsOptions{StartTime: start, EndTime: start.Add(time.Minute)}) {
+		if err != nil {
+			t.Fatalf("Logs.Stream returned %+v", err)
+		}
+		logs = append(logs, log)
+	}
+
+	if !reflect.DeepEqual(logs, expected) {
+		t.Errorf("Logs.Stream returned %+v, expected %+v", logs, expected)
+	}
+	if *requests != 4 {
+		t.Errorf("Logs.Stream made %d requests, expected 4", *requests)
+	}
+}
+
+func TestLogsServiceHandler_StreamError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/logs", func(writer http.ResponseWriter, request *http.Request) {
+		http.Error(writer, `{"error":"invalid start_time","status":400}`, http.StatusBadRequest)
+	})
+
+	count := 0
+	for _, err := range client.Logs.Stream(ctx, LogsOptions{}) {
+		count++
+		if !IsBadRequest(err) {
+			t.Errorf("Logs.Stream returned %+v, expected a bad request", err)
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("Logs.Stream yielded %d times, expected the error once", count)
+	}
+}
+
+func TestLogsServiceHandler_Follow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	start := time.Now().UTC().Truncate(time.Second).Add(-time.Minute)
+
+	var mu sync.Mutex
+	all := []Log{testLog(start, "Info", 200), testLog(start.Add(time.Second), "Error", 500)}
+	serveLogs(t, func() []Log {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(all)
+	}, 2)
+
+	followCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := client.Logs.Follow(followCtx, LogsOptions{StartTime: start}, &LogsFollowOptions{
+		PollInterval: 10 * time.Millisecond,
+		Filter:       &LogFilter{MinHTTPStatus: 500},
+	})
+
+	first := <-events
+	if first.Err != nil || first.Log != all[1] {
+		t.Fatalf("Logs.Follow sent %+v, expected %+v", first, all[1])
+	}
+
+	mu.Lock()
+	added := testLog(time.Now().UTC().Truncate(time.Second), "Error", 503)
+	all = append(all, added)
+	mu.Unlock()
+
+	select {
+	case next := <-events:
+		if next.Err != nil || next.Log != added {
+			t.Errorf("Logs.Follow sent %+v, expected %+v", next, added)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Logs.Follow didn't send the new log")
+	}
+
+	cancel()
+	for event := range events {
+		t.Errorf("Logs.Follow sent %+v after being canceled", event)
+	}
+}
+
+func TestLogFilter_Match(t *testing.T) {
+	log := testLog(time.Now(), "Error", 404)
+
+	tests := []struct {
+		name   string
+		filter *LogFilter
+		match  bool
+	}{
+		{"nil", nil, true},
+		{"empty", &LogFilter{}, true},
+		{"level", &LogFilter{Levels: []string{"error"}}, true},
+		{"other level", &LogFilter{Levels: []string{"Info", "Debug"}}, false},
+		{"resource type", &LogFilter{ResourceTypes: []string{"instances"}}, true},
+		{"other resource type", &LogFilter{ResourceTypes: []string{"kubernetes"}}, false},
+		{"user ID", &LogFilter{Users: []string{"765b8aa0"}}, true},
+		{"username", &LogFilter{Users: []string{"ops"}}, true},
+		{"other user", &LogFilter{Users: []string{"dev"}}, false},
+		{"status", &LogFilter{HTTPStatusCodes: []int{404, 410}}, true},
+		{"other status", &LogFilter{HTTPStatusCodes: []int{200}}, false},
+		{"min status", &LogFilter{MinHTTPStatus: 400}, true},
+		{"above status", &LogFilter{MinHTTPStatus: 500}, false},
+		{"all", &LogFilter{Levels: []string{"Error"}, Users: []string{"ops"}, MinHTTPStatus: 400}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.Match(&log); got != tt.match {
+			t.Errorf("%s: Match returned %v, expected %v", tt.name, got, tt.match)
+		}
+	}
+}
+
+func TestWriteLogsJSONLines(t *testing.T) {
+	setup()
+	defer teardown()
+
+	start := time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC)
+	all := []Log{testLog(start, "Info", 200), testLog(start.Add(time.Second), "Error", 500), testLog(start.Add(2*time.Second), "Info", 201)}
+	serveLogs(t, func() []Log { return all }, 2)
+
+	var buf bytes.Buffer
+	logs := FilterLogs(client.Logs.Stream(ctx, LogsOptions{StartTime: start}), &LogFilter{Levels: []string{"Info"}})
+	n, err := WriteLogsJSONLines(&buf, logs)
+	if err != nil || n != 2 {
+		t.Fatalf("WriteLogsJSONLines returned %d, %+v, expected 2", n, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	for i, expected := range []Log{all[0], all[2]} {
+		var got Log
+		if err := json.Unmarshal([]byte(lines[i]), &got); err != nil || got != expected {
+			t.Errorf("line %d is %s, expected %+v", i, lines[i], expected)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package govultr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Limit on how much of a response body is read to reuse the connection before a retry
+const retryDrainLimit = 4096
+
+// Handler sends an API request and returns its response. It has the same shape
+// as http.RoundTripper so any RoundTripper can be used as a Handler.
+type Handler interface {
+	RoundTrip(req *http.Request) (*http.Response, error)
+}
+
+// HandlerFunc adapts an ordinary function to a Handler
+type HandlerFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f HandlerFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type attemptKey struct{}
+
+// AttemptFromContext returns which attempt of a request is being sent,
+// starting at 1. Middleware can use it with req.Context().
+func AttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+
+	return 1
+}
+
+// Middleware wraps a Handler to run code before and after the next handler
+// in the chain. A middleware can modify the request, replace the response or
+// return without calling next at all.
+type Middleware func(next Handler) Handler
+
+// Use appends middleware to the end of the client's chain. Middleware added
+// this way runs after the built in middleware, once for every attempt.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// SetMiddleware replaces the client's whole middleware chain, including the
+// built in ErrorMiddleware, DryRunMiddleware, RetryMiddleware,
+// UserAgentMiddleware, AuthMiddleware and LoggingMiddleware. The first
+// middleware is the outermost one.
+func (c *Client) SetMiddleware(middleware ...Middleware) {
+	c.middleware = append([]Middleware(nil), middleware...)
+}
+
+// Middleware returns a copy of the client's middleware chain, outermost first
+func (c *Client) Middleware() []Middleware {
+	return append([]Middleware(nil), c.middleware...)
+}
+
+// handler builds the middleware chain around the HTTP client
+func (c *Client) handler() Handler {
+	var h Handler = HandlerFunc(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+
+	return h
+}
+
+// send is the end of every middleware chain
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	return c.client.HTTPClient.Do(req)
+}
+
+// ErrorMiddleware turns any response outside of the 200 to 206 range into
+// an *APIError. The response is still returned along with the error. Without
+// it such responses are handed back to the service methods as successes.
+func ErrorMiddleware(next Handler) Handler {
+	return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil || (resp.StatusCode >= http.StatusOK && resp.StatusCode <= http.StatusPartialContent) {
+			return resp, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if cerr := resp.Body.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		apiErr := newAPIError(resp, body)
+		apiErr.Method, apiErr.Path = req.Method, req.URL.Path
+
+		return resp, apiErr
+	})
+}
+
+// UserAgentMiddleware sets the User-Agent header to the client's UserAgent
+func (c *Client) UserAgentMiddleware(next Handler) Handler {
+	return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("User-Agent") != c.UserAgent {
+			req = req.Clone(req.Context())
+			req.Header.Set("User-Agent", c.UserAgent)
+		}
+
+		return next.RoundTrip(req)
+	})
+}
+
+// RetryMiddleware retries requests the client's RetryPolicy considers
+// retryable, by default connection errors, 429s and 5xx responses of
+// idempotent requests. The number of retries and the wait between them follow
+// SetRetryLimit and SetRateLimit. Once retries are exhausted a *RetryError
+// wrapping the last error is returned.
+func (c *Client) RetryMiddleware(next Handler) Handler {
+	return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		getBody, err := rewindableBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if key, ok := req.Context().Value(idempotencyKey{}).(string); ok && key != "" {
+			req = req.Clone(req.Context())
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+
+		var resp *http.Response
+		var doErr, checkErr error
+		var shouldRetry bool
+		attempt := 0
+
+		for i := 0; ; i++ {
+			attempt++
+
+			attemptReq := req.Clone(context.WithValue(req.Context(), attemptKey{}, attempt))
+			if getBody != nil {
+				if attemptReq.Body, err = getBody(); err != nil {
+					return nil, err
+				}
+			}
+
+			resp, doErr = next.RoundTrip(attemptReq)
+
+			shouldRetry, checkErr = c.retryPolicy.ShouldRetry(attemptReq, resp, doErr)
+			if !shouldRetry || c.client.RetryMax-i <= 0 {
+				break
+			}
+
+			applied, err := dedupApplied(attemptReq, resp)
+			if err != nil {
+				return nil, err
+			}
+			if applied {
+				return nil, appliedError(resp, doErr, attempt)
+			}
+
+			if doErr == nil {
+				_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, retryDrainLimit))
+				_ = resp.Body.Close()
+			}
+
+			wait := c.retryPolicy.Backoff(c.client.RetryWaitMin, c.client.RetryWaitMax, i, resp)
+			c.logRetry(attemptReq, resp, doErr, wait, c.client.RetryMax-i)
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-req.Context().Done():
+				timer.Stop()
+				return nil, req.Context().Err()
+			case <-timer.C:
+			}
+		}
+
+		if doErr == nil && checkErr == nil && !shouldRetry {
+			return resp, nil
+		}
+
+		err = doErr
+		if checkErr != nil {
+			err = checkErr
+		}
+
+		if c.client.ErrorHandler != nil {
+			return c.client.ErrorHandler(resp, err, attempt)
+		}
+
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		return nil, &RetryError{Attempts: attempt, Err: err}
+	})
+}
+
+// appliedError is returned when a DedupCheck found that a failed request took
+// effect. It wraps ErrRequestApplied and the error of the failed attempt.
+func appliedError(resp *http.Response, doErr error, attempt int) error {
+	err := doErr
+	if resp != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr == nil {
+			err = newAPIError(resp, body)
+		}
+	}
+
+	if err == nil {
+		return &RetryError{Attempts: attempt, Err: ErrRequestApplied}
+	}
+
+	return &RetryError{Attempts: attempt, Err: fmt.Errorf("%w: %w", ErrRequestApplied, err)}
+}
+
+// rewindableBody returns a function that produces a fresh copy of the request
+// body for each attempt, or nil when the request has no body
+func rewindableBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf)), nil
+	}, nil
+}
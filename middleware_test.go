@@ -0,0 +1,155 @@
+package govultr
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Use(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(10 * time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/ssh-keys", func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		if got := request.Header.Get("X-Signature"); got != "signed" {
+			t.Errorf("X-Signature = %q, expected %q", got, "signed")
+		}
+
+		body, _ := io.ReadAll(request.Body)
+		if !strings.Contains(string(body), `"name":"key"`) {
+			t.Errorf("Request body = %s, expected the ssh key on every attempt", body)
+		}
+
+		if attempts == 1 {
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"ssh_key":{"id":"abc","name":"key"}}`)
+	})
+
+	calls := 0
+	client.Use(func(next Handler) Handler {
+		return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			req.Header.Set("X-Signature", "signed")
+			return next.RoundTrip(req)
+		})
+	})
+
+	key, _, err := client.SSHKey.Create(ctx, &SSHKeyReq{Name: "key", SSHKey: "ssh-rsa AAAA"})
+	if err != nil {
+		t.Fatalf("SSHKey.Create returned %+v", err)
+	}
+
+	if key.ID != "abc" {
+		t.Errorf("SSHKey.Create returned %+v, expected id abc", key)
+	}
+
+	if attempts != 2 || calls != 2 {
+		t.Errorf("Middleware ran %d times for %d attempts, expected 2 and 2", calls, attempts)
+	}
+}
+
+func TestClient_UseShortCircuit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		t.Error("expected the middleware to answer without calling the API")
+	})
+
+	client.Use(func(next Handler) Handler {
+		return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			body := `{"account":{"name":"stubbed"}}`
+			return &http.Response{
+				StatusCode:    http.StatusOK,
+				Header:        http.Header{"Content-Type": {"application/json"}},
+				Body:          io.NopCloser(bytes.NewBufferString(body)),
+				ContentLength: int64(len(body)),
+				Request:       req,
+			}, nil
+		})
+	})
+
+	account, _, err := client.Account.Get(ctx)
+	if err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if account.Name != "stubbed" {
+		t.Errorf("Account.Get returned %+v, expected the stubbed account", account)
+	}
+}
+
+func TestClient_SetMiddlewareWithoutRetry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(writer, `{"error":"unavailable","status":503}`)
+	})
+
+	if len(client.Middleware()) != 6 {
+		t.Fatalf("Client.Middleware returned %d middleware, expected the 6 built in", len(client.Middleware()))
+	}
+
+	client.SetMiddleware(ErrorMiddleware, client.UserAgentMiddleware)
+
+	_, resp, err := client.Account.Get(ctx)
+	if !IsServerError(err) {
+		t.Errorf("Account.Get returned %+v, expected a server error", err)
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Account.Get returned %+v, expected the 503 response", resp)
+	}
+
+	if attempts != 1 {
+		t.Errorf("Account.Get made %d attempts, expected 1 without RetryMiddleware", attempts)
+	}
+}
+
+func TestClient_SetMiddlewareWithoutErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	})
+
+	client.SetMiddleware()
+
+	if _, _, err := client.Account.Get(ctx); err != nil {
+		t.Errorf("Account.Get returned %+v, expected no error without ErrorMiddleware", err)
+	}
+}
+
+func TestClient_UserAgentMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+		if ua := request.Header.Get("User-Agent"); ua != "vultr/testing" {
+			t.Errorf("User-Agent = %q, expected %q", ua, "vultr/testing")
+		}
+	})
+
+	client.SetUserAgent("vultr/testing")
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if _, err := client.DoWithContext(ctx, req, nil); err != nil {
+		t.Errorf("DoWithContext returned %+v", err)
+	}
+}
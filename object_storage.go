@@ -38,7 +38,7 @@ type ObjectStorageServiceHandler struct {
 // ObjectStorage represents a Vultr Object Storage subscription.
 type ObjectStorage struct {
 	ID                   string                 `json:"id"`
-	DateCreated          string                 `json:"date_created"`
+	DateCreated          Timestamp              `json:"date_created"`
 	ObjectStoreClusterID int                    `json:"cluster_id"`
 	Region               string                 `json:"region"`
 	Location             string                 `json:"location"`
@@ -105,8 +105,8 @@ type ObjectStorageListTier struct {
 
 // ObjectStorageBucket represents an object storage bucket
 type ObjectStorageBucket struct {
-	Name        string `json:"name"`
-	DateCreated string `json:"date_created"`
+	Name        string    `json:"name"`
+	DateCreated Timestamp `json:"date_created"`
 }
 
 // ObjectStorageBucketReq represents a create request for an object storage
@@ -58,17 +58,17 @@ type OIDCProviderReq struct {
 
 // OIDCIssuer represents an OIDC issuer
 type OIDCIssuer struct {
-	ID              string `json:"id"`
-	Source          string `json:"source"`
-	URI             string `json:"uri"`
-	KTY             string `json:"kty"`
-	KID             string `json:"kid"`
-	N               string `json:"n"`
-	E               string `json:"e"`
-	ALG             string `json:"alg"`
-	USE             string `json:"use"`
-	JWKSFetchedDate string `json:"jwks_fetched_at"`
-	JWKSExpiryDate  string `json:"jwks_expires_at"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	URI             string    `json:"uri"`
+	KTY             string    `json:"kty"`
+	KID             string    `json:"kid"`
+	N               string    `json:"n"`
+	E               string    `json:"e"`
+	ALG             string    `json:"alg"`
+	USE             string    `json:"use"`
+	JWKSFetchedDate Timestamp `json:"jwks_fetched_at"`
+	JWKSExpiryDate  Timestamp `json:"jwks_expires_at"`
 }
 
 type oidcIssuersBase struct {
@@ -0,0 +1,70 @@
+package govultr
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Frames searched for the service method that made a request
+const operationCallerDepth = 8
+
+type operationKey struct{}
+
+// WithOperation returns a context that names the operation of the requests
+// made with it. Requests sent by the service methods are named automatically,
+// e.g. "Instance.Create", so this is only needed for requests built by hand.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext returns the name of the operation a request belongs
+// to, e.g. "Instance.Create". Middleware can use it with req.Context().
+func OperationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
+
+// serviceNames maps the handler types to the name of their Client field
+var serviceNames = sync.OnceValue(func() map[string]string {
+	names := make(map[string]string)
+
+	client := reflect.ValueOf(NewClient(nil)).Elem()
+	for i := 0; i < client.NumField(); i++ {
+		field := client.Type().Field(i)
+		value := client.Field(i)
+		if !field.IsExported() || value.Kind() != reflect.Interface || value.IsNil() {
+			continue
+		}
+
+		names[reflect.Indirect(value.Elem()).Type().Name()] = field.Name
+	}
+
+	return names
+})
+
+// callerOperation walks up the stack to find the service method that is
+// sending a request and returns it as "Service.Method"
+func callerOperation() string {
+	pcs := make([]uintptr, operationCallerDepth)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	prefix := reflect.TypeOf(Client{}).PkgPath() + ".(*"
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, prefix); ok {
+			if handler, method, found := strings.Cut(name, ")."); found {
+				if service, ok := serviceNames()[handler]; ok {
+					method, _, _ = strings.Cut(method, ".")
+					return service + "." + method
+				}
+			}
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package govultr
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestOperationFromContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/abc", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instance":{"id":"abc"}}`)
+	})
+	mux.HandleFunc("/v2/domains/example.com/records", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"records":[],"meta":{"total":0,"links":{"next":"","prev":""}}}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/abc", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"vke_cluster":{"id":"abc"}}`)
+	})
+
+	var operation string
+	client.Use(func(next Handler) Handler {
+		return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			operation = OperationFromContext(req.Context())
+			return next.RoundTrip(req)
+		})
+	})
+
+	tests := []struct {
+		call     func() error
+		expected string
+	}{
+		{func() error { _, _, err := client.Instance.Get(ctx, "abc"); return err }, "Instance.Get"},
+		{func() error { _, _, _, err := client.DomainRecord.List(ctx, "example.com", nil); return err }, "DomainRecord.List"},
+		{func() error { _, _, err := client.Kubernetes.GetCluster(ctx, "abc"); return err }, "Kubernetes.GetCluster"},
+	}
+
+	for _, tt := range tests {
+		if err := tt.call(); err != nil {
+			t.Fatalf("%s returned %+v", tt.expected, err)
+		}
+
+		if operation != tt.expected {
+			t.Errorf("OperationFromContext returned %q, expected %q", operation, tt.expected)
+		}
+	}
+}
+
+func TestWithOperation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {})
+
+	var operation string
+	client.Use(func(next Handler) Handler {
+		return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			operation = OperationFromContext(req.Context())
+			return next.RoundTrip(req)
+		})
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	if _, err := client.DoWithContext(WithOperation(ctx, "Custom.Call"), req, nil); err != nil {
+		t.Fatalf("DoWithContext returned %+v", err)
+	}
+
+	if operation != "Custom.Call" {
+		t.Errorf("OperationFromContext returned %q, expected %q", operation, "Custom.Call")
+	}
+}
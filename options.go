@@ -0,0 +1,303 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClientOption configures a Client created with NewClientWithOptions
+type ClientOption func(*clientConfig) error
+
+type clientConfig struct {
+	httpClient      *http.Client
+	transport       http.RoundTripper
+	timeout         *time.Duration
+	baseURL         *url.URL
+	userAgent       string
+	userAgentSuffix string
+	retryLimit      *int
+	retryWaitMin    time.Duration
+	retryWaitMax    time.Duration
+	retryPolicy     RetryPolicy
+	rateLimiter     *RateLimiter
+	tokenSource     TokenSource
+	logger          *slog.Logger
+	logBodies       bool
+	middleware      []Middleware
+	outerMiddleware []Middleware
+	dryRun          *DryRunPlan
+	validation      *bool
+}
+
+// NewClientWithOptions returns a Vultr API Client configured by the options.
+// Options are validated before the client is built so a client is never left
+// half configured. NewClient and the Set* methods remain for compatibility.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	cfg := &clientConfig{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := cfg.httpClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient()
+	}
+
+	if cfg.transport != nil || cfg.timeout != nil {
+		configured := *httpClient
+		if cfg.transport != nil {
+			configured.Transport = cfg.transport
+		}
+		if cfg.timeout != nil {
+			configured.Timeout = *cfg.timeout
+		}
+		httpClient = &configured
+	}
+
+	client := NewClient(httpClient)
+
+	if cfg.baseURL != nil {
+		client.BaseURL = cfg.baseURL
+	}
+
+	if cfg.userAgent != "" {
+		client.UserAgent = cfg.userAgent
+	}
+	if cfg.userAgentSuffix != "" {
+		client.UserAgent += " " + cfg.userAgentSuffix
+	}
+
+	if cfg.retryLimit != nil {
+		client.SetRetryLimit(*cfg.retryLimit)
+	}
+	if cfg.retryWaitMax > 0 {
+		client.client.RetryWaitMin = cfg.retryWaitMin
+		client.client.RetryWaitMax = cfg.retryWaitMax
+	}
+	if cfg.retryPolicy != nil {
+		client.SetRetryPolicy(cfg.retryPolicy)
+	}
+
+	client.tokenSource = cfg.tokenSource
+	client.logger = cfg.logger
+	client.logBodies = cfg.logBodies
+	client.dryRun = cfg.dryRun
+	if cfg.validation != nil {
+		client.SetValidation(*cfg.validation)
+	}
+	client.SetMiddleware(append(cfg.outerMiddleware, client.Middleware()...)...)
+	client.Use(cfg.middleware...)
+
+	if cfg.rateLimiter != nil {
+		client.SetRateLimiter(cfg.rateLimiter)
+	}
+
+	return client, nil
+}
+
+// WithHTTPClient sets the HTTP client requests are sent with. It is not
+// modified, WithTransport and WithTimeout apply to a copy of it.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *clientConfig) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the transport of the HTTP client
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *clientConfig) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+		c.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of every attempt of a request. Zero means no timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative: %v", timeout)
+		}
+		c.timeout = &timeout
+		return nil
+	}
+}
+
+// WithBaseURL overrides the default base URL
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *clientConfig) error {
+		parsed, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		if parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("base url must be absolute: %q", baseURL)
+		}
+		c.baseURL = parsed
+		return nil
+	}
+}
+
+// WithUserAgent replaces the default user agent
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *clientConfig) error {
+		if userAgent == "" {
+			return errors.New("user agent must not be empty")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithUserAgentSuffix appends a product token, such as "my-tool/1.0", to the user agent
+func WithUserAgentSuffix(suffix string) ClientOption {
+	return func(c *clientConfig) error {
+		c.userAgentSuffix = suffix
+		return nil
+	}
+}
+
+// WithRetryLimit sets how many times a failed request is retried
+func WithRetryLimit(retries int) ClientOption {
+	return func(c *clientConfig) error {
+		if retries < 0 {
+			return fmt.Errorf("retry limit must not be negative: %d", retries)
+		}
+		c.retryLimit = &retries
+		return nil
+	}
+}
+
+// WithRetryWait sets the minimum and maximum wait between retries
+func WithRetryWait(minWait, maxWait time.Duration) ClientOption {
+	return func(c *clientConfig) error {
+		if minWait < 0 || maxWait <= 0 || minWait > maxWait {
+			return fmt.Errorf("invalid retry wait: min %v, max %v", minWait, maxWait)
+		}
+		c.retryWaitMin, c.retryWaitMax = minWait, maxWait
+		return nil
+	}
+}
+
+// WithRetryPolicy sets which failed requests are retried, see SetRetryPolicy
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *clientConfig) error {
+		if policy == nil {
+			return errors.New("retry policy must not be nil")
+		}
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter attaches a client side rate limiter, see SetRateLimiter
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *clientConfig) error {
+		if limiter == nil {
+			return errors.New("rate limiter must not be nil")
+		}
+		c.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithTokenSource sets where the bearer token for every request comes from
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *clientConfig) error {
+		if ts == nil {
+			return errors.New("token source must not be nil")
+		}
+		c.tokenSource = ts
+		return nil
+	}
+}
+
+// WithAPIKey authenticates every request with the API key
+func WithAPIKey(apiKey string) ClientOption {
+	return func(c *clientConfig) error {
+		if apiKey == "" {
+			return errors.New("api key must not be empty")
+		}
+		c.tokenSource = NewAPIKeyTokenSource(apiKey)
+		return nil
+	}
+}
+
+// WithLogger sets a structured logger, see SetLogger
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *clientConfig) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithLogBodies enables logging of redacted headers and bodies, see SetLogBodies
+func WithLogBodies(enabled bool) ClientOption {
+	return func(c *clientConfig) error {
+		c.logBodies = enabled
+		return nil
+	}
+}
+
+// WithDryRun puts the client in dry-run mode, capturing mutating requests in
+// plan. Service methods that return a resource return a nil one for them, see
+// SetDryRun.
+func WithDryRun(plan *DryRunPlan) ClientOption {
+	return func(c *clientConfig) error {
+		if plan == nil {
+			return errors.New("dry-run plan must not be nil")
+		}
+
+		c.dryRun = plan
+		return nil
+	}
+}
+
+// WithValidation turns client side validation of request bodies on or off,
+// see SetValidation
+func WithValidation(enabled bool) ClientOption {
+	return func(c *clientConfig) error {
+		c.validation = &enabled
+		return nil
+	}
+}
+
+// WithMiddleware appends middleware to the client's chain, see Use
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *clientConfig) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		c.middleware = append(c.middleware, middleware...)
+		return nil
+	}
+}
+
+// WithOuterMiddleware puts middleware in front of the client's chain, ahead of
+// the built in middleware, so it sees every call once however often it is
+// retried. The first middleware is the outermost one.
+func WithOuterMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *clientConfig) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		c.outerMiddleware = append(c.outerMiddleware, middleware...)
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+package govultr
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var userAgent, authorization string
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		userAgent = request.Header.Get("User-Agent")
+		authorization = request.Header.Get("Authorization")
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"account":{"name":"options"}}`)
+	})
+
+	var logs bytes.Buffer
+	limiter := NewRateLimiter(100, 10)
+	called := false
+
+	c, err := NewClientWithOptions(
+		WithBaseURL(server.URL),
+		WithUserAgentSuffix("my-tool/1.0"),
+		WithAPIKey("option-key"),
+		WithRetryLimit(1),
+		WithRetryWait(time.Millisecond, 10*time.Millisecond),
+		WithTimeout(5*time.Second),
+		WithRateLimiter(limiter),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+		WithMiddleware(func(next Handler) Handler {
+			return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+				called = true
+				return next.RoundTrip(req)
+			})
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	account, _, err := c.Account.Get(ctx)
+	if err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if account.Name != "options" {
+		t.Errorf("Account.Get returned %+v, expected the options account", account)
+	}
+
+	if expected := c.UserAgent; userAgent != expected || !strings.HasSuffix(userAgent, " my-tool/1.0") {
+		t.Errorf("User-Agent = %q, expected %q ending in my-tool/1.0", userAgent, expected)
+	}
+
+	if authorization != "Bearer option-key" {
+		t.Errorf("Authorization = %q, expected %q", authorization, "Bearer option-key")
+	}
+
+	if c.client.RetryMax != 1 || c.client.RetryWaitMin != time.Millisecond || c.client.RetryWaitMax != 10*time.Millisecond {
+		t.Errorf("Retry policy = %d %v %v, expected 1 1ms 10ms", c.client.RetryMax, c.client.RetryWaitMin, c.client.RetryWaitMax)
+	}
+
+	if c.client.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, expected %v", c.client.HTTPClient.Timeout, 5*time.Second)
+	}
+
+	if c.RateLimiter() != limiter || limiter.Stats().Requests != 1 {
+		t.Errorf("RateLimiter = %+v, expected the limiter to see 1 request", c.RateLimiter())
+	}
+
+	if !called {
+		t.Error("expected the middleware option to be used")
+	}
+
+	if !strings.Contains(logs.String(), "vultr api request") {
+		t.Errorf("Logger output = %q, expected the request to be logged", logs.String())
+	}
+}
+
+func TestNewClientWithOptions_OuterMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		if attempts == 1 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"account":{"name":"outer"}}`)
+	})
+
+	var order []string
+	named := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return HandlerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	c, err := NewClientWithOptions(
+		WithBaseURL(server.URL),
+		WithMiddleware(named("inner")),
+		WithOuterMiddleware(named("outer"), named("second")),
+		WithRetryLimit(1),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if expected := []string{"outer", "second", "inner", "inner"}; !slices.Equal(order, expected) {
+		t.Errorf("middleware ran in order %v, expected %v", order, expected)
+	}
+}
+
+func TestNewClientWithOptions_HTTPClientNotModified(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	transport := &http.Transport{}
+
+	c, err := NewClientWithOptions(WithHTTPClient(httpClient), WithTransport(transport), WithTimeout(0))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	if c.client.HTTPClient.Transport != transport || c.client.HTTPClient.Timeout != 0 {
+		t.Errorf("HTTPClient = %+v, expected the transport and no timeout", c.client.HTTPClient)
+	}
+
+	if httpClient.Transport != nil || httpClient.Timeout != time.Second {
+		t.Errorf("HTTPClient = %+v, expected the given client to be left alone", httpClient)
+	}
+}
+
+func TestNewClientWithOptions_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  ClientOption
+	}{
+		{"nil http client", WithHTTPClient(nil)},
+		{"nil transport", WithTransport(nil)},
+		{"negative timeout", WithTimeout(-time.Second)},
+		{"relative base url", WithBaseURL("api.vultr.com")},
+		{"unparsable base url", WithBaseURL(":")},
+		{"empty user agent", WithUserAgent("")},
+		{"negative retry limit", WithRetryLimit(-1)},
+		{"inverted retry wait", WithRetryWait(time.Second, time.Millisecond)},
+		{"nil retry policy", WithRetryPolicy(nil)},
+		{"nil dry-run plan", WithDryRun(nil)},
+		{"nil rate limiter", WithRateLimiter(nil)},
+		{"nil token source", WithTokenSource(nil)},
+		{"empty api key", WithAPIKey("")},
+		{"nil middleware", WithMiddleware(nil)},
+		{"nil outer middleware", WithOuterMiddleware(nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClientWithOptions(tt.opt)
+			if err == nil {
+				t.Errorf("NewClientWithOptions returned %+v, expected an error", c)
+			}
+		})
+	}
+}
+
+func TestNewClientWithOptions_Defaults(t *testing.T) {
+	c, err := NewClientWithOptions()
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	if c.BaseURL.String() != defaultBase || c.UserAgent != userAgent {
+		t.Errorf("NewClientWithOptions returned %v %q, expected the defaults", c.BaseURL, c.UserAgent)
+	}
+
+	if c.client.RetryMax != retryLimit || c.client.HTTPClient.Timeout != 60*time.Second {
+		t.Errorf("NewClientWithOptions returned %d retries and a %v timeout, expected the defaults", c.client.RetryMax, c.client.HTTPClient.Timeout)
+	}
+
+	if len(c.Middleware()) != 6 {
+		t.Errorf("NewClientWithOptions returned %d middleware, expected the 6 built in", len(c.Middleware()))
+	}
+}
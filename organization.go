@@ -119,10 +119,10 @@ type organizationsBase struct {
 
 // Organization represents an organization
 type Organization struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	DateCreated string `json:"date_created"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	DateCreated Timestamp `json:"date_created"`
 }
 
 // OrganizationReq represents an organization modification request
@@ -144,9 +144,9 @@ type OrganizationInvitation struct {
 	Status           string                            `json:"status"`
 	StatusFull       string                            `json:"status_full"`
 	StatusInvite     string                            `json:"invite_status"`
-	DateCreated      string                            `json:"date_created"`
-	DateResponded    string                            `json:"date_responded"`
-	DateExpiration   string                            `json:"expiration_date"`
+	DateCreated      Timestamp                         `json:"date_created"`
+	DateResponded    Timestamp                         `json:"date_responded"`
+	DateExpiration   Timestamp                         `json:"expiration_date"`
 }
 
 type invitationsBase struct {
@@ -167,15 +167,15 @@ type OrganizationInvitationPermission struct {
 
 // OrganizationUser represents an organization user
 type OrganizationUser struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	FirstName      string `json:"first_name,omitempty"`
-	LastName       string `json:"last_name,omitempty"`
-	Email          string `json:"email"`
-	AssignmentType string `json:"assignment_type,omitempty"`
-	DateCreated    string `json:"date_created"`
-	DateSuspended  string `json:"date_suspended"`
-	DateAssigned   string `json:"assigned_date,omitempty"`
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	FirstName      string    `json:"first_name,omitempty"`
+	LastName       string    `json:"last_name,omitempty"`
+	Email          string    `json:"email"`
+	AssignmentType string    `json:"assignment_type,omitempty"`
+	DateCreated    Timestamp `json:"date_created"`
+	DateSuspended  Timestamp `json:"date_suspended"`
+	DateAssigned   Timestamp `json:"assigned_date,omitempty"`
 }
 
 type organizationUsersBase struct {
@@ -189,8 +189,8 @@ type OrganizationGroup struct {
 	Name         string             `json:"display_name"`
 	Description  string             `json:"description"`
 	Members      []OrganizationUser `json:"members"`
-	DateCreated  string             `json:"date_created"`
-	DateAssigned string             `json:"assigned_date,omitempty"`
+	DateCreated  Timestamp          `json:"date_created"`
+	DateAssigned Timestamp          `json:"assigned_date,omitempty"`
 }
 
 type organizationGroupBase struct {
@@ -222,7 +222,7 @@ type OrganizationRole struct {
 	Type               string               `json:"role_type"`
 	MaxSessionDuration int                  `json:"max_session_duration"`
 	Policies           []OrganizationPolicy `json:"policies"`
-	DateCreated        string               `json:"date_created"`
+	DateCreated        Timestamp            `json:"date_created"`
 }
 
 type organizationRoleBase struct {
@@ -256,11 +256,11 @@ type OrganizationRoleReq struct {
 
 // OrganizationGroupRole represents an organization group role
 type OrganizationGroupRole struct {
-	ID          string `json:"id"`
-	Name        string `json:"display_name"`
-	Description string `json:"description"`
-	Type        string `json:"role_type"`
-	DateCreated string `json:"date_assigned"`
+	ID          string    `json:"id"`
+	Name        string    `json:"display_name"`
+	Description string    `json:"description"`
+	Type        string    `json:"role_type"`
+	DateCreated Timestamp `json:"date_assigned"`
 }
 
 type organizationGroupRolesBase struct {
@@ -285,7 +285,7 @@ type OrganizationPolicy struct {
 	SystemPolicy bool                       `json:"is_system_policy"`
 	RoleID       string                     `json:"from_role,omitempty"`
 	Source       string                     `json:"source,omitempty"`
-	DateCreated  string                     `json:"date_created"`
+	DateCreated  Timestamp                  `json:"date_created"`
 }
 
 type organizationPoliciesBase struct {
@@ -316,13 +316,13 @@ type OrganizationRolePolicyReq struct {
 
 // OrganizationRolePolicyAttachment represents a role policy attachment
 type OrganizationRolePolicyAttachment struct {
-	PolicyID        string `json:"policy_id"`
-	PolicyName      string `json:"policy_name"`
-	RoleID          string `json:"role_id"`
-	RoleDescription string `json:"role_description"`
-	RoleType        string `json:"role_type"`
-	DateAssigned    string `json:"date_assigned"`
-	AssignedBy      string `json:"assigned_by"`
+	PolicyID        string    `json:"policy_id"`
+	PolicyName      string    `json:"policy_name"`
+	RoleID          string    `json:"role_id"`
+	RoleDescription string    `json:"role_description"`
+	RoleType        string    `json:"role_type"`
+	DateAssigned    Timestamp `json:"date_assigned"`
+	AssignedBy      string    `json:"assigned_by"`
 }
 
 type organizationRolePolicyAttachmentsBase struct {
@@ -417,12 +417,12 @@ type organizationGroupPoliciesBase struct {
 
 // OrganizationRoleUserAssignment represents an organization role user assignment
 type OrganizationRoleUserAssignment struct {
-	UserID          string `json:"user_id"`
-	RoleID          string `json:"role_id"`
-	RoleName        string `json:"role_name"`
-	RoleDescription string `json:"role_description"`
-	RoleType        string `json:"role_type"`
-	DateCreated     string `json:"date_assigned"`
+	UserID          string    `json:"user_id"`
+	RoleID          string    `json:"role_id"`
+	RoleName        string    `json:"role_name"`
+	RoleDescription string    `json:"role_description"`
+	RoleType        string    `json:"role_type"`
+	DateCreated     Timestamp `json:"date_assigned"`
 }
 
 type organizationRoleUserAssignmentsBase struct {
@@ -432,13 +432,13 @@ type organizationRoleUserAssignmentsBase struct {
 
 // OrganizationRoleGroupAssignment represents an organization role group assignment
 type OrganizationRoleGroupAssignment struct {
-	GroupID         string `json:"group_id"`
-	GroupName       string `json:"group_name"`
-	RoleID          string `json:"role_id"`
-	RoleName        string `json:"role_name"`
-	RoleDescription string `json:"role_description"`
-	RoleType        string `json:"role_type"`
-	DateCreated     string `json:"date_assigned"`
+	GroupID         string    `json:"group_id"`
+	GroupName       string    `json:"group_name"`
+	RoleID          string    `json:"role_id"`
+	RoleName        string    `json:"role_name"`
+	RoleDescription string    `json:"role_description"`
+	RoleType        string    `json:"role_type"`
+	DateCreated     Timestamp `json:"date_assigned"`
 }
 
 type organizationRoleGroupAssignmentsBase struct {
@@ -448,21 +448,21 @@ type organizationRoleGroupAssignmentsBase struct {
 
 // OrganizationPolicyUserAssignment represents an organization user policy assignment
 type OrganizationPolicyUserAssignment struct {
-	UserID            string `json:"user_id"`
-	PolicyID          string `json:"policy_id"`
-	PolicyName        string `json:"policy_name"`
-	PolicyDescription string `json:"policy_description"`
-	DateCreated       string `json:"date_assigned"`
+	UserID            string    `json:"user_id"`
+	PolicyID          string    `json:"policy_id"`
+	PolicyName        string    `json:"policy_name"`
+	PolicyDescription string    `json:"policy_description"`
+	DateCreated       Timestamp `json:"date_assigned"`
 }
 
 // OrganizationPolicyGroupAssignment represents an organization group policy assignment
 type OrganizationPolicyGroupAssignment struct {
-	GroupID           string `json:"group_id"`
-	GroupName         string `json:"group_name"`
-	PolicyID          string `json:"policy_id"`
-	PolicyName        string `json:"policy_name"`
-	PolicyDescription string `json:"policy_description"`
-	DateCreated       string `json:"date_assigned"`
+	GroupID           string    `json:"group_id"`
+	GroupName         string    `json:"group_name"`
+	PolicyID          string    `json:"policy_id"`
+	PolicyName        string    `json:"policy_name"`
+	PolicyDescription string    `json:"policy_description"`
+	DateCreated       Timestamp `json:"date_assigned"`
 }
 
 // OrganizationRoleTrust represents an organization role trust
@@ -475,8 +475,8 @@ type OrganizationRoleTrust struct {
 	GroupID     string                         `json:"trusted_group_id"`
 	GroupName   string                         `json:"group_display"`
 	Conditions  OrganizationRoleTrustCondition `json:"conditions"`
-	DateExpires string                         `json:"valid_until"`
-	DateCreated string                         `json:"date_created"`
+	DateExpires Timestamp                      `json:"valid_until"`
+	DateCreated Timestamp                      `json:"date_created"`
 }
 
 // OrganizationRoleTrustCondition represents a organization role trust condition
@@ -512,7 +512,7 @@ type OrganizationRoleTrustCreateReq struct {
 	GroupID     string                             `json:"trusted_group_id,omitempty"`
 	Type        string                             `json:"trust_type"`
 	Conditions  *OrganizationRoleTrustReqCondition `json:"conditions,omitempty"`
-	DateExpires *string                            `json:"valid_until,omitempty"`
+	DateExpires *Timestamp                         `json:"valid_until,omitempty"`
 }
 
 // OrganizationRoleTrustReqCondition represents a organization role trust create request condition
@@ -534,7 +534,7 @@ type OrganizationRoleTrustUpdateReq struct {
 	GroupID     string                             `json:"trusted_group_id,omitempty"`
 	Type        string                             `json:"trust_type,omitempty"`
 	Conditions  *OrganizationRoleTrustReqCondition `json:"conditions,omitempty"`
-	DateExpires *string                            `json:"valid_until,omitempty"`
+	DateExpires *Timestamp                         `json:"valid_until,omitempty"`
 }
 
 // OrganizationRoleAssumedReq represents an organization assumed role
@@ -553,16 +553,16 @@ type OrganizationRoleSessionReqContext struct {
 
 // OrganizationRoleSession represents an organization role session
 type OrganizationRoleSession struct {
-	Token             string   `json:"session_token"`
-	RoleID            string   `json:"role_id"`
-	UserID            string   `json:"user_id"`
-	SessionName       string   `json:"session_name"`
-	AuthMethod        string   `json:"auth_method"`
-	RemainingDuration int      `json:"remaining_duration"`
-	ConditionsMet     []string `json:"conditions_met"`
-	SourceIP          string   `json:"source_ip"`
-	DateExpires       string   `json:"expires_at"`
-	DateAssumed       string   `json:"assumed_at"`
+	Token             string    `json:"session_token"`
+	RoleID            string    `json:"role_id"`
+	UserID            string    `json:"user_id"`
+	SessionName       string    `json:"session_name"`
+	AuthMethod        string    `json:"auth_method"`
+	RemainingDuration int       `json:"remaining_duration"`
+	ConditionsMet     []string  `json:"conditions_met"`
+	SourceIP          string    `json:"source_ip"`
+	DateExpires       Timestamp `json:"expires_at"`
+	DateAssumed       Timestamp `json:"assumed_at"`
 }
 
 type organizationRoleSessionsBase struct {
@@ -0,0 +1,312 @@
+// Package otelgovultr adds OpenTelemetry tracing and metrics to a govultr
+// Client. It lives in its own module so the core client does not depend on
+// OpenTelemetry.
+//
+//	client, err := govultr.NewClientWithOptions(
+//		govultr.WithAPIKey(apiKey),
+//		otelgovultr.ClientOption(),
+//	)
+//
+// Instrument adds the same instrumentation to a client that is already built.
+//
+// Every service method call gets a client span named after it, e.g.
+// "Instance.Create", carrying the HTTP method, path, status code, resource
+// IDs, retry count and Vultr request ID. Latency, errors, retries and rate
+// limit hits are recorded as metrics.
+package otelgovultr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// ScopeName is the instrumentation scope used for the tracer and meter
+const ScopeName = "github.com/vultr/govultr/v3/otelgovultr"
+
+// Attribute keys set on spans and metrics
+const (
+	OperationKey   = attribute.Key("vultr.operation")
+	ResourceIDsKey = attribute.Key("vultr.resource.ids")
+	RetryCountKey  = attribute.Key("vultr.retry_count")
+	RequestIDKey   = attribute.Key("vultr.request_id")
+
+	httpMethodKey = attribute.Key("http.request.method")
+	httpStatusKey = attribute.Key("http.response.status_code")
+	urlPathKey    = attribute.Key("url.path")
+	serverKey     = attribute.Key("server.address")
+)
+
+// Response headers that may carry the Vultr request ID
+var requestIDHeaders = []string{"X-Request-Id", "X-Vultr-Request-Id"}
+
+// Vultr resource IDs are UUIDs
+var resourceIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider, the global one is used by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider, the global one is used by default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+type instruments struct {
+	tracer      trace.Tracer
+	duration    metric.Float64Histogram
+	requests    metric.Int64Counter
+	errors      metric.Int64Counter
+	retries     metric.Int64Counter
+	rateLimited metric.Int64Counter
+}
+
+// callState is shared between the span middleware and the attempt middleware
+// of a single call
+type callState struct {
+	attempts    atomic.Int64
+	rateLimited atomic.Int64
+	requestID   atomic.Value
+}
+
+type callStateKey struct{}
+
+// Instrument adds tracing and metrics to client. The span middleware is put
+// in front of the client's chain so a span covers every retry of a call, and
+// an attempt middleware is appended to the end of it to count retries and rate
+// limit hits.
+func Instrument(client *govultr.Client, opts ...Option) error {
+	outer, inner, err := Middleware(opts...)
+	if err != nil {
+		return err
+	}
+
+	client.SetMiddleware(append([]govultr.Middleware{outer}, client.Middleware()...)...)
+	client.Use(inner)
+
+	return nil
+}
+
+// ClientOption instruments a client as it is built by
+// govultr.NewClientWithOptions. Like Instrument, it puts the span middleware
+// in front of the client's chain and appends the attempt middleware to it.
+func ClientOption(opts ...Option) govultr.ClientOption {
+	outer, inner, err := Middleware(opts...)
+
+	return combine(err, govultr.WithOuterMiddleware(outer), govultr.WithMiddleware(inner))
+}
+
+// combine returns an option that fails with err when it is set, and applies
+// opts in order otherwise. It is generic over the argument of the options
+// because govultr doesn't export the config its ClientOption configures.
+func combine[C any](err error, opts ...func(C) error) func(C) error {
+	return func(c C) error {
+		if err != nil {
+			return err
+		}
+
+		for _, opt := range opts {
+			if err := opt(c); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Middleware returns the span middleware, which belongs at the front of a
+// client's chain, and the attempt middleware, which belongs at the end of it.
+// Use it instead of Instrument to place them in a custom chain.
+func Middleware(opts ...Option) (outer, inner govultr.Middleware, err error) {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	inst, err := newInstruments(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inst.callMiddleware, inst.attemptMiddleware, nil
+}
+
+func newInstruments(cfg *config) (*instruments, error) {
+	meter := cfg.meterProvider.Meter(ScopeName)
+	inst := &instruments{tracer: cfg.tracerProvider.Tracer(ScopeName)}
+
+	var err error
+	if inst.duration, err = meter.Float64Histogram("govultr.client.request.duration",
+		metric.WithUnit("s"), metric.WithDescription("Duration of Vultr API calls, including retries")); err != nil {
+		return nil, err
+	}
+
+	if inst.requests, err = meter.Int64Counter("govultr.client.requests",
+		metric.WithDescription("Number of Vultr API calls")); err != nil {
+		return nil, err
+	}
+
+	if inst.errors, err = meter.Int64Counter("govultr.client.errors",
+		metric.WithDescription("Number of Vultr API calls that returned an error")); err != nil {
+		return nil, err
+	}
+
+	if inst.retries, err = meter.Int64Counter("govultr.client.retries",
+		metric.WithDescription("Number of retried Vultr API requests")); err != nil {
+		return nil, err
+	}
+
+	if inst.rateLimited, err = meter.Int64Counter("govultr.client.rate_limited",
+		metric.WithDescription("Number of Vultr API responses with a 429 status code")); err != nil {
+		return nil, err
+	}
+
+	return inst, nil
+}
+
+func (i *instruments) callMiddleware(next govultr.Handler) govultr.Handler {
+	return govultr.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		operation := govultr.OperationFromContext(req.Context())
+		if operation == "" {
+			operation = req.Method + " " + req.URL.Path
+		}
+
+		ctx, span := i.tracer.Start(req.Context(), operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				OperationKey.String(operation),
+				httpMethodKey.String(req.Method),
+				urlPathKey.String(req.URL.Path),
+				serverKey.String(req.URL.Hostname()),
+			),
+		)
+		defer span.End()
+
+		if ids := resourceIDs(req.URL.Path); len(ids) > 0 {
+			span.SetAttributes(ResourceIDsKey.StringSlice(ids))
+		}
+
+		state := &callState{}
+		ctx = context.WithValue(ctx, callStateKey{}, state)
+
+		start := time.Now()
+		resp, err := next.RoundTrip(req.WithContext(ctx))
+		elapsed := time.Since(start)
+
+		retries := max(state.attempts.Load()-1, 0)
+		attrs := []attribute.KeyValue{OperationKey.String(operation), httpMethodKey.String(req.Method)}
+
+		span.SetAttributes(RetryCountKey.Int64(retries))
+		if id, ok := state.requestID.Load().(string); ok {
+			span.SetAttributes(RequestIDKey.String(id))
+		}
+
+		if status := statusCode(resp, err); status != 0 {
+			span.SetAttributes(httpStatusKey.Int(status))
+			attrs = append(attrs, httpStatusKey.Int(status))
+		}
+
+		measure := metric.WithAttributes(attrs...)
+		i.requests.Add(ctx, 1, measure)
+		i.duration.Record(ctx, elapsed.Seconds(), measure)
+		if retries > 0 {
+			i.retries.Add(ctx, retries, measure)
+		}
+		if limited := state.rateLimited.Load(); limited > 0 {
+			i.rateLimited.Add(ctx, limited, measure)
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			i.errors.Add(ctx, 1, measure)
+		}
+
+		return resp, err
+	})
+}
+
+func (i *instruments) attemptMiddleware(next govultr.Handler) govultr.Handler {
+	return govultr.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		state, ok := req.Context().Value(callStateKey{}).(*callState)
+		if !ok {
+			return next.RoundTrip(req)
+		}
+
+		state.attempts.Add(1)
+
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			state.rateLimited.Add(1)
+		}
+
+		for _, header := range requestIDHeaders {
+			if id := resp.Header.Get(header); id != "" {
+				state.requestID.Store(id)
+				break
+			}
+		}
+
+		return resp, err
+	})
+}
+
+// statusCode returns the status of the final response, which is only
+// available through the error once retries are exhausted
+func statusCode(resp *http.Response, err error) int {
+	if resp != nil {
+		return resp.StatusCode
+	}
+
+	var apiErr *govultr.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	return 0
+}
+
+// resourceIDs returns every path segment that looks like a Vultr resource ID
+func resourceIDs(path string) []string {
+	var ids []string
+	for _, segment := range strings.Split(path, "/") {
+		if resourceIDPattern.MatchString(segment) {
+			ids = append(ids, segment)
+		}
+	}
+
+	return ids
+}
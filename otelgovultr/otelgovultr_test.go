@@ -0,0 +1,189 @@
+package otelgovultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const instanceID = "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"
+
+func setup(t *testing.T, handler http.HandlerFunc) (*govultr.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := govultr.NewClient(nil)
+	if err := client.SetBaseURL(server.URL); err != nil {
+		t.Fatal(err)
+	}
+	client.SetRateLimit(10 * time.Millisecond)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	err := Instrument(client,
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("Instrument returned %+v", err)
+	}
+
+	return client, recorder, reader
+}
+
+func TestInstrument(t *testing.T) {
+	attempts := 0
+	client, recorder, reader := setup(t, func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		writer.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", attempts))
+		if attempts == 1 {
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintf(writer, `{"instance":{"id":%q}}`, instanceID)
+	})
+
+	if _, _, err := client.Instance.Get(context.Background(), instanceID); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, expected 1", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name() != "Instance.Get" {
+		t.Errorf("span name = %q, expected %q", span.Name(), "Instance.Get")
+	}
+
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+
+	expected := map[attribute.Key]any{
+		OperationKey:   "Instance.Get",
+		httpMethodKey:  http.MethodGet,
+		urlPathKey:     "/v2/instances/" + instanceID,
+		httpStatusKey:  int64(http.StatusOK),
+		RetryCountKey:  int64(1),
+		RequestIDKey:   "req-2",
+		ResourceIDsKey: []string{instanceID},
+	}
+
+	for key, value := range expected {
+		if got := attrs[key].AsInterface(); !reflect.DeepEqual(got, value) {
+			t.Errorf("span attribute %s = %v, expected %v", key, got, value)
+		}
+	}
+
+	sums := collectSums(t, reader)
+	for name, value := range map[string]int64{
+		"govultr.client.requests":     1,
+		"govultr.client.retries":      1,
+		"govultr.client.rate_limited": 1,
+		"govultr.client.errors":       0,
+	} {
+		if sums[name] != value {
+			t.Errorf("metric %s = %d, expected %d", name, sums[name], value)
+		}
+	}
+}
+
+func TestInstrument_Error(t *testing.T) {
+	client, recorder, reader := setup(t, func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"Invalid instance-id.","status":404}`)
+	})
+
+	if _, _, err := client.Instance.Get(context.Background(), instanceID); !govultr.IsNotFound(err) {
+		t.Fatalf("Instance.Get returned %+v, expected a not found error", err)
+	}
+
+	span := recorder.Ended()[0]
+	if span.Status().Code != codes.Error {
+		t.Errorf("span status = %v, expected %v", span.Status().Code, codes.Error)
+	}
+
+	if sums := collectSums(t, reader); sums["govultr.client.errors"] != 1 {
+		t.Errorf("metric govultr.client.errors = %d, expected 1", sums["govultr.client.errors"])
+	}
+}
+
+func TestClientOption(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		if attempts == 1 {
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"instance":{"id":%q}}`, instanceID)
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := govultr.NewClientWithOptions(
+		govultr.WithBaseURL(server.URL),
+		govultr.WithRetryWait(time.Millisecond, 10*time.Millisecond),
+		ClientOption(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+			WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned %+v", err)
+	}
+
+	if _, _, err := client.Instance.Get(context.Background(), instanceID); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	if spans := recorder.Ended(); len(spans) != 1 || spans[0].Name() != "Instance.Get" {
+		t.Fatalf("recorded %+v, expected one Instance.Get span covering both attempts", spans)
+	}
+
+	if sums := collectSums(t, reader); sums["govultr.client.requests"] != 1 || sums["govultr.client.retries"] != 1 {
+		t.Errorf("metrics = %v, expected 1 request and 1 retry", sums)
+	}
+}
+
+func collectSums(t *testing.T, reader *sdkmetric.ManualReader) map[string]int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect returned %+v", err)
+	}
+
+	sums := map[string]int64{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					sums[m.Name] += point.Value
+				}
+			}
+		}
+	}
+
+	return sums
+}
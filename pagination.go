@@ -0,0 +1,71 @@
+package govultr
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// ListFunc is the signature shared by the list calls that paginate with ListOptions
+type ListFunc[T any] func(ctx context.Context, options *ListOptions) ([]T, *Meta, *http.Response, error)
+
+// Paginate returns an iterator over every item of a paginated list call. The
+// cursor in Meta.Links.Next is followed until the last page has been read,
+// the loop is exited early or the context is canceled. The options passed in
+// are copied, so PerPage and any filters are reused for each page while the
+// caller's struct is left untouched. Errors are yielded once and end the
+// iteration.
+//
+// List calls that take extra arguments can be wrapped in a closure:
+//
+//	records := Paginate(ctx, nil, func(ctx context.Context, o *ListOptions) ([]DomainRecord, *Meta, *http.Response, error) {
+//		return client.DomainRecord.List(ctx, "example.com", o)
+//	})
+func Paginate[T any](ctx context.Context, options *ListOptions, list ListFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var opts ListOptions
+		if options != nil {
+			opts = *options
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			items, meta, _, err := list(ctx, &opts)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for i := range items {
+				if !yield(items[i], nil) {
+					return
+				}
+			}
+
+			if meta == nil || meta.Links == nil || meta.Links.Next == "" || meta.Links.Next == opts.Cursor {
+				return
+			}
+
+			opts.Cursor = meta.Links.Next
+		}
+	}
+}
+
+// CollectAll reads every page of a paginated list call into a single slice
+func CollectAll[T any](ctx context.Context, options *ListOptions, list ListFunc[T]) ([]T, error) {
+	var all []T
+	for item, err := range Paginate(ctx, options, list) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+
+	return all, nil
+}
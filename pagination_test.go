@@ -0,0 +1,138 @@
+package govultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func paginatedSSHKeysHandler(t *testing.T, pages *int) func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		*pages++
+		if perPage := request.URL.Query().Get("per_page"); perPage != "2" {
+			t.Errorf("per_page = %q, expected %q", perPage, "2")
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		switch request.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(writer, `{"ssh_keys":[{"id":"a"},{"id":"b"}],"meta":{"total":5,"links":{"next":"page2","prev":""}}}`)
+		case "page2":
+			fmt.Fprint(writer, `{"ssh_keys":[{"id":"c"},{"id":"d"}],"meta":{"total":5,"links":{"next":"page3","prev":"page1"}}}`)
+		case "page3":
+			fmt.Fprint(writer, `{"ssh_keys":[{"id":"e"}],"meta":{"total":5,"links":{"next":"","prev":"page2"}}}`)
+		default:
+			t.Errorf("unexpected cursor %q", request.URL.Query().Get("cursor"))
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pages := 0
+	mux.HandleFunc("/v2/ssh-keys", paginatedSSHKeysHandler(t, &pages))
+
+	options := &ListOptions{PerPage: 2}
+
+	var ids []string
+	for key, err := range Paginate(ctx, options, client.SSHKey.List) {
+		if err != nil {
+			t.Fatalf("Paginate returned %+v", err)
+		}
+		ids = append(ids, key.ID)
+	}
+
+	expected := []string{"a", "b", "c", "d", "e"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Paginate returned %+v, expected %+v", ids, expected)
+	}
+
+	if pages != 3 {
+		t.Errorf("Paginate requested %d pages, expected 3", pages)
+	}
+
+	if options.Cursor != "" {
+		t.Errorf("Paginate modified the caller options: %+v", options)
+	}
+}
+
+func TestPaginate_EarlyStop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pages := 0
+	mux.HandleFunc("/v2/ssh-keys", paginatedSSHKeysHandler(t, &pages))
+
+	var ids []string
+	for key, err := range Paginate(ctx, &ListOptions{PerPage: 2}, client.SSHKey.List) {
+		if err != nil {
+			t.Fatalf("Paginate returned %+v", err)
+		}
+		ids = append(ids, key.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	if pages != 2 {
+		t.Errorf("Paginate requested %d pages, expected 2", pages)
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/ssh-keys", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(writer, `{"error":"Unauthorized IP address","status":403}`)
+	})
+
+	count := 0
+	for _, err := range Paginate(ctx, nil, client.SSHKey.List) {
+		count++
+		if !IsForbidden(err) {
+			t.Errorf("Paginate returned %+v, expected a forbidden error", err)
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("Paginate yielded %d times, expected 1", count)
+	}
+}
+
+func TestPaginate_Canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err := CollectAll(cctx, nil, client.SSHKey.List)
+	if err != context.Canceled {
+		t.Errorf("CollectAll returned %+v, expected %+v", err, context.Canceled)
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pages := 0
+	mux.HandleFunc("/v2/ssh-keys", paginatedSSHKeysHandler(t, &pages))
+
+	records, err := CollectAll(ctx, &ListOptions{PerPage: 2}, func(ctx context.Context, o *ListOptions) ([]SSHKey, *Meta, *http.Response, error) {
+		return client.SSHKey.List(ctx, o)
+	})
+	if err != nil {
+		t.Fatalf("CollectAll returned %+v", err)
+	}
+
+	if len(records) != 5 {
+		t.Errorf("CollectAll returned %d items, expected 5", len(records))
+	}
+}
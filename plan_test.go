@@ -1,7 +1,6 @@
 package govultr
 
 import (
-	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -11,10 +10,29 @@ func TestPlanServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/plans", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{ "plans":[{ "id": "vc2-16c-64gb", "vcpu_count": 16, "ram": 65536, "disk": 1280, "disk_count": 1, "bandwidth": 10240, "monthly_cost": 320, "type": "vc2", "locations": [  "dfw"]}], "meta": { "total": 19, "links": { "next": "", "prev": "" } }}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/plans", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"plans": [
+		{ 
+			"id": "vc2-16c-64gb", 
+			"vcpu_count": 16, 
+			"ram": 65536, 
+			"disk": 1280, 
+			"disk_count": 1, 
+			"bandwidth": 10240, 
+			"monthly_cost": 320, 
+			"type": "vc2", 
+			"locations": [ "dfw" ]
+		}
+	], 
+	"meta": { 
+		"total": 19, 
+		"links": { 
+			"next": "", 
+			"prev": "" 
+		}
+	}
+}`))
 
 	plans, meta, _, err := client.Plan.List(ctx, "vc2", nil)
 	if err != nil {
@@ -54,12 +72,33 @@ func TestPlanServiceHandler_List(t *testing.T) {
 func TestPlanServiceHandler_GetBareMetalList(t *testing.T) {
 	setup()
 	defer teardown()
-	mux.HandleFunc("/v2/plans-metal", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{ "plans_metal":[{"id": "vbm-4c-32gb","cpu_count": 4,"cpu_threads": 8,"cpu_model": "E3-1270v6","ram": 32768,"disk": 240, "disk_count": 1, "bandwidth": 5120,"monthly_cost": 300,"type": "SSD", "locations": [ "dwf"]}], "meta": { "total": 19, "links": { "next": "", "prev": "" } }}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/plans-metal", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"plans_metal": [
+		{
+			"id": "vbm-4c-32gb",
+			"cpu_count": 4,
+			"cpu_threads": 8,
+			"cpu_model": "E3-1270v6",
+			"ram": 32768,
+			"disk": 240, 
+			"disk_count": 1, 
+			"bandwidth": 5120,
+			"monthly_cost": 300,
+			"type": "SSD", 
+			"locations": ["dwf"]
+		}
+	], 
+	"meta": { 
+		"total": 19, 
+		"links": {
+			"next": "", 
+			"prev": ""
+		}
+	}
+}`))
 
-	bareMetalPlans, meta, _, err := client.Plan.ListBareMetal(ctx, nil)
+	plans, meta, _, err := client.Plan.ListBareMetal(ctx, nil)
 	if err != nil {
 		t.Errorf("Plan.GetBareMetalList returned %+v", err)
 	}
@@ -87,8 +126,8 @@ func TestPlanServiceHandler_GetBareMetalList(t *testing.T) {
 		Links: &Links{},
 	}
 
-	if !reflect.DeepEqual(bareMetalPlans, expectedPlan) {
-		t.Errorf("Plan.GetBareMetalList  returned %+v, expected %+v", bareMetalPlans, expectedPlan)
+	if !reflect.DeepEqual(plans, expectedPlan) {
+		t.Errorf("Plan.GetBareMetalList  returned %+v, expected %+v", plans, expectedPlan)
 	}
 
 	if !reflect.DeepEqual(meta, expectedMeta) {
@@ -0,0 +1,245 @@
+package govultr
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Unix timestamps are used by X-RateLimit-Reset once the value is past this
+// point, smaller values are treated as a number of seconds from now
+const rateLimitResetEpochThreshold = 1_000_000_000
+
+// RateLimiter is a token bucket that limits how fast requests are sent to the
+// Vultr API. Tokens are refilled at a fixed rate up to the burst size and every
+// request, including retries, consumes one. The limiter also pauses requests
+// when the API responds with a Retry-After header or reports that no requests
+// remain through the X-RateLimit-* headers.
+//
+// A RateLimiter is safe for concurrent use. Attach the same limiter to every
+// Client that uses an API key so that all of them share that key's budget.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+
+	blockedUntil time.Time
+	stats        RateLimiterStats
+}
+
+// RateLimiterStats is a snapshot of a RateLimiter's state
+type RateLimiterStats struct {
+	// Configured requests per second and burst size
+	Rate  float64
+	Burst int
+
+	// Tokens currently available, negative when requests are queued
+	Tokens float64
+
+	// Requests that went through the limiter and how many of those had to wait
+	Requests int64
+	Waited   int64
+
+	// Total time spent waiting for a token
+	TotalWait time.Duration
+
+	// Number of 429 responses seen
+	RateLimited int64
+
+	// Requests are paused until this time because of Retry-After or X-RateLimit-* headers
+	BlockedUntil time.Time
+
+	// Last X-RateLimit-Limit and X-RateLimit-Remaining values sent by the API, -1 when never seen
+	Limit     int
+	Remaining int
+}
+
+// NewRateLimiter returns a RateLimiter that allows requestsPerSecond requests
+// on average with bursts of up to burst requests. The bucket starts full.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+		stats:  RateLimiterStats{Limit: -1, Remaining: -1},
+	}
+}
+
+// Wait blocks until a request is allowed to be sent or the context is done
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	now := time.Now()
+	r.refill(now)
+
+	// Reserve a token up front so concurrent callers queue up in order
+	r.tokens--
+	delay := time.Duration(0)
+	if r.tokens < 0 && r.rate > 0 {
+		delay = time.Duration(-r.tokens / r.rate * float64(time.Second))
+	}
+
+	if blocked := r.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+
+	r.stats.Requests++
+	if delay > 0 {
+		r.stats.Waited++
+		r.stats.TotalWait += delay
+	}
+	r.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		r.mu.Lock()
+		r.tokens++
+		r.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Observe updates the limiter with the rate limit headers of a response
+func (r *RateLimiter) Observe(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		r.stats.RateLimited++
+	}
+
+	if limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit")); err == nil {
+		r.stats.Limit = limit
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err == nil {
+		r.stats.Remaining = remaining
+	}
+
+	if err == nil && remaining <= 0 {
+		if reset, ok := parseRateLimitReset(resp.Header.Get("X-RateLimit-Reset"), now); ok {
+			r.blockUntil(reset)
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if after, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			r.blockUntil(after)
+		}
+	}
+}
+
+// Stats returns a snapshot of the limiter's counters and current state
+func (r *RateLimiter) Stats() RateLimiterStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refill(time.Now())
+
+	stats := r.stats
+	stats.Rate = r.rate
+	stats.Burst = r.burst
+	stats.Tokens = r.tokens
+	stats.BlockedUntil = r.blockedUntil
+
+	return stats
+}
+
+func (r *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(r.last).Seconds()
+	r.last = now
+	if elapsed <= 0 {
+		return
+	}
+
+	r.tokens = math.Min(float64(r.burst), r.tokens+elapsed*r.rate)
+}
+
+func (r *RateLimiter) blockUntil(t time.Time) {
+	if t.After(r.blockedUntil) {
+		r.blockedUntil = t
+	}
+}
+
+// parseRetryAfter handles both the delay-seconds and HTTP date forms of Retry-After
+func parseRetryAfter(value string, now time.Time) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return now.Add(time.Duration(seconds) * time.Second), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return date, true
+	}
+
+	return time.Time{}, false
+}
+
+// parseRateLimitReset handles X-RateLimit-Reset sent as a unix timestamp or as seconds from now
+func parseRateLimitReset(value string, now time.Time) (time.Time, bool) {
+	reset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	if reset > rateLimitResetEpochThreshold {
+		return time.Unix(reset, 0), true
+	}
+
+	return now.Add(time.Duration(reset) * time.Second), true
+}
+
+// rateLimitTransport waits on the limiter before every attempt and feeds the
+// response headers back into it
+type rateLimitTransport struct {
+	limiter *RateLimiter
+	base    http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err == nil {
+		t.limiter.Observe(resp)
+	}
+
+	return resp, err
+}
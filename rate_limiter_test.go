@@ -0,0 +1,112 @@
+package govultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Burst(t *testing.T) {
+	limiter := NewRateLimiter(50, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("RateLimiter.Wait returned %+v", err)
+		}
+	}
+
+	// Two requests fit in the burst, the other two wait 20ms each
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("RateLimiter.Wait took %v, expected at least 40ms", elapsed)
+	}
+
+	stats := limiter.Stats()
+	if stats.Requests != 4 || stats.Waited != 2 || stats.Rate != 50 || stats.Burst != 2 {
+		t.Errorf("RateLimiter.Stats returned %+v", stats)
+	}
+}
+
+func TestRateLimiter_WaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(0.1, 1)
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("RateLimiter.Wait returned %+v", err)
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(cctx); err != context.DeadlineExceeded {
+		t.Errorf("RateLimiter.Wait returned %+v, expected %+v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimiter_Observe(t *testing.T) {
+	limiter := NewRateLimiter(100, 10)
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "2")
+	resp.Header.Set("X-RateLimit-Limit", "30")
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Second).Unix(), 10))
+	limiter.Observe(resp)
+
+	stats := limiter.Stats()
+	if stats.RateLimited != 1 || stats.Limit != 30 || stats.Remaining != 0 {
+		t.Errorf("RateLimiter.Stats returned %+v", stats)
+	}
+
+	if wait := time.Until(stats.BlockedUntil); wait < time.Second || wait > 2*time.Second {
+		t.Errorf("RateLimiter blocked for %v, expected about 2s", wait)
+	}
+}
+
+func TestClient_SetRateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(10 * time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		attempts++
+		if attempts == 1 {
+			writer.Header().Set("Retry-After", "1")
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(writer, `{"account":{"name":"vultr"}}`)
+	})
+
+	limiter := NewRateLimiter(100, 5)
+	client.SetRateLimiter(limiter)
+	client.SetRateLimiter(limiter)
+
+	if client.RateLimiter() != limiter {
+		t.Fatalf("Client.RateLimiter returned %+v, expected %+v", client.RateLimiter(), limiter)
+	}
+
+	start := time.Now()
+	if _, _, err := client.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	// retryablehttp already waits for Retry-After, the limiter should make every
+	// request wait as well, including those of other goroutines
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("Account.Get took %v, expected to wait for Retry-After", elapsed)
+	}
+
+	stats := limiter.Stats()
+	if stats.Requests != 2 || stats.RateLimited != 1 {
+		t.Errorf("RateLimiter.Stats returned %+v, expected 2 requests and 1 rate limited", stats)
+	}
+
+	client.SetRateLimiter(nil)
+	if _, ok := client.client.HTTPClient.Transport.(*rateLimitTransport); ok {
+		t.Error("SetRateLimiter(nil) expected the limiter to be removed")
+	}
+}
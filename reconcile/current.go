@@ -0,0 +1,181 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// index holds the existing resources of one kind by name
+type index[T any] struct {
+	all    []T
+	byName map[string]T
+	dupes  map[string]bool
+}
+
+func newIndex[T any](items []T, name func(T) string) *index[T] {
+	x := &index[T]{all: items, byName: map[string]T{}, dupes: map[string]bool{}}
+	for _, item := range items {
+		n := name(item)
+		if _, ok := x.byName[n]; ok {
+			x.dupes[n] = true
+		}
+		x.byName[n] = item
+	}
+
+	return x
+}
+
+// get returns the resource named name. Resources sharing a name can't be
+// told apart, so that's an error.
+func (x *index[T]) get(name string) (T, bool, error) {
+	if x.dupes[name] {
+		var zero T
+		return zero, false, fmt.Errorf("more than one existing resource is named %s", name)
+	}
+
+	item, ok := x.byName[name]
+	return item, ok, nil
+}
+
+// current is the existing state of the account
+type current struct {
+	vpcs           *index[govultr.VPC]
+	firewallGroups *index[govultr.FirewallGroup]
+	instances      *index[govultr.Instance]
+	blocks         *index[govultr.BlockStorage]
+	reservedIPs    *index[govultr.ReservedIP]
+	loadBalancers  *index[govultr.LoadBalancer]
+	domains        *index[govultr.Domain]
+
+	// Only read for the desired resources that exist
+	firewallRules map[string][]govultr.FirewallRule
+	instanceVPCs  map[string][]string
+	records       map[string][]govultr.DomainRecord
+}
+
+// fetch reads the resources of every kind the desired state or the scope
+// can touch
+func (r *Reconciler) fetch(ctx context.Context, desired *State) (*current, error) {
+	c := &current{
+		firewallRules: map[string][]govultr.FirewallRule{},
+		instanceVPCs:  map[string][]string{},
+		records:       map[string][]govultr.DomainRecord{},
+	}
+
+	var err error
+	if c.vpcs, err = list(ctx, r.client.VPC.List, func(v govultr.VPC) string { return v.Description }); err != nil {
+		return nil, err
+	}
+	if c.firewallGroups, err = list(ctx, r.client.FirewallGroup.List, func(g govultr.FirewallGroup) string { return g.Description }); err != nil { //nolint:lll
+		return nil, err
+	}
+	if c.instances, err = list(ctx, r.client.Instance.List, func(i govultr.Instance) string { return i.Label }); err != nil {
+		return nil, err
+	}
+	if c.blocks, err = list(ctx, r.client.BlockStorage.List, func(b govultr.BlockStorage) string { return b.Label }); err != nil {
+		return nil, err
+	}
+	if c.reservedIPs, err = list(ctx, r.client.ReservedIP.List, func(ip govultr.ReservedIP) string { return ip.Label }); err != nil {
+		return nil, err
+	}
+	if c.loadBalancers, err = list(ctx, r.client.LoadBalancer.List, func(lb govultr.LoadBalancer) string { return lb.Label }); err != nil {
+		return nil, err
+	}
+	if c.domains, err = list(ctx, r.client.Domain.List, func(d govultr.Domain) string { return d.Domain }); err != nil {
+		return nil, err
+	}
+
+	if err := r.fetchChildren(ctx, desired, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// fetchChildren reads the firewall rules, VPC attachments and records of the
+// desired resources that exist
+func (r *Reconciler) fetchChildren(ctx context.Context, desired *State, c *current) error {
+	for _, group := range desired.FirewallGroups {
+		existing, ok := c.firewallGroups.byName[group.Description]
+		if !ok {
+			continue
+		}
+
+		rules, err := govultr.CollectAll(ctx, nil, func(ctx context.Context, o *govultr.ListOptions) ([]govultr.FirewallRule, *govultr.Meta, *http.Response, error) { //nolint:lll
+			return r.client.FirewallRule.List(ctx, existing.ID, o)
+		})
+		if err != nil {
+			return err
+		}
+		c.firewallRules[existing.ID] = rules
+	}
+
+	for _, instance := range desired.Instances {
+		existing, ok := c.instances.byName[instance.Label]
+		if !ok || instance.VPCs == nil {
+			continue
+		}
+
+		vpcs, err := govultr.CollectAll(ctx, nil, func(ctx context.Context, o *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, *http.Response, error) { //nolint:lll
+			return r.client.Instance.ListVPCInfo(ctx, existing.ID, o)
+		})
+		if err != nil {
+			return err
+		}
+		for _, vpc := range vpcs {
+			c.instanceVPCs[existing.ID] = append(c.instanceVPCs[existing.ID], vpc.ID)
+		}
+	}
+
+	for _, domain := range desired.Domains {
+		if _, ok := c.domains.byName[domain.Name]; !ok {
+			continue
+		}
+
+		records, err := govultr.CollectAll(ctx, nil, func(ctx context.Context, o *govultr.ListOptions) ([]govultr.DomainRecord, *govultr.Meta, *http.Response, error) { //nolint:lll
+			return r.client.DomainRecord.List(ctx, domain.Name, o)
+		})
+		if err != nil {
+			return err
+		}
+		c.records[domain.Name] = records
+	}
+
+	return nil
+}
+
+// list reads every page of a list call into an index
+func list[T any](ctx context.Context, call govultr.ListFunc[T], name func(T) string) (*index[T], error) {
+	items, err := govultr.CollectAll(ctx, nil, call)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIndex(items, name), nil
+}
+
+// ids returns the IDs of the existing resources other resources can refer
+// to. Names shared by several resources are left out.
+func (c *current) ids() *ids {
+	result := newIDs()
+	for name, vpc := range c.vpcs.byName {
+		if !c.vpcs.dupes[name] {
+			result.set(KindVPC, name, vpc.ID)
+		}
+	}
+	for name, group := range c.firewallGroups.byName {
+		if !c.firewallGroups.dupes[name] {
+			result.set(KindFirewallGroup, name, group.ID)
+		}
+	}
+	for name, instance := range c.instances.byName {
+		if !c.instances.dupes[name] {
+			result.set(KindInstance, name, instance.ID)
+		}
+	}
+
+	return result
+}
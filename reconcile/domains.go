@@ -0,0 +1,166 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (p *planner) planDomains() {
+	for _, domain := range p.desired.Domains {
+		existing, ok, err := p.current.domains.get(domain.Name)
+		switch {
+		case err != nil:
+			p.errorf(KindDomain, domain.Name, "%s", err)
+			continue
+		case !ok:
+			p.add(p.createDomain(domain))
+		case dnsSec(domain.DNSSec) != existing.DNSSec:
+			p.add(&Change{
+				Action: Update, Kind: KindDomain, Name: domain.Name, ID: domain.Name,
+				Diff: []string{diff("dns_sec", existing.DNSSec, dnsSec(domain.DNSSec))},
+				apply: func(ctx context.Context, _ *ids) (string, error) {
+					return domain.Name, p.client.Domain.Update(ctx, domain.Name, dnsSec(domain.DNSSec))
+				},
+			})
+		}
+
+		p.planRecords(domain, p.current.records[domain.Name])
+	}
+}
+
+func (p *planner) createDomain(domain Domain) *Change {
+	return &Change{
+		Action: Create, Kind: KindDomain, Name: domain.Name,
+		apply: func(ctx context.Context, _ *ids) (string, error) {
+			created, _, err := p.client.Domain.Create(ctx, &govultr.DomainReq{Domain: domain.Name, DNSSec: dnsSec(domain.DNSSec)})
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			return created.Domain, nil
+		},
+	}
+}
+
+// planRecords creates the desired records a domain doesn't have, updates the
+// TTL and priority of the ones it has and, if the domain prunes its records,
+// deletes the others
+func (p *planner) planRecords(domain Domain, existing []govultr.DomainRecord) {
+	have := map[string]govultr.DomainRecord{}
+	for _, record := range existing {
+		have[recordKey(record.Type, record.Name, record.Data)] = record
+	}
+
+	want := map[string]bool{}
+	for _, record := range domain.Records {
+		key := recordKey(record.Type, record.Name, record.Data)
+		if want[key] {
+			p.errorf(KindDomainRecord, domain.Name+": "+key, "listed more than once")
+			continue
+		}
+		want[key] = true
+
+		current, ok := have[key]
+		if !ok {
+			p.add(p.createRecord(domain.Name, key, record))
+			continue
+		}
+
+		if change := p.updateRecord(domain.Name, key, record, current); change != nil {
+			p.add(change)
+		}
+	}
+
+	if !domain.PruneRecords {
+		return
+	}
+
+	for _, record := range existing {
+		key := recordKey(record.Type, record.Name, record.Data)
+		if want[key] || (strings.EqualFold(string(record.Type), string(govultr.RecordTypeNS)) && record.Name == "") {
+			continue
+		}
+
+		p.add(&Change{
+			Action: Delete, Kind: KindDomainRecord, Name: domain.Name + ": " + key, ID: record.ID,
+			apply: func(ctx context.Context, _ *ids) (string, error) {
+				return record.ID, p.client.DomainRecord.Delete(ctx, domain.Name, record.ID)
+			},
+		})
+	}
+}
+
+func (p *planner) createRecord(domain, key string, record Record) *Change {
+	return &Change{
+		Action: Create, Kind: KindDomainRecord, Name: domain + ": " + key,
+		apply: func(ctx context.Context, _ *ids) (string, error) {
+			req := &govultr.DomainRecordCreateReq{
+				Name: record.Name,
+				Type: record.Type,
+				Data: record.Data,
+				TTL:  record.TTL,
+			}
+			if record.Priority != 0 {
+				req.Priority = govultr.IntToIntPtr(record.Priority)
+			}
+
+			created, _, err := p.client.DomainRecord.Create(ctx, domain, req)
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			return created.ID, nil
+		},
+	}
+}
+
+// updateRecord returns the change that updates the TTL and priority of an
+// existing record, nil if it's up to date. A zero TTL or priority leaves
+// the existing one.
+func (p *planner) updateRecord(domain, key string, record Record, existing govultr.DomainRecord) *Change {
+	change := &Change{Action: Update, Kind: KindDomainRecord, Name: domain + ": " + key, ID: existing.ID}
+	req := &govultr.DomainRecordUpdateReq{}
+
+	if record.TTL != 0 && record.TTL != existing.TTL {
+		change.Diff = append(change.Diff, diff("ttl", existing.TTL, record.TTL))
+		req.TTL = record.TTL
+	}
+
+	if record.Priority != 0 && record.Priority != existing.Priority {
+		change.Diff = append(change.Diff, diff("priority", existing.Priority, record.Priority))
+		req.Priority = govultr.IntToIntPtr(record.Priority)
+	}
+
+	if len(change.Diff) == 0 {
+		return nil
+	}
+
+	change.apply = func(ctx context.Context, _ *ids) (string, error) {
+		return existing.ID, p.client.DomainRecord.Update(ctx, domain, existing.ID, req)
+	}
+
+	return change
+}
+
+// recordKey identifies a DNS record, e.g. `A "www" 192.0.2.1`
+func recordKey(recordType govultr.RecordType, name, data string) string {
+	return fmt.Sprintf("%s %q %s", strings.ToUpper(string(recordType)), name, data)
+}
+
+// dnsSec returns the API value of a DNSSEC setting
+func dnsSec(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+
+	return "disabled"
+}
@@ -0,0 +1,200 @@
+package reconcile
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (p *planner) planInstances() {
+	for _, instance := range p.desired.Instances {
+		p.checkRefs(KindInstance, instance.Label, KindFirewallGroup, instance.FirewallGroup)
+		p.checkRefs(KindInstance, instance.Label, KindVPC, instance.VPCs...)
+
+		existing, ok, err := p.current.instances.get(instance.Label)
+		switch {
+		case err != nil:
+			p.errorf(KindInstance, instance.Label, "%s", err)
+		case !ok:
+			p.add(p.createInstance(instance))
+		default:
+			if change := p.updateInstance(instance, existing); change != nil {
+				p.add(change)
+			}
+		}
+	}
+
+	for _, existing := range p.current.instances.all {
+		if !p.wanted[KindInstance][existing.Label] && p.inScope(existing.Label, existing.Tags) {
+			p.add(&Change{
+				Action: Delete, Kind: KindInstance, Name: existing.Label, ID: existing.ID,
+				apply: func(ctx context.Context, _ *ids) (string, error) {
+					return existing.ID, p.client.Instance.Delete(ctx, existing.ID)
+				},
+			})
+		}
+	}
+}
+
+// tags returns the tags of a desired instance, including the owner tag
+func (p *planner) tags(instance Instance) []string {
+	tags := slices.Clone(instance.Tags)
+	if p.ownerTag != "" && !slices.Contains(tags, p.ownerTag) {
+		tags = append(tags, p.ownerTag)
+	}
+
+	return tags
+}
+
+func (p *planner) createInstance(instance Instance) *Change {
+	tags := p.tags(instance)
+
+	return &Change{
+		Action: Create, Kind: KindInstance, Name: instance.Label,
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			firewallGroupID, err := ids.get(KindFirewallGroup, instance.FirewallGroup)
+			if err != nil {
+				return "", err
+			}
+
+			vpcIDs, err := ids.getAll(KindVPC, instance.VPCs)
+			if err != nil {
+				return "", err
+			}
+
+			req := &govultr.InstanceCreateReq{
+				Label:           instance.Label,
+				Region:          instance.Region,
+				Plan:            instance.Plan,
+				OsID:            instance.OsID,
+				AppID:           instance.AppID,
+				ImageID:         instance.ImageID,
+				SnapshotID:      instance.SnapshotID,
+				Hostname:        instance.Hostname,
+				Tags:            tags,
+				SSHKeys:         instance.SSHKeys,
+				UserData:        instance.UserData,
+				FirewallGroupID: firewallGroupID,
+				AttachVPC:       vpcIDs,
+			}
+			if instance.EnableIPv6 {
+				req.EnableIPv6 = govultr.BoolToBoolPtr(true)
+			}
+
+			created, _, err := p.client.Instance.Create(ctx, req)
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			ids.set(KindInstance, instance.Label, created.ID)
+
+			if p.wait {
+				if _, err := p.client.WaitForInstanceActive(ctx, created.ID, p.waitOptions); err != nil {
+					return created.ID, err
+				}
+			}
+
+			return created.ID, nil
+		},
+	}
+}
+
+// updateInstance returns the change that updates an existing instance, nil
+// if it's up to date
+func (p *planner) updateInstance(instance Instance, existing govultr.Instance) *Change {
+	p.checkInstance(instance, existing)
+
+	change := &Change{Action: Update, Kind: KindInstance, Name: instance.Label, ID: existing.ID}
+	req := &govultr.InstanceUpdateReq{}
+
+	if instance.Plan != existing.Plan {
+		change.Diff = append(change.Diff, diff("plan", existing.Plan, instance.Plan))
+		req.Plan = instance.Plan
+	}
+
+	if tags := p.tags(instance); !sameSet(tags, existing.Tags) {
+		change.Diff = append(change.Diff, diff("tags", existing.Tags, tags))
+		req.Tags = tags
+	}
+
+	firewallGroup := p.refID(KindFirewallGroup, instance.FirewallGroup)
+	if instance.FirewallGroup != "" && firewallGroup != existing.FirewallGroupID {
+		change.Diff = append(change.Diff, diff("firewall_group", existing.FirewallGroupID, firewallGroup))
+	}
+
+	var attach, detach []string
+	if instance.VPCs != nil {
+		attach, detach = p.vpcChanges(instance.VPCs, p.current.instanceVPCs[existing.ID])
+		if len(attach) > 0 {
+			change.Diff = append(change.Diff, "vpcs: attach "+strings.Join(attach, ", "))
+		}
+		if len(detach) > 0 {
+			change.Diff = append(change.Diff, "vpcs: detach "+strings.Join(detach, ", "))
+		}
+	}
+
+	if len(change.Diff) == 0 {
+		return nil
+	}
+
+	change.apply = func(ctx context.Context, ids *ids) (string, error) {
+		var err error
+		if instance.FirewallGroup != "" {
+			if req.FirewallGroupID, err = ids.get(KindFirewallGroup, instance.FirewallGroup); err != nil {
+				return "", err
+			}
+		}
+
+		if req.AttachVPC, err = ids.getAll(KindVPC, attach); err != nil {
+			return "", err
+		}
+		req.DetachVPC = detach
+
+		_, _, err = p.client.Instance.Update(ctx, existing.ID, req)
+		return existing.ID, err
+	}
+
+	return change
+}
+
+// checkInstance reports the changes to an existing instance that would need
+// it to be replaced or reinstalled
+func (p *planner) checkInstance(instance Instance, existing govultr.Instance) {
+	if instance.Region != existing.Region {
+		p.errorf(KindInstance, instance.Label, "region can't change from %s to %s", existing.Region, instance.Region)
+	}
+
+	if (instance.OsID != 0 && instance.OsID != existing.OsID) ||
+		(instance.AppID != 0 && instance.AppID != existing.AppID) ||
+		(instance.ImageID != "" && instance.ImageID != existing.ImageID) {
+		p.errorf(KindInstance, instance.Label, "image can't change without reinstalling the instance")
+	}
+}
+
+// vpcChanges returns the names of the desired VPCs to attach and the IDs of
+// the attached VPCs to detach
+func (p *planner) vpcChanges(desired, attached []string) (attach, detach []string) {
+	wanted := map[string]bool{}
+	for _, name := range desired {
+		id := p.ids.lookup(KindVPC, name)
+		if id != "" {
+			wanted[id] = true
+		}
+		if id == "" || !slices.Contains(attached, id) {
+			attach = append(attach, name)
+		}
+	}
+
+	for _, id := range attached {
+		if !wanted[id] {
+			detach = append(detach, id)
+		}
+	}
+
+	return attach, detach
+}
@@ -0,0 +1,168 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (p *planner) planLoadBalancers() {
+	wanted := map[string]bool{}
+	for _, lb := range p.desired.LoadBalancers {
+		wanted[lb.Label] = true
+		p.checkRefs(KindLoadBalancer, lb.Label, KindInstance, lb.Instances...)
+		p.checkRefs(KindLoadBalancer, lb.Label, KindVPC, lb.VPC)
+
+		existing, ok, err := p.current.loadBalancers.get(lb.Label)
+		switch {
+		case err != nil:
+			p.errorf(KindLoadBalancer, lb.Label, "%s", err)
+		case !ok:
+			p.add(p.createLoadBalancer(lb))
+		default:
+			if change := p.updateLoadBalancer(lb, existing); change != nil {
+				p.add(change)
+			}
+		}
+	}
+
+	for _, existing := range p.current.loadBalancers.all {
+		if wanted[existing.Label] || !p.inScope(existing.Label, nil) {
+			continue
+		}
+
+		p.add(&Change{
+			Action: Delete, Kind: KindLoadBalancer, Name: existing.Label, ID: existing.ID,
+			apply: func(ctx context.Context, _ *ids) (string, error) {
+				return existing.ID, p.client.LoadBalancer.Delete(ctx, existing.ID)
+			},
+		})
+	}
+}
+
+func (p *planner) createLoadBalancer(lb LoadBalancer) *Change {
+	return &Change{
+		Action: Create, Kind: KindLoadBalancer, Name: lb.Label,
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			req := &govultr.LoadBalancerReq{
+				Region:             lb.Region,
+				Label:              lb.Label,
+				HealthCheck:        lb.HealthCheck,
+				ForwardingRules:    lb.ForwardingRules,
+				BalancingAlgorithm: lb.BalancingAlgorithm,
+			}
+			if err := resolveLoadBalancer(ids, lb, req); err != nil {
+				return "", err
+			}
+
+			created, _, err := p.client.LoadBalancer.Create(ctx, req)
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			return created.ID, nil
+		},
+	}
+}
+
+// updateLoadBalancer returns the change that updates an existing load
+// balancer, nil if it's up to date
+func (p *planner) updateLoadBalancer(lb LoadBalancer, existing govultr.LoadBalancer) *Change {
+	if lb.Region != existing.Region {
+		p.errorf(KindLoadBalancer, lb.Label, "region can't change from %s to %s", existing.Region, lb.Region)
+	}
+
+	change := &Change{Action: Update, Kind: KindLoadBalancer, Name: lb.Label, ID: existing.ID}
+	req := &govultr.LoadBalancerReq{}
+
+	instances := make([]string, len(lb.Instances))
+	for i, label := range lb.Instances {
+		instances[i] = p.refID(KindInstance, label)
+	}
+	if !sameSet(instances, existing.Instances) {
+		labels := make([]string, len(existing.Instances))
+		for i, id := range existing.Instances {
+			labels[i] = p.instanceLabel(id)
+		}
+		change.Diff = append(change.Diff, diff("instances", sorted(labels), sorted(lb.Instances)))
+	}
+
+	if from, to := forwardingRuleKeys(existing.ForwardingRules), forwardingRuleKeys(lb.ForwardingRules); !sameSet(from, to) {
+		change.Diff = append(change.Diff, diff("forwarding_rules", sorted(from), sorted(to)))
+		req.ForwardingRules = lb.ForwardingRules
+	}
+
+	if lb.HealthCheck != nil && (existing.HealthCheck == nil || *lb.HealthCheck != *existing.HealthCheck) {
+		var from any = "none"
+		if existing.HealthCheck != nil {
+			from = *existing.HealthCheck
+		}
+		change.Diff = append(change.Diff, diff("health_check", from, *lb.HealthCheck))
+		req.HealthCheck = lb.HealthCheck
+	}
+
+	info := existing.GenericInfo
+	if info == nil {
+		info = &govultr.GenericInfo{}
+	}
+
+	if lb.BalancingAlgorithm != "" && lb.BalancingAlgorithm != info.BalancingAlgorithm {
+		change.Diff = append(change.Diff, diff("balancing_algorithm", info.BalancingAlgorithm, lb.BalancingAlgorithm))
+		req.BalancingAlgorithm = lb.BalancingAlgorithm
+	}
+
+	vpc := p.refID(KindVPC, lb.VPC)
+	if vpc != info.VPC {
+		change.Diff = append(change.Diff, diff("vpc", info.VPC, vpc))
+	}
+
+	if len(change.Diff) == 0 {
+		return nil
+	}
+
+	change.apply = func(ctx context.Context, ids *ids) (string, error) {
+		if err := resolveLoadBalancer(ids, lb, req); err != nil {
+			return "", err
+		}
+
+		return existing.ID, p.client.LoadBalancer.Update(ctx, existing.ID, req)
+	}
+
+	return change
+}
+
+// resolveLoadBalancer sets the IDs of the instances and VPC of a load
+// balancer on req
+func resolveLoadBalancer(ids *ids, lb LoadBalancer, req *govultr.LoadBalancerReq) error {
+	instances, err := ids.getAll(KindInstance, lb.Instances)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := ids.get(KindVPC, lb.VPC)
+	if err != nil {
+		return err
+	}
+
+	req.Instances = instances
+	req.VPC = govultr.StringToStringPtr(vpc)
+
+	return nil
+}
+
+// forwardingRuleKeys identifies forwarding rules regardless of their IDs,
+// e.g. "http:80 -> http:8080"
+func forwardingRuleKeys(rules []govultr.ForwardingRule) []string {
+	keys := make([]string, len(rules))
+	for i, rule := range rules {
+		keys[i] = fmt.Sprintf("%s:%d -> %s:%d",
+			strings.ToLower(string(rule.FrontendProtocol)), rule.FrontendPort, strings.ToLower(string(rule.BackendProtocol)), rule.BackendPort)
+	}
+
+	return keys
+}
@@ -0,0 +1,194 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (p *planner) planVPCs() {
+	for _, vpc := range p.desired.VPCs {
+		existing, ok, err := p.current.vpcs.get(vpc.Description)
+		switch {
+		case err != nil:
+			p.errorf(KindVPC, vpc.Description, "%s", err)
+		case !ok:
+			p.add(p.createVPC(vpc))
+		default:
+			p.checkVPC(vpc, existing)
+		}
+	}
+
+	for _, existing := range p.current.vpcs.all {
+		if !p.wanted[KindVPC][existing.Description] && p.inScope(existing.Description, nil) {
+			p.add(&Change{
+				Action: Delete, Kind: KindVPC, Name: existing.Description, ID: existing.ID,
+				apply: func(ctx context.Context, _ *ids) (string, error) {
+					return existing.ID, p.client.VPC.Delete(ctx, existing.ID)
+				},
+			})
+		}
+	}
+}
+
+func (p *planner) createVPC(vpc VPC) *Change {
+	return &Change{
+		Action: Create, Kind: KindVPC, Name: vpc.Description,
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			created, _, err := p.client.VPC.Create(ctx, &govultr.VPCReq{
+				Region:       vpc.Region,
+				Description:  vpc.Description,
+				V4Subnet:     vpc.Subnet,
+				V4SubnetMask: vpc.SubnetMask,
+			})
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			ids.set(KindVPC, vpc.Description, created.ID)
+			return created.ID, nil
+		},
+	}
+}
+
+// checkVPC reports the changes to an existing VPC, which would need it to be
+// replaced
+func (p *planner) checkVPC(vpc VPC, existing govultr.VPC) {
+	if vpc.Region != existing.Region {
+		p.errorf(KindVPC, vpc.Description, "region can't change from %s to %s", existing.Region, vpc.Region)
+	}
+
+	if vpc.Subnet != "" && (vpc.Subnet != existing.V4Subnet || vpc.SubnetMask != existing.V4SubnetMask) {
+		p.errorf(KindVPC, vpc.Description, "subnet can't change from %s/%d to %s/%d",
+			existing.V4Subnet, existing.V4SubnetMask, vpc.Subnet, vpc.SubnetMask)
+	}
+}
+
+func (p *planner) planFirewallGroups() {
+	for _, group := range p.desired.FirewallGroups {
+		existing, ok, err := p.current.firewallGroups.get(group.Description)
+		switch {
+		case err != nil:
+			p.errorf(KindFirewallGroup, group.Description, "%s", err)
+			continue
+		case !ok:
+			p.add(p.createFirewallGroup(group))
+		}
+
+		p.planFirewallRules(group, p.current.firewallRules[existing.ID])
+	}
+
+	for _, existing := range p.current.firewallGroups.all {
+		if !p.wanted[KindFirewallGroup][existing.Description] && p.inScope(existing.Description, nil) {
+			p.add(&Change{
+				Action: Delete, Kind: KindFirewallGroup, Name: existing.Description, ID: existing.ID,
+				apply: func(ctx context.Context, _ *ids) (string, error) {
+					return existing.ID, p.client.FirewallGroup.Delete(ctx, existing.ID)
+				},
+			})
+		}
+	}
+}
+
+func (p *planner) createFirewallGroup(group FirewallGroup) *Change {
+	return &Change{
+		Action: Create, Kind: KindFirewallGroup, Name: group.Description,
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			created, _, err := p.client.FirewallGroup.Create(ctx, &govultr.FirewallGroupReq{Description: group.Description})
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			ids.set(KindFirewallGroup, group.Description, created.ID)
+			return created.ID, nil
+		},
+	}
+}
+
+// planFirewallRules creates the desired rules a group doesn't have and
+// deletes the ones it has that aren't desired
+func (p *planner) planFirewallRules(group FirewallGroup, existing []govultr.FirewallRule) {
+	have := map[string]bool{}
+	for _, rule := range existing {
+		key := ruleKey(rule.IPType, rule.Protocol, rule.Subnet, rule.SubnetSize, rule.Port, rule.Source)
+		have[key] = true
+	}
+
+	want := map[string]bool{}
+	for _, rule := range group.Rules {
+		key := ruleKey(rule.IPType, rule.Protocol, rule.Subnet, rule.SubnetSize, rule.Port, rule.Source)
+		if want[key] || have[key] {
+			want[key] = true
+			continue
+		}
+		want[key] = true
+
+		p.add(&Change{
+			Action: Create, Kind: KindFirewallRule, Name: group.Description + ": " + key,
+			apply: func(ctx context.Context, ids *ids) (string, error) {
+				groupID, err := ids.get(KindFirewallGroup, group.Description)
+				if err != nil {
+					return "", err
+				}
+
+				created, _, err := p.client.FirewallRule.Create(ctx, groupID, &govultr.FirewallRuleReq{
+					IPType:     rule.IPType,
+					Protocol:   rule.Protocol,
+					Subnet:     rule.Subnet,
+					SubnetSize: rule.SubnetSize,
+					Port:       rule.Port,
+					Source:     rule.Source,
+					Notes:      rule.Notes,
+				})
+				if err != nil {
+					return "", err
+				}
+				if created == nil {
+					return "", ErrNotCreated
+				}
+
+				return fmt.Sprint(created.ID), nil
+			},
+		})
+	}
+
+	for _, rule := range existing {
+		key := ruleKey(rule.IPType, rule.Protocol, rule.Subnet, rule.SubnetSize, rule.Port, rule.Source)
+		if want[key] {
+			continue
+		}
+
+		p.add(&Change{
+			Action: Delete, Kind: KindFirewallRule, Name: group.Description + ": " + key, ID: fmt.Sprint(rule.ID),
+			apply: func(ctx context.Context, ids *ids) (string, error) {
+				groupID, err := ids.get(KindFirewallGroup, group.Description)
+				if err != nil {
+					return "", err
+				}
+
+				return fmt.Sprint(rule.ID), p.client.FirewallRule.Delete(ctx, groupID, rule.ID)
+			},
+		})
+	}
+}
+
+// ruleKey identifies a firewall rule, e.g. "v4 tcp 0.0.0.0/0 port 22"
+func ruleKey(ipType govultr.IPType, protocol govultr.Protocol, subnet string, subnetSize int, port, source string) string {
+	key := fmt.Sprintf("%s %s %s/%d", strings.ToLower(string(ipType)), strings.ToLower(string(protocol)), subnet, subnetSize)
+	if port != "" {
+		key += " port " + port
+	}
+	if source != "" {
+		key += " source " + source
+	}
+
+	return key
+}
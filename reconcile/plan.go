@@ -0,0 +1,187 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Action is what a change does to a resource
+type Action string
+
+// Actions of a change
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Kind is the type of resource a change applies to
+type Kind string
+
+// Kinds of resources the reconciler manages
+const (
+	KindVPC           Kind = "vpc"
+	KindFirewallGroup Kind = "firewall_group"
+	KindFirewallRule  Kind = "firewall_rule"
+	KindInstance      Kind = "instance"
+	KindBlockStorage  Kind = "block_storage"
+	KindReservedIP    Kind = "reserved_ip"
+	KindLoadBalancer  Kind = "load_balancer"
+	KindDomain        Kind = "domain"
+	KindDomainRecord  Kind = "domain_record"
+)
+
+// ErrNotCreated is the error of a create whose call succeeded without
+// returning the resource. Clients in dry-run mode do that: the request is
+// recorded in their dry-run plan and nothing is created, so the change stops
+// there and the changes that depend on the resource fail.
+var ErrNotCreated = errors.New("create returned no resource")
+
+// Change is a single create, update or delete of a resource
+type Change struct {
+	Action Action `json:"action"`
+	Kind   Kind   `json:"kind"`
+
+	// Label, description or name the resource is identified by
+	Name string `json:"name"`
+
+	// ID of the existing resource, empty for creates
+	ID string `json:"id,omitempty"`
+
+	// Fields that change, as "field: old -> new"
+	Diff []string `json:"diff,omitempty"`
+
+	apply func(ctx context.Context, ids *ids) (string, error)
+}
+
+// String describes the change in one line
+func (c *Change) String() string {
+	symbol := map[Action]string{Create: "+", Update: "~", Delete: "-"}[c.Action]
+	if c.ID == "" {
+		return fmt.Sprintf("%s %s %s", symbol, c.Kind, c.Name)
+	}
+
+	return fmt.Sprintf("%s %s %s (%s)", symbol, c.Kind, c.Name, c.ID)
+}
+
+// Plan is the ordered list of changes that turn the current state into the
+// desired one. Creates and updates come first in dependency order, e.g. VPCs
+// and firewall groups before the instances that use them, followed by
+// deletes in the reverse order. A plan is applied once.
+type Plan struct {
+	Changes []*Change `json:"changes"`
+
+	ids *ids
+}
+
+// Empty reports whether the current state already is the desired one
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String lists the changes with their diffs, one per line
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	for _, change := range p.Changes {
+		b.WriteString(change.String() + "\n")
+		for _, diff := range change.Diff {
+			b.WriteString("    " + diff + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// Result is the outcome of applying a change
+type Result struct {
+	Change *Change `json:"change"`
+
+	// ID of the resource, set for creates that succeeded
+	ID string `json:"id,omitempty"`
+
+	Err error `json:"-"`
+}
+
+// Report is the outcome of applying every change of a plan, in order
+type Report struct {
+	Results []Result `json:"results"`
+}
+
+// Failed returns the results of the changes that failed
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err joins the errors of the changes that failed, nil if all succeeded
+func (r *Report) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s %s %s: %w", result.Change.Action, result.Change.Kind, result.Change.Name, result.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ids maps the names of the resources other resources refer to onto their
+// IDs. It starts out with the existing resources and is filled in as
+// resources are created.
+type ids struct {
+	kinds map[Kind]map[string]string
+}
+
+func newIDs() *ids {
+	return &ids{kinds: map[Kind]map[string]string{}}
+}
+
+func (i *ids) set(kind Kind, name, id string) {
+	if i.kinds[kind] == nil {
+		i.kinds[kind] = map[string]string{}
+	}
+	i.kinds[kind][name] = id
+}
+
+// lookup returns the ID of a resource, empty when it doesn't exist yet
+func (i *ids) lookup(kind Kind, name string) string {
+	return i.kinds[kind][name]
+}
+
+// get returns the ID of a resource, failing when it doesn't exist, e.g.
+// because creating it failed
+func (i *ids) get(kind Kind, name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+
+	if id := i.lookup(kind, name); id != "" {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("%s %s does not exist", kind, name)
+}
+
+// getAll returns the IDs of the named resources
+func (i *ids) getAll(kind Kind, names []string) ([]string, error) {
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		id, err := i.get(kind, name)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, id)
+	}
+
+	return resolved, nil
+}
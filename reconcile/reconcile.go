@@ -0,0 +1,276 @@
+// Package reconcile brings Vultr resources to a desired state.
+//
+// A State describes the VPCs, firewall groups and rules, instances, block
+// storage, reserved IPs, load balancers and domains and records that should
+// exist. Plan compares it with what exists on the account and returns the
+// creates, updates and deletes needed, without changing anything. Apply
+// makes those changes in dependency order and reports the outcome of each.
+//
+//	r := reconcile.New(client, reconcile.WithOwnerTag("managed-by:deployer"))
+//
+//	plan, err := r.Plan(ctx, desired)
+//	fmt.Print(plan)
+//
+//	report := r.Apply(ctx, plan)
+//	if err := report.Err(); err != nil {
+//		...
+//	}
+//
+// Resources are matched by label, or description for VPCs and firewall
+// groups. Existing resources that aren't part of the desired state are only
+// deleted when they are in the reconciler's scope, see WithOwnerTag and
+// WithLabelPrefix. Without either nothing is deleted.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Reconciler plans and applies the changes that bring an account to a
+// desired State
+type Reconciler struct {
+	client      *govultr.Client
+	ownerTag    string
+	labelPrefix string
+	wait        bool
+	waitOptions *govultr.WaitOptions
+}
+
+// Option configures a Reconciler
+type Option func(*Reconciler)
+
+// WithOwnerTag puts the instances tagged with tag in scope, so they are
+// deleted when they aren't part of the desired state. The tag is added to
+// every instance the reconciler creates.
+func WithOwnerTag(tag string) Option {
+	return func(r *Reconciler) {
+		r.ownerTag = tag
+	}
+}
+
+// WithLabelPrefix puts every resource whose label or description starts with
+// prefix in scope, so it's deleted when it isn't part of the desired state
+func WithLabelPrefix(prefix string) Option {
+	return func(r *Reconciler) {
+		r.labelPrefix = prefix
+	}
+}
+
+// WithWait sets whether Apply waits for created instances and block storage
+// to become active before moving on, which attaching volumes and IPs to them
+// needs. It's on by default. opts configures the waiters and may be nil.
+func WithWait(wait bool, opts *govultr.WaitOptions) Option {
+	return func(r *Reconciler) {
+		r.wait = wait
+		r.waitOptions = opts
+	}
+}
+
+// New returns a Reconciler that uses client's services
+func New(client *govultr.Client, opts ...Option) *Reconciler {
+	r := &Reconciler{client: client, wait: true}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Plan reads the current state of the account and returns the changes that
+// turn it into desired. Nothing is changed, so Plan on its own is a dry run.
+// Desired resources that can't be reconciled, e.g. because they would have
+// to be replaced, are reported as errors.
+func (r *Reconciler) Plan(ctx context.Context, desired *State) (*Plan, error) {
+	if err := validate(desired); err != nil {
+		return nil, err
+	}
+
+	current, err := r.fetch(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &planner{Reconciler: r, desired: desired, current: current, ids: current.ids(), wanted: map[Kind]map[string]bool{
+		KindVPC:           set(names(desired.VPCs, func(v VPC) string { return v.Description })),
+		KindFirewallGroup: set(names(desired.FirewallGroups, func(g FirewallGroup) string { return g.Description })),
+		KindInstance:      set(names(desired.Instances, func(i Instance) string { return i.Label })),
+	}}
+	p.planVPCs()
+	p.planFirewallGroups()
+	p.planInstances()
+	p.planBlockStorage()
+	p.planReservedIPs()
+	p.planLoadBalancers()
+	p.planDomains()
+
+	if err := errors.Join(p.errs...); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(p.deletes)
+
+	return &Plan{Changes: append(p.changes, p.deletes...), ids: p.ids}, nil
+}
+
+// Apply makes the changes of a plan in order. A change that fails doesn't
+// stop the others, but changes that depend on a resource that couldn't be
+// created fail too. The report has the outcome of every change. With a
+// client in dry-run mode the requests are recorded in its dry-run plan and
+// creates fail with ErrNotCreated.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) *Report {
+	report := &Report{Results: make([]Result, 0, len(plan.Changes))}
+
+	for _, change := range plan.Changes {
+		result := Result{Change: change}
+
+		if result.Err = ctx.Err(); result.Err == nil {
+			result.ID, result.Err = change.apply(ctx, plan.ids)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// Reconcile plans and applies the changes that turn the account into desired
+func (r *Reconciler) Reconcile(ctx context.Context, desired *State) (*Plan, *Report, error) {
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := r.Apply(ctx, plan)
+
+	return plan, report, report.Err()
+}
+
+// inScope reports whether an existing resource that isn't desired should be
+// deleted
+func (r *Reconciler) inScope(label string, tags []string) bool {
+	if r.ownerTag != "" && slices.Contains(tags, r.ownerTag) {
+		return true
+	}
+
+	return r.labelPrefix != "" && strings.HasPrefix(label, r.labelPrefix)
+}
+
+// planner collects the changes of a plan
+type planner struct {
+	*Reconciler
+
+	desired *State
+	current *current
+	ids     *ids
+
+	// Names of the desired resources other resources can refer to
+	wanted map[Kind]map[string]bool
+
+	changes []*Change
+	deletes []*Change
+	errs    []error
+}
+
+func (p *planner) add(change *Change) {
+	if change.Action == Delete {
+		p.deletes = append(p.deletes, change)
+		return
+	}
+
+	p.changes = append(p.changes, change)
+}
+
+func (p *planner) errorf(kind Kind, name, format string, args ...any) {
+	p.errs = append(p.errs, fmt.Errorf("%s %s: %s", kind, name, fmt.Sprintf(format, args...)))
+}
+
+// checkRefs checks that the resources a desired resource refers to are
+// either desired too or exist
+func (p *planner) checkRefs(kind Kind, name string, refKind Kind, refs ...string) {
+	for _, ref := range refs {
+		if ref != "" && !p.wanted[refKind][ref] && p.ids.lookup(refKind, ref) == "" {
+			p.errorf(kind, name, "%s %s is neither part of the desired state nor does it exist", refKind, ref)
+		}
+	}
+}
+
+// refID returns the ID a reference resolves to at plan time, or a note that
+// the resource is created by the plan
+func (p *planner) refID(kind Kind, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	if id := p.ids.lookup(kind, name); id != "" {
+		return id
+	}
+
+	return name + " (new)"
+}
+
+// diff formats a changed field
+func diff(field string, from, to any) string {
+	return fmt.Sprintf("%s: %v -> %v", field, from, to)
+}
+
+// validate checks that the resources of a state are named and unique
+func validate(desired *State) error {
+	var errs []error
+	check := func(kind Kind, names []string) {
+		seen := map[string]bool{}
+		for _, name := range names {
+			switch {
+			case name == "":
+				errs = append(errs, fmt.Errorf("%s without a name", kind))
+			case seen[name]:
+				errs = append(errs, fmt.Errorf("%s %s is listed more than once", kind, name))
+			}
+			seen[name] = true
+		}
+	}
+
+	check(KindVPC, names(desired.VPCs, func(v VPC) string { return v.Description }))
+	check(KindFirewallGroup, names(desired.FirewallGroups, func(g FirewallGroup) string { return g.Description }))
+	check(KindInstance, names(desired.Instances, func(i Instance) string { return i.Label }))
+	check(KindBlockStorage, names(desired.BlockStorage, func(b BlockStorage) string { return b.Label }))
+	check(KindReservedIP, names(desired.ReservedIPs, func(ip ReservedIP) string { return ip.Label }))
+	check(KindLoadBalancer, names(desired.LoadBalancers, func(lb LoadBalancer) string { return lb.Label }))
+	check(KindDomain, names(desired.Domains, func(d Domain) string { return d.Name }))
+
+	return errors.Join(errs...)
+}
+
+func names[T any](items []T, name func(T) string) []string {
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i] = name(item)
+	}
+
+	return result
+}
+
+func set(names []string) map[string]bool {
+	result := make(map[string]bool, len(names))
+	for _, name := range names {
+		result[name] = true
+	}
+
+	return result
+}
+
+// sameSet reports whether a and b hold the same strings in any order
+func sameSet(a, b []string) bool {
+	return slices.Equal(sorted(a), sorted(b))
+}
+
+func sorted(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	return slices.Compact(s)
+}
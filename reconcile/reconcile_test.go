@@ -0,0 +1,330 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/govultrtest"
+)
+
+var fastWait = &govultr.WaitOptions{PollInterval: time.Millisecond, Timeout: time.Second}
+
+func desiredState() *State {
+	return &State{
+		VPCs: []VPC{{Description: "app-net", Region: "ewr", Subnet: "10.10.0.0", SubnetMask: 24}},
+		FirewallGroups: []FirewallGroup{{
+			Description: "app-web",
+			Rules: []FirewallRule{
+				{IPType: "v4", Protocol: "tcp", Subnet: "0.0.0.0", SubnetSize: 0, Port: "443"},
+				{IPType: "v4", Protocol: "tcp", Subnet: "192.0.2.0", SubnetSize: 24, Port: "22"},
+			},
+		}},
+		Instances: []Instance{{
+			Label:         "app-web-1",
+			Region:        "ewr",
+			Plan:          "vc2-1c-1gb",
+			OsID:          2284,
+			Tags:          []string{"web"},
+			FirewallGroup: "app-web",
+			VPCs:          []string{"app-net"},
+		}},
+		BlockStorage: []BlockStorage{{Label: "app-data", Region: "ewr", SizeGB: 40, AttachTo: "app-web-1"}},
+		ReservedIPs:  []ReservedIP{{Label: "app-ip", Region: "ewr", IPType: "v4", AttachTo: "app-web-1"}},
+		LoadBalancers: []LoadBalancer{{
+			Label:     "app-lb",
+			Region:    "ewr",
+			Instances: []string{"app-web-1"},
+			ForwardingRules: []govultr.ForwardingRule{
+				{FrontendProtocol: "http", FrontendPort: 80, BackendProtocol: "http", BackendPort: 8080},
+			},
+			VPC: "app-net",
+		}},
+		Domains: []Domain{{
+			Name:    "example.com",
+			Records: []Record{{Type: "A", Name: "www", Data: "192.0.2.10", TTL: 300}},
+		}},
+	}
+}
+
+func newReconciler(srv *govultrtest.Server) (*Reconciler, *govultr.Client) {
+	client := srv.Client()
+	return New(client, WithOwnerTag("owner:app"), WithLabelPrefix("app-"), WithWait(true, fastWait)), client
+}
+
+func actions(plan *Plan) []string {
+	var result []string
+	for _, change := range plan.Changes {
+		result = append(result, string(change.Action)+" "+string(change.Kind)+" "+change.Name)
+	}
+
+	return result
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	srv := govultrtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	r, client := newReconciler(srv)
+
+	plan, report, err := r.Reconcile(ctx, desiredState())
+	if err != nil {
+		t.Fatalf("Reconcile returned %+v", err)
+	}
+
+	expected := []string{
+		"create vpc app-net",
+		"create firewall_group app-web",
+		`create firewall_rule app-web: v4 tcp 0.0.0.0/0 port 443`,
+		`create firewall_rule app-web: v4 tcp 192.0.2.0/24 port 22`,
+		"create instance app-web-1",
+		"create block_storage app-data",
+		"create reserved_ip app-ip",
+		"create load_balancer app-lb",
+		"create domain example.com",
+		`create domain_record example.com: A "www" 192.0.2.10`,
+	}
+	if got := actions(plan); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Reconcile planned %+v, expected %+v", got, expected)
+	}
+
+	if len(report.Results) != len(plan.Changes) || len(report.Failed()) != 0 {
+		t.Errorf("Reconcile reported %+v, expected %d successful results", report.Results, len(plan.Changes))
+	}
+	for _, result := range report.Results {
+		if result.ID == "" {
+			t.Errorf("Reconcile reported no ID for %s", result.Change)
+		}
+	}
+
+	instances, _, _, err := client.Instance.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Instance.List returned %+v", err)
+	}
+	if len(instances) != 1 || !reflect.DeepEqual(instances[0].Tags, []string{"web", "owner:app"}) || instances[0].FirewallGroupID == "" {
+		t.Errorf("Reconcile created %+v, expected a tagged instance in a firewall group", instances)
+	}
+
+	blocks, _, _, _ := client.BlockStorage.List(ctx, nil)
+	if len(blocks) != 1 || blocks[0].AttachedToInstance != instances[0].ID {
+		t.Errorf("Reconcile created %+v, expected a volume attached to %s", blocks, instances[0].ID)
+	}
+
+	lbs, _, _, _ := client.LoadBalancer.List(ctx, nil)
+	if len(lbs) != 1 || !reflect.DeepEqual(lbs[0].Instances, []string{instances[0].ID}) {
+		t.Errorf("Reconcile created %+v, expected a load balancer in front of %s", lbs, instances[0].ID)
+	}
+
+	plan, err = r.Plan(ctx, desiredState())
+	if err != nil {
+		t.Fatalf("Plan returned %+v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Plan returned %s, expected no changes once reconciled", plan)
+	}
+}
+
+func TestReconciler_Update(t *testing.T) {
+	srv := govultrtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	r, _ := newReconciler(srv)
+
+	if _, _, err := r.Reconcile(ctx, desiredState()); err != nil {
+		t.Fatalf("Reconcile returned %+v", err)
+	}
+
+	desired := desiredState()
+	desired.Instances[0].Plan = "vc2-2c-4gb"
+	desired.FirewallGroups[0].Rules = desired.FirewallGroups[0].Rules[:1]
+	desired.BlockStorage[0].SizeGB = 80
+	desired.LoadBalancers[0].ForwardingRules[0].BackendPort = 8443
+	desired.Domains[0].Records[0].TTL = 600
+	desired.Domains[0].PruneRecords = true
+
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		t.Fatalf("Plan returned %+v", err)
+	}
+
+	expected := []string{
+		"update instance app-web-1",
+		"update block_storage app-data",
+		"update load_balancer app-lb",
+		`update domain_record example.com: A "www" 192.0.2.10`,
+		`delete firewall_rule app-web: v4 tcp 192.0.2.0/24 port 22`,
+	}
+	if got := actions(plan); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Plan returned %+v, expected %+v", got, expected)
+	}
+
+	if diff := plan.Changes[0].Diff; !reflect.DeepEqual(diff, []string{"plan: vc2-1c-1gb -> vc2-2c-4gb"}) {
+		t.Errorf("Plan returned the instance diff %+v", diff)
+	}
+
+	if err := r.Apply(ctx, plan).Err(); err != nil {
+		t.Fatalf("Apply returned %+v", err)
+	}
+
+	plan, err = r.Plan(ctx, desired)
+	if err != nil {
+		t.Fatalf("Plan returned %+v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Plan returned %s, expected no changes once applied", plan)
+	}
+}
+
+func TestReconciler_Delete(t *testing.T) {
+	srv := govultrtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	r, client := newReconciler(srv)
+
+	if _, _, err := r.Reconcile(ctx, desiredState()); err != nil {
+		t.Fatalf("Reconcile returned %+v", err)
+	}
+
+	other, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284, Label: "other"})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	plan, report, err := r.Reconcile(ctx, &State{Domains: desiredState().Domains})
+	if err != nil {
+		t.Fatalf("Reconcile returned %+v", err)
+	}
+
+	expected := []string{
+		"delete load_balancer app-lb",
+		"delete reserved_ip app-ip",
+		"delete block_storage app-data",
+		"delete instance app-web-1",
+		"delete firewall_group app-web",
+		"delete vpc app-net",
+	}
+	if got := actions(plan); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Reconcile planned %+v, expected %+v", got, expected)
+	}
+	if len(report.Failed()) != 0 {
+		t.Errorf("Reconcile reported %+v", report.Failed())
+	}
+
+	instances, _, _, _ := client.Instance.List(ctx, nil)
+	if len(instances) != 1 || instances[0].ID != other.ID {
+		t.Errorf("Reconcile left %+v, expected only the out of scope instance", instances)
+	}
+}
+
+func TestReconciler_PlanErrors(t *testing.T) {
+	srv := govultrtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	r, _ := newReconciler(srv)
+
+	if _, _, err := r.Reconcile(ctx, desiredState()); err != nil {
+		t.Fatalf("Reconcile returned %+v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*State)
+		err    string
+	}{
+		{
+			name:   "duplicate",
+			change: func(s *State) { s.VPCs = append(s.VPCs, s.VPCs[0]) },
+			err:    "vpc app-net is listed more than once",
+		},
+		{
+			name:   "unknown reference",
+			change: func(s *State) { s.BlockStorage[0].AttachTo = "missing" },
+			err:    "block_storage app-data: instance missing is neither part of the desired state nor does it exist",
+		},
+		{
+			name:   "replacement",
+			change: func(s *State) { s.Instances[0].Region = "lax" },
+			err:    "instance app-web-1: region can't change from ewr to lax",
+		},
+		{
+			name:   "shrink",
+			change: func(s *State) { s.BlockStorage[0].SizeGB = 10 },
+			err:    "block_storage app-data: size can't shrink from 40GB to 10GB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := desiredState()
+			tt.change(desired)
+
+			plan, err := r.Plan(ctx, desired)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Plan returned %+v, %+v, expected %q", plan, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestReport_Err(t *testing.T) {
+	srv := govultrtest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, _ := newReconciler(srv)
+
+	plan, err := r.Plan(ctx, desiredState())
+	if err != nil {
+		t.Fatalf("Plan returned %+v", err)
+	}
+
+	cancel()
+
+	report := r.Apply(ctx, plan)
+	if len(report.Failed()) != len(plan.Changes) || report.Err() == nil {
+		t.Errorf("Apply returned %+v, expected every change to fail", report.Results)
+	}
+}
+
+func TestReconciler_DryRun(t *testing.T) {
+	srv := govultrtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	dryRun := govultr.NewDryRunPlan()
+	client := srv.Client(govultr.WithDryRun(dryRun))
+	r := New(client, WithWait(true, fastWait))
+
+	desired := &State{Instances: []Instance{{Label: "app-web-1", Region: "ewr", Plan: "vc2-1c-1gb", OsID: 2284}}}
+	_, report, err := r.Reconcile(ctx, desired)
+	if !errors.Is(err, ErrNotCreated) || len(report.Failed()) != 1 {
+		t.Errorf("Reconcile returned %+v, expected %+v", err, ErrNotCreated)
+	}
+
+	actions := dryRun.Actions()
+	if len(actions) != 1 || actions[0].Operation != "Instance.Create" {
+		t.Errorf("Reconcile recorded %+v, expected the instance create", actions)
+	}
+
+	// Changes that depend on resources that weren't created fail too
+	dryRun.Reset()
+	_, report, _ = r.Reconcile(ctx, desiredState())
+	if len(report.Failed()) != len(report.Results) || !errors.Is(report.Results[0].Err, ErrNotCreated) {
+		t.Errorf("Reconcile reported %+v, expected every change to fail", report.Results)
+	}
+	if len(dryRun.Actions()) == 0 {
+		t.Error("Reconcile recorded no actions")
+	}
+
+	if instances, _, _, _ := client.Instance.List(ctx, nil); len(instances) != 0 {
+		t.Errorf("Reconcile created %+v in dry-run mode", instances)
+	}
+}
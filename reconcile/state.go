@@ -0,0 +1,139 @@
+package reconcile
+
+import "github.com/vultr/govultr/v3"
+
+// State is the desired state of the resources a Reconciler manages. Each
+// resource is identified by its label, or its description for VPCs and
+// firewall groups and its name for domains, so labels have to be unique.
+// Other resources refer to each other by those names too.
+type State struct {
+	VPCs           []VPC           `json:"vpcs,omitempty"`
+	FirewallGroups []FirewallGroup `json:"firewall_groups,omitempty"`
+	Instances      []Instance      `json:"instances,omitempty"`
+	BlockStorage   []BlockStorage  `json:"block_storage,omitempty"`
+	ReservedIPs    []ReservedIP    `json:"reserved_ips,omitempty"`
+	LoadBalancers  []LoadBalancer  `json:"load_balancers,omitempty"`
+	Domains        []Domain        `json:"domains,omitempty"`
+}
+
+// VPC is a desired VPC. VPCs can't be changed once created, only replaced.
+type VPC struct {
+	Description string `json:"description"`
+	Region      string `json:"region"`
+
+	// Subnet and mask, picked by Vultr when empty
+	Subnet     string `json:"subnet,omitempty"`
+	SubnetMask int    `json:"subnet_mask,omitempty"`
+}
+
+// FirewallGroup is a desired firewall group and all of its rules. Rules that
+// exist in the group but aren't listed are deleted.
+type FirewallGroup struct {
+	Description string         `json:"description"`
+	Rules       []FirewallRule `json:"rules,omitempty"`
+}
+
+// FirewallRule is a desired firewall rule. Rules can't be updated, a changed
+// rule is deleted and created again.
+type FirewallRule struct {
+	IPType     govultr.IPType   `json:"ip_type"`
+	Protocol   govultr.Protocol `json:"protocol"`
+	Subnet     string           `json:"subnet"`
+	SubnetSize int              `json:"subnet_size"`
+	Port       string           `json:"port,omitempty"`
+	Source     string           `json:"source,omitempty"`
+	Notes      string           `json:"notes,omitempty"`
+}
+
+// Instance is a desired instance
+type Instance struct {
+	Label  string `json:"label"`
+	Region string `json:"region"`
+	Plan   string `json:"plan"`
+
+	// Image of the instance. Changing it on an existing instance would
+	// reinstall it, so that's reported as an error instead.
+	OsID       int    `json:"os_id,omitempty"`
+	AppID      int    `json:"app_id,omitempty"`
+	ImageID    string `json:"image_id,omitempty"`
+	SnapshotID string `json:"snapshot_id,omitempty"`
+
+	Hostname   string   `json:"hostname,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	SSHKeys    []string `json:"ssh_keys,omitempty"`
+	UserData   string   `json:"user_data,omitempty"`
+	EnableIPv6 bool     `json:"enable_ipv6,omitempty"`
+
+	// Description of the firewall group the instance is in. Empty leaves
+	// the group of an existing instance alone.
+	FirewallGroup string `json:"firewall_group,omitempty"`
+
+	// Descriptions of the VPCs attached to the instance. Nil leaves the
+	// VPCs of an existing instance alone.
+	VPCs []string `json:"vpcs,omitempty"`
+}
+
+// BlockStorage is a desired block storage volume. Volumes can grow but not
+// shrink.
+type BlockStorage struct {
+	Label     string            `json:"label"`
+	Region    string            `json:"region"`
+	SizeGB    int               `json:"size_gb"`
+	BlockType govultr.BlockType `json:"block_type,omitempty"`
+
+	// Label of the instance the volume is attached to, detached when empty
+	AttachTo string `json:"attach_to,omitempty"`
+
+	// Attach and detach without restarting the instance
+	Live bool `json:"live,omitempty"`
+}
+
+// ReservedIP is a desired reserved IP
+type ReservedIP struct {
+	Label  string `json:"label"`
+	Region string `json:"region"`
+	IPType string `json:"ip_type"`
+
+	// Label of the instance the IP is attached to, detached when empty
+	AttachTo string `json:"attach_to,omitempty"`
+}
+
+// LoadBalancer is a desired load balancer
+type LoadBalancer struct {
+	Label  string `json:"label"`
+	Region string `json:"region"`
+
+	// Labels of the instances behind the load balancer
+	Instances []string `json:"instances,omitempty"`
+
+	ForwardingRules []govultr.ForwardingRule `json:"forwarding_rules,omitempty"`
+
+	// Left as they are when not set
+	HealthCheck        *govultr.HealthCheck       `json:"health_check,omitempty"`
+	BalancingAlgorithm govultr.BalancingAlgorithm `json:"balancing_algorithm,omitempty"`
+
+	// Description of the VPC the load balancer is attached to
+	VPC string `json:"vpc,omitempty"`
+}
+
+// Domain is a desired DNS domain. Domains are created and updated but never
+// deleted, only their records are.
+type Domain struct {
+	Name    string   `json:"name"`
+	DNSSec  bool     `json:"dns_sec,omitempty"`
+	Records []Record `json:"records,omitempty"`
+
+	// Delete the records of the domain that aren't listed, except the NS
+	// records of the domain itself
+	PruneRecords bool `json:"prune_records,omitempty"`
+}
+
+// Record is a desired DNS record. Records are identified by their type,
+// name and data, so only the TTL and priority of a record are updated.
+type Record struct {
+	Type     govultr.RecordType `json:"type"`
+	Name     string             `json:"name"`
+	Data     string             `json:"data"`
+	TTL      int                `json:"ttl,omitempty"`
+	Priority int                `json:"priority,omitempty"`
+}
@@ -0,0 +1,284 @@
+package reconcile
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// instanceLabel returns the label of the existing instance with id
+func (p *planner) instanceLabel(id string) string {
+	for _, instance := range p.current.instances.all {
+		if instance.ID == id {
+			return instance.Label
+		}
+	}
+
+	return id
+}
+
+// attachmentDiff returns the diff of the instance something is attached to,
+// empty when it doesn't change
+func (p *planner) attachmentDiff(attachedTo, attachTo string) string {
+	if p.refID(KindInstance, attachTo) == attachedTo {
+		return ""
+	}
+
+	from, to := "none", "none"
+	if attachedTo != "" {
+		from = p.instanceLabel(attachedTo)
+	}
+	if attachTo != "" {
+		to = attachTo
+	}
+
+	return diff("attached_to", from, to)
+}
+
+func (p *planner) planBlockStorage() {
+	for _, block := range p.desired.BlockStorage {
+		p.checkRefs(KindBlockStorage, block.Label, KindInstance, block.AttachTo)
+
+		existing, ok, err := p.current.blocks.get(block.Label)
+		switch {
+		case err != nil:
+			p.errorf(KindBlockStorage, block.Label, "%s", err)
+		case !ok:
+			p.add(p.createBlockStorage(block))
+		default:
+			if change := p.updateBlockStorage(block, existing); change != nil {
+				p.add(change)
+			}
+		}
+	}
+
+	for _, existing := range p.current.blocks.all {
+		if p.desiredBlockStorage(existing.Label) || !p.inScope(existing.Label, nil) {
+			continue
+		}
+
+		p.add(&Change{
+			Action: Delete, Kind: KindBlockStorage, Name: existing.Label, ID: existing.ID,
+			apply: func(ctx context.Context, _ *ids) (string, error) {
+				if existing.AttachedToInstance != "" {
+					if err := p.client.BlockStorage.Detach(ctx, existing.ID, &govultr.BlockStorageDetach{}); err != nil {
+						return existing.ID, err
+					}
+				}
+
+				return existing.ID, p.client.BlockStorage.Delete(ctx, existing.ID)
+			},
+		})
+	}
+}
+
+func (p *planner) desiredBlockStorage(label string) bool {
+	for _, block := range p.desired.BlockStorage {
+		if block.Label == label {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *planner) createBlockStorage(block BlockStorage) *Change {
+	return &Change{
+		Action: Create, Kind: KindBlockStorage, Name: block.Label,
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			instanceID, err := ids.get(KindInstance, block.AttachTo)
+			if err != nil {
+				return "", err
+			}
+
+			created, _, err := p.client.BlockStorage.Create(ctx, &govultr.BlockStorageCreate{
+				Region:    block.Region,
+				SizeGB:    block.SizeGB,
+				Label:     block.Label,
+				BlockType: block.BlockType,
+			})
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			if instanceID == "" {
+				return created.ID, nil
+			}
+
+			if p.wait {
+				if _, err := p.client.WaitForBlockStorageActive(ctx, created.ID, p.waitOptions); err != nil {
+					return created.ID, err
+				}
+			}
+
+			return created.ID, p.client.BlockStorage.Attach(ctx, created.ID, &govultr.BlockStorageAttach{
+				InstanceID: instanceID,
+				Live:       govultr.BoolToBoolPtr(block.Live),
+			})
+		},
+	}
+}
+
+// updateBlockStorage returns the change that resizes, attaches or detaches
+// an existing volume, nil if it's up to date
+func (p *planner) updateBlockStorage(block BlockStorage, existing govultr.BlockStorage) *Change {
+	if block.Region != existing.Region {
+		p.errorf(KindBlockStorage, block.Label, "region can't change from %s to %s", existing.Region, block.Region)
+	}
+	if block.BlockType != "" && block.BlockType != existing.BlockType {
+		p.errorf(KindBlockStorage, block.Label, "block type can't change from %s to %s", existing.BlockType, block.BlockType)
+	}
+	if block.SizeGB < existing.SizeGB {
+		p.errorf(KindBlockStorage, block.Label, "size can't shrink from %dGB to %dGB", existing.SizeGB, block.SizeGB)
+	}
+
+	change := &Change{Action: Update, Kind: KindBlockStorage, Name: block.Label, ID: existing.ID}
+
+	resize := block.SizeGB > existing.SizeGB
+	if resize {
+		change.Diff = append(change.Diff, diff("size_gb", existing.SizeGB, block.SizeGB))
+	}
+
+	attachment := p.attachmentDiff(existing.AttachedToInstance, block.AttachTo)
+	if attachment != "" {
+		change.Diff = append(change.Diff, attachment)
+	}
+
+	if len(change.Diff) == 0 {
+		return nil
+	}
+
+	change.apply = func(ctx context.Context, ids *ids) (string, error) {
+		if resize {
+			if err := p.client.BlockStorage.Update(ctx, existing.ID, &govultr.BlockStorageUpdate{SizeGB: block.SizeGB}); err != nil {
+				return existing.ID, err
+			}
+		}
+
+		if attachment == "" {
+			return existing.ID, nil
+		}
+
+		live := govultr.BoolToBoolPtr(block.Live)
+		if existing.AttachedToInstance != "" {
+			if err := p.client.BlockStorage.Detach(ctx, existing.ID, &govultr.BlockStorageDetach{Live: live}); err != nil {
+				return existing.ID, err
+			}
+		}
+
+		instanceID, err := ids.get(KindInstance, block.AttachTo)
+		if err != nil || instanceID == "" {
+			return existing.ID, err
+		}
+
+		return existing.ID, p.client.BlockStorage.Attach(ctx, existing.ID, &govultr.BlockStorageAttach{InstanceID: instanceID, Live: live})
+	}
+
+	return change
+}
+
+func (p *planner) planReservedIPs() {
+	wanted := map[string]bool{}
+	for _, ip := range p.desired.ReservedIPs {
+		wanted[ip.Label] = true
+		p.checkRefs(KindReservedIP, ip.Label, KindInstance, ip.AttachTo)
+
+		existing, ok, err := p.current.reservedIPs.get(ip.Label)
+		switch {
+		case err != nil:
+			p.errorf(KindReservedIP, ip.Label, "%s", err)
+		case !ok:
+			p.add(p.createReservedIP(ip))
+		default:
+			if change := p.updateReservedIP(ip, existing); change != nil {
+				p.add(change)
+			}
+		}
+	}
+
+	for _, existing := range p.current.reservedIPs.all {
+		if wanted[existing.Label] || !p.inScope(existing.Label, nil) {
+			continue
+		}
+
+		p.add(&Change{
+			Action: Delete, Kind: KindReservedIP, Name: existing.Label, ID: existing.ID,
+			apply: func(ctx context.Context, _ *ids) (string, error) {
+				if existing.InstanceID != "" {
+					if err := p.client.ReservedIP.Detach(ctx, existing.ID); err != nil {
+						return existing.ID, err
+					}
+				}
+
+				return existing.ID, p.client.ReservedIP.Delete(ctx, existing.ID)
+			},
+		})
+	}
+}
+
+func (p *planner) createReservedIP(ip ReservedIP) *Change {
+	return &Change{
+		Action: Create, Kind: KindReservedIP, Name: ip.Label,
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			instanceID, err := ids.get(KindInstance, ip.AttachTo)
+			if err != nil {
+				return "", err
+			}
+
+			created, _, err := p.client.ReservedIP.Create(ctx, &govultr.ReservedIPReq{
+				Region: ip.Region,
+				IPType: ip.IPType,
+				Label:  ip.Label,
+			})
+			if err != nil {
+				return "", err
+			}
+			if created == nil {
+				return "", ErrNotCreated
+			}
+
+			if instanceID == "" {
+				return created.ID, nil
+			}
+
+			return created.ID, p.client.ReservedIP.Attach(ctx, created.ID, instanceID)
+		},
+	}
+}
+
+// updateReservedIP returns the change that moves a reserved IP to another
+// instance, nil if it's up to date
+func (p *planner) updateReservedIP(ip ReservedIP, existing govultr.ReservedIP) *Change {
+	if ip.Region != existing.Region {
+		p.errorf(KindReservedIP, ip.Label, "region can't change from %s to %s", existing.Region, ip.Region)
+	}
+	if ip.IPType != existing.IPType {
+		p.errorf(KindReservedIP, ip.Label, "ip type can't change from %s to %s", existing.IPType, ip.IPType)
+	}
+
+	attachment := p.attachmentDiff(existing.InstanceID, ip.AttachTo)
+	if attachment == "" {
+		return nil
+	}
+
+	return &Change{
+		Action: Update, Kind: KindReservedIP, Name: ip.Label, ID: existing.ID, Diff: []string{attachment},
+		apply: func(ctx context.Context, ids *ids) (string, error) {
+			if existing.InstanceID != "" {
+				if err := p.client.ReservedIP.Detach(ctx, existing.ID); err != nil {
+					return existing.ID, err
+				}
+			}
+
+			instanceID, err := ids.get(KindInstance, ip.AttachTo)
+			if err != nil || instanceID == "" {
+				return existing.ID, err
+			}
+
+			return existing.ID, p.client.ReservedIP.Attach(ctx, existing.ID, instanceID)
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// RedactedValue replaces secrets in redacted headers and bodies
+const RedactedValue = "[REDACTED]"
+
+// JSON fields whose values are secrets in requests to or responses from the API
+var sensitiveFields = map[string]bool{
+	"password":         true,
+	"default_password": true,
+	"api_key":          true,
+	"ssl_cert_key":     true,
+	"s3_secret_key":    true,
+	"s3_access_key":    true,
+	"secret":           true,
+	"client_secret":    true,
+	"access_key":       true,
+	"private_key":      true,
+	"private_key_b64":  true,
+	"token":            true,
+	"access_token":     true,
+	"refresh_token":    true,
+	"id_token":         true,
+	"session_token":    true,
+	"kube_config":      true,
+	"user_data":        true,
+	"auth":             true,
+	"auths":            true,
+}
+
+// Headers that carry credentials
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// IsSensitiveField reports whether a JSON field holds a secret, such as
+// password, api_key, ssl_cert_key, s3_secret_key or kube_config
+func IsSensitiveField(name string) bool {
+	return sensitiveFields[strings.ToLower(name)]
+}
+
+// RedactHeaders returns a copy of the headers with credentials replaced by RedactedValue
+func RedactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, RedactedValue)
+		}
+	}
+
+	return redacted
+}
+
+// RedactBody returns a copy of a JSON body with the value of every sensitive
+// field replaced by RedactedValue, at any depth. Bodies that are not JSON are
+// returned unchanged.
+func RedactBody(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return body
+	}
+
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if IsSensitiveField(key) {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+
+	return value
+}
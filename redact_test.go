@@ -0,0 +1,53 @@
+package govultr
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"database":{"id":"abc","password":"p4ss","users":[{"username":"vultradmin","Password":"p4ss"}],
+		"port":16751},"s3_secret_key":"s3cr3t","ssl_cert_key":"-----BEGIN","count":10.50}`)
+
+	var redacted map[string]interface{}
+	if err := json.Unmarshal(RedactBody(body), &redacted); err != nil {
+		t.Fatalf("RedactBody returned invalid JSON: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"database": map[string]interface{}{
+			"id":       "abc",
+			"password": RedactedValue,
+			"users":    []interface{}{map[string]interface{}{"username": "vultradmin", "Password": RedactedValue}},
+			"port":     float64(16751),
+		},
+		"s3_secret_key": RedactedValue,
+		"ssl_cert_key":  RedactedValue,
+		"count":         10.5,
+	}
+
+	if !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("RedactBody returned %+v, expected %+v", redacted, expected)
+	}
+
+	if got := string(RedactBody([]byte("not json"))); got != "not json" {
+		t.Errorf("RedactBody returned %s, expected non JSON bodies unchanged", got)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer key")
+	header.Set("Accept", "application/json")
+
+	redacted := RedactHeaders(header)
+	if redacted.Get("Authorization") != RedactedValue || redacted.Get("Accept") != "application/json" {
+		t.Errorf("RedactHeaders returned %+v", redacted)
+	}
+
+	if header.Get("Authorization") != "Bearer key" {
+		t.Error("RedactHeaders modified the original headers")
+	}
+}
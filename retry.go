@@ -0,0 +1,177 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// IdempotencyKeyHeader is the header WithIdempotencyKey sends its key in
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// ErrRequestApplied is wrapped by the error returned when a DedupCheck finds
+// that a failed request took effect anyway, so it was not retried
+var ErrRequestApplied = errors.New("request took effect despite failing")
+
+// RetryPolicy decides which failed attempts of a request are retried and how
+// long to wait before each retry. The number of retries is set separately
+// with SetRetryLimit or WithRetryLimit.
+type RetryPolicy interface {
+	// ShouldRetry reports whether the attempt that returned resp or err is
+	// retried. A non nil error replaces the attempt's error.
+	ShouldRetry(req *http.Request, resp *http.Response, err error) (bool, error)
+
+	// Backoff returns how long to wait before retry n, counting from 0. The
+	// minimum and maximum wait come from SetRateLimit or WithRetryWait.
+	Backoff(minWait, maxWait time.Duration, n int, resp *http.Response) time.Duration
+}
+
+// DefaultRetryPolicy retries connection errors and responses with one of its
+// status codes using jittered exponential backoff, honoring Retry-After.
+//
+// POST and PATCH requests are not idempotent, retrying one that failed after
+// the API acted on it can create a duplicate resource. They are only retried
+// on a 429, which the API returns before doing any work, unless the call was
+// opted in with WithIdempotencyKey or WithDedupCheck.
+type DefaultRetryPolicy struct {
+	// StatusCodes that are retried, 429, 500, 502, 503 and 504 when empty
+	StatusCodes []int
+
+	// RetryNonIdempotent retries POST and PATCH requests like any other
+	RetryNonIdempotent bool
+
+	// DisableJitter makes the wait before a retry exact instead of random
+	// between half of it and all of it
+	DisableJitter bool
+}
+
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// ShouldRetry implements RetryPolicy
+func (p *DefaultRetryPolicy) ShouldRetry(req *http.Request, resp *http.Response, err error) (bool, error) {
+	ctx := req.Context()
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	rateLimited := err == nil && resp.StatusCode == http.StatusTooManyRequests
+	if !p.RetryNonIdempotent && !rateLimited && !IsIdempotent(req) && !retryOptIn(ctx) {
+		return false, nil
+	}
+
+	if err != nil {
+		// retryablehttp knows which connection errors are permanent
+		return retryablehttp.DefaultRetryPolicy(ctx, nil, err)
+	}
+
+	codes := p.StatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryStatusCodes
+	}
+
+	return slices.Contains(codes, resp.StatusCode), nil
+}
+
+// Backoff implements RetryPolicy
+func (p *DefaultRetryPolicy) Backoff(minWait, maxWait time.Duration, n int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		now := time.Now()
+		if after, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return max(after.Sub(now), 0)
+		}
+	}
+
+	wait := maxWait
+	if exp := math.Pow(2, float64(n)) * float64(minWait); exp < float64(maxWait) {
+		wait = time.Duration(exp)
+	}
+
+	if p.DisableJitter || wait <= 1 {
+		return wait
+	}
+
+	half := wait / 2
+	return half + rand.N(wait-half+1) //nolint:gosec
+}
+
+// IsIdempotent reports whether sending the request more than once has the
+// same effect as sending it once. Only POST and PATCH are not idempotent.
+func IsIdempotent(req *http.Request) bool {
+	return req.Method != http.MethodPost && req.Method != http.MethodPatch
+}
+
+// SetRetryPolicy sets which failed requests are retried and the wait before
+// each retry. Passing nil restores the DefaultRetryPolicy.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	if policy == nil {
+		policy = &DefaultRetryPolicy{}
+	}
+
+	c.retryPolicy = policy
+}
+
+// RetryPolicy returns the client's retry policy
+func (c *Client) RetryPolicy() RetryPolicy {
+	return c.retryPolicy
+}
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey returns a context that opts a non-idempotent call into
+// retries. The key is sent in the Idempotency-Key header of every attempt so
+// a server or proxy that supports it can drop duplicates. Use a new key for
+// every logical request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// DedupCheck is run before a failed non-idempotent request is retried. It
+// reports whether the failed attempt took effect anyway, for example by
+// looking up the instance label that was being created. A request that took
+// effect is not retried.
+type DedupCheck func(ctx context.Context) (applied bool, err error)
+
+type dedupCheckKey struct{}
+
+// WithDedupCheck returns a context that opts a non-idempotent call into
+// retries, with the check deciding before each retry whether it is needed
+func WithDedupCheck(ctx context.Context, check DedupCheck) context.Context {
+	return context.WithValue(ctx, dedupCheckKey{}, check)
+}
+
+// retryOptIn reports whether a non-idempotent call was opted into retries
+func retryOptIn(ctx context.Context) bool {
+	if key, ok := ctx.Value(idempotencyKey{}).(string); ok && key != "" {
+		return true
+	}
+
+	check, ok := ctx.Value(dedupCheckKey{}).(DedupCheck)
+	return ok && check != nil
+}
+
+// dedupApplied runs the call's DedupCheck, if any, before a non-idempotent
+// request is retried. A 429 means the API did nothing so it is not checked.
+func dedupApplied(req *http.Request, resp *http.Response) (bool, error) {
+	if IsIdempotent(req) || (resp != nil && resp.StatusCode == http.StatusTooManyRequests) {
+		return false, nil
+	}
+
+	check, ok := req.Context().Value(dedupCheckKey{}).(DedupCheck)
+	if !ok || check == nil {
+		return false, nil
+	}
+
+	return check(req.Context())
+}
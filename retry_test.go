@@ -0,0 +1,278 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func retryHandler(attempts *int, failures int, status int) func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		*attempts++
+		writer.Header().Set("Content-Type", "application/json")
+		if *attempts <= failures {
+			writer.WriteHeader(status)
+			fmt.Fprintf(writer, `{"error":"failed","status":%d}`, status)
+			return
+		}
+		fmt.Fprint(writer, `{"instance":{"id":"14b3e7d6-ffb5-4994-8502-57fcd9db3b33","label":"web"}}`)
+	}
+}
+
+func TestDefaultRetryPolicy_NonIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/instances", retryHandler(&attempts, 1, http.StatusInternalServerError))
+
+	_, _, err := client.Instance.Create(ctx, &InstanceCreateReq{Label: "web", OsID: 2284})
+	if !IsServerError(err) {
+		t.Errorf("Instance.Create returned %+v, expected a server error", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("Instance.Create made %d attempts, expected a POST not to be retried on a 500", attempts)
+	}
+}
+
+func TestDefaultRetryPolicy_NonIdempotentRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/instances", retryHandler(&attempts, 1, http.StatusTooManyRequests))
+
+	if _, _, err := client.Instance.Create(ctx, &InstanceCreateReq{Label: "web", OsID: 2284}); err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("Instance.Create made %d attempts, expected a 429 to be retried", attempts)
+	}
+}
+
+func TestDefaultRetryPolicy_Idempotent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33", retryHandler(&attempts, 2, http.StatusBadGateway))
+
+	if _, _, err := client.Instance.Get(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("Instance.Get made %d attempts, expected 3", attempts)
+	}
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(time.Millisecond)
+
+	attempts := 0
+	handler := retryHandler(&attempts, 1, http.StatusInternalServerError)
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		if key := request.Header.Get(IdempotencyKeyHeader); key != "create-web" {
+			t.Errorf("%s = %q, expected %q", IdempotencyKeyHeader, key, "create-web")
+		}
+		handler(writer, request)
+	})
+
+	instance, _, err := client.Instance.Create(WithIdempotencyKey(ctx, "create-web"), &InstanceCreateReq{Label: "web", OsID: 2284})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if attempts != 2 || instance.Label != "web" {
+		t.Errorf("Instance.Create returned %+v after %d attempts, expected web after 2", instance, attempts)
+	}
+}
+
+func TestWithDedupCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		applied  bool
+		attempts int
+	}{
+		{"applied", true, 1},
+		{"not applied", false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			client.SetRateLimit(time.Millisecond)
+
+			attempts := 0
+			mux.HandleFunc("/v2/instances", retryHandler(&attempts, 1, http.StatusInternalServerError))
+
+			checks := 0
+			check := func(ctx context.Context) (bool, error) {
+				checks++
+				return tt.applied, nil
+			}
+
+			_, _, err := client.Instance.Create(WithDedupCheck(ctx, check), &InstanceCreateReq{Label: "web", OsID: 2284})
+			if tt.applied {
+				if !errors.Is(err, ErrRequestApplied) || !IsServerError(err) {
+					t.Errorf("Instance.Create returned %+v, expected ErrRequestApplied and the server error", err)
+				}
+			} else if err != nil {
+				t.Errorf("Instance.Create returned %+v", err)
+			}
+
+			if attempts != tt.attempts || checks != 1 {
+				t.Errorf("Instance.Create made %d attempts and %d checks, expected %d and 1", attempts, checks, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestWithDedupCheck_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(time.Millisecond)
+
+	attempts := 0
+	mux.HandleFunc("/v2/instances", retryHandler(&attempts, 1, http.StatusInternalServerError))
+
+	checkErr := errors.New("lookup failed")
+	check := func(ctx context.Context) (bool, error) {
+		return false, checkErr
+	}
+
+	if _, _, err := client.Instance.Create(WithDedupCheck(ctx, check), &InstanceCreateReq{Label: "web", OsID: 2284}); !errors.Is(err, checkErr) {
+		t.Errorf("Instance.Create returned %+v, expected %+v", err, checkErr)
+	}
+
+	if attempts != 1 {
+		t.Errorf("Instance.Create made %d attempts, expected 1", attempts)
+	}
+}
+
+func TestDefaultRetryPolicy_Options(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(time.Millisecond)
+	client.SetRetryPolicy(&DefaultRetryPolicy{
+		StatusCodes:        []int{http.StatusInternalServerError},
+		RetryNonIdempotent: true,
+	})
+
+	attempts := 0
+	mux.HandleFunc("/v2/instances", retryHandler(&attempts, 1, http.StatusInternalServerError))
+
+	if _, _, err := client.Instance.Create(ctx, &InstanceCreateReq{Label: "web", OsID: 2284}); err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("Instance.Create made %d attempts, expected RetryNonIdempotent to retry", attempts)
+	}
+
+	getAttempts := 0
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		getAttempts++
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, _, err := client.Account.Get(ctx); !IsServerError(err) {
+		t.Errorf("Account.Get returned %+v, expected a server error", err)
+	}
+
+	if getAttempts != 1 {
+		t.Errorf("Account.Get made %d attempts, expected a 503 not to be retried", getAttempts)
+	}
+}
+
+type countingRetryPolicy struct {
+	calls int
+}
+
+func (p *countingRetryPolicy) ShouldRetry(req *http.Request, resp *http.Response, err error) (bool, error) {
+	p.calls++
+	return resp.StatusCode == http.StatusConflict, nil
+}
+
+func (p *countingRetryPolicy) Backoff(minWait, maxWait time.Duration, n int, resp *http.Response) time.Duration {
+	return 0
+}
+
+func TestClient_SetRetryPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	policy := &countingRetryPolicy{}
+	client.SetRetryPolicy(policy)
+
+	if client.RetryPolicy() != policy {
+		t.Errorf("Client.RetryPolicy returned %+v, expected %+v", client.RetryPolicy(), policy)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33", retryHandler(&attempts, 1, http.StatusConflict))
+
+	if _, _, err := client.Instance.Get(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	if attempts != 2 || policy.calls != 2 {
+		t.Errorf("Instance.Get made %d attempts and %d policy calls, expected 2 and 2", attempts, policy.calls)
+	}
+
+	client.SetRetryPolicy(nil)
+	if _, ok := client.RetryPolicy().(*DefaultRetryPolicy); !ok {
+		t.Errorf("Client.RetryPolicy returned %+v, expected the default policy", client.RetryPolicy())
+	}
+}
+
+func TestDefaultRetryPolicy_Backoff(t *testing.T) {
+	exact := &DefaultRetryPolicy{DisableJitter: true}
+
+	tests := []struct {
+		n        int
+		expected time.Duration
+	}{
+		{0, 100 * time.Millisecond},
+		{1, 200 * time.Millisecond},
+		{2, 400 * time.Millisecond},
+		{3, 500 * time.Millisecond},
+		{100, 500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		if wait := exact.Backoff(100*time.Millisecond, 500*time.Millisecond, tt.n, nil); wait != tt.expected {
+			t.Errorf("Backoff(%d) returned %v, expected %v", tt.n, wait, tt.expected)
+		}
+	}
+
+	jittered := &DefaultRetryPolicy{}
+	for range 100 {
+		if wait := jittered.Backoff(100*time.Millisecond, time.Second, 1, nil); wait < 100*time.Millisecond || wait > 200*time.Millisecond {
+			t.Fatalf("Backoff returned %v, expected between 100ms and 200ms", wait)
+		}
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"3"}}}
+	if wait := jittered.Backoff(100*time.Millisecond, time.Second, 0, resp); wait < 2*time.Second || wait > 3*time.Second {
+		t.Errorf("Backoff returned %v, expected the 3s from Retry-After", wait)
+	}
+}
@@ -25,10 +25,10 @@ type SSHKeyServiceHandler struct {
 
 // SSHKey represents an SSH Key on Vultr
 type SSHKey struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	SSHKey      string `json:"ssh_key"`
-	DateCreated string `json:"date_created"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	SSHKey      string    `json:"ssh_key"`
+	DateCreated Timestamp `json:"date_created"`
 }
 
 // SSHKeyReq is the ssh key struct for create and update calls
@@ -27,12 +27,12 @@ type StartupScriptServiceHandler struct {
 
 // StartupScript represents an startup script on Vultr
 type StartupScript struct {
-	ID           string `json:"id"`
-	DateCreated  string `json:"date_created"`
-	DateModified string `json:"date_modified"`
-	Name         string `json:"name"`
-	Type         string `json:"type"`
-	Script       string `json:"script"`
+	ID           string    `json:"id"`
+	DateCreated  Timestamp `json:"date_created"`
+	DateModified Timestamp `json:"date_modified"`
+	Name         string    `json:"name"`
+	Type         string    `json:"type"`
+	Script       string    `json:"script"`
 }
 
 // StartupScriptReq is the user struct for create and update calls
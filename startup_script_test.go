@@ -2,57 +2,83 @@ package govultr
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
 )
 
-func TestStartupScriptServiceHandler_Create(t *testing.T) {
+func TestStartupScriptServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/startup-scripts", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"startup_script": {"id": "14356","date_created": "2020-07-07 18:52:56","date_modified": "2020-07-07 18:59:54","name": "govultr","type": "boot","script": "dGVzdGFwaXVwZGF0ZQ=="}}`
-		fmt.Fprint(writer, response)
-	})
-
-	script := &StartupScriptReq{
-		Name:   "govultr",
-		Type:   "boot",
-		Script: "dGVzdGFwaXVwZGF0ZQ==",
+	mux.HandleFunc("/v2/startup-scripts", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"startup_scripts": [
+		{
+			"id": "14350",
+			"date_created": "2020-06-08 17:58:10",
+			"date_modified": "2020-06-08 17:59:54",
+			"name": "govultr",
+			"type": "pxe",
+			"script": "dGVzdA=="
+		}
+	],
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "",
+			"prev": ""
+		}
 	}
-	s, _, err := client.StartupScript.Create(context.Background(), script)
+}`))
 
+	scripts, meta, _, err := client.StartupScript.List(ctx, nil)
 	if err != nil {
-		t.Errorf("StartupScript.Create returned %+v, expected %+v", err, nil)
+		t.Errorf("StartupScript.List returned error: %v", err)
 	}
 
-	expected := &StartupScript{
-		ID:           "14356",
-		DateCreated:  "2020-07-07 18:52:56",
-		DateModified: "2020-07-07 18:59:54",
-		Name:         "govultr",
-		Type:         "boot",
-		Script:       "dGVzdGFwaXVwZGF0ZQ==",
+	expectedScript := []StartupScript{
+		{
+			ID:           "14350",
+			DateCreated:  "2020-06-08 17:58:10",
+			DateModified: "2020-06-08 17:59:54",
+			Name:         "govultr",
+			Type:         "pxe",
+			Script:       "dGVzdA==",
+		},
 	}
 
-	if !reflect.DeepEqual(s, expected) {
-		t.Errorf("StartupScript.Create returned %+v, expected %+v", s, expected)
+	expectedMeta := &Meta{
+		Total: 1,
+		Links: &Links{},
+	}
+
+	if !reflect.DeepEqual(scripts, expectedScript) {
+		t.Errorf("StartupScript.List scripts returned %+v, expected %+v", scripts, expectedScript)
+	}
+
+	if !reflect.DeepEqual(meta, expectedMeta) {
+		t.Errorf("StartupScript.List meta returned %+v, expected %+v", meta, expectedMeta)
 	}
 }
 
-func TestStartupScriptServiceHandler_GET(t *testing.T) {
+func TestStartupScriptServiceHandler_Get(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/startup-scripts/14350", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"startup_script": {"id": "14350","date_created": "2020-06-08 17:58:10","date_modified": "2020-06-08 17:59:54","name": "govultr","type": "pxe","script": "dGVzdA=="}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/startup-scripts/14350", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"startup_script": {
+		"id": "14350",
+		"date_created": "2020-06-08 17:58:10",
+		"date_modified": "2020-06-08 17:59:54",
+		"name": "govultr",
+		"type": "pxe",
+		"script": "dGVzdA=="
+	}
+}`))
 
 	scripts, _, err := client.StartupScript.Get(ctx, "14350")
-
 	if err != nil {
 		t.Errorf("StartupScript.Get returned error: %v", err)
 	}
@@ -71,78 +97,71 @@ func TestStartupScriptServiceHandler_GET(t *testing.T) {
 	}
 }
 
-func TestStartupScriptServiceHandler_Update(t *testing.T) {
+func TestStartupScriptServiceHandler_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/startup-scripts/1234", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/startup-scripts", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"startup_script": {
+		"id": "14356",
+		"date_created": "2020-07-07 18:52:56",
+		"date_modified": "2020-07-07 18:59:54",
+		"name": "govultr",
+		"type": "boot",
+		"script": "dGVzdGFwaXVwZGF0ZQ=="
+	}
+}`))
 
-	script := &StartupScriptReq{
-		Name:   "foo",
+	req := &StartupScriptReq{
+		Name:   "govultr",
 		Type:   "boot",
-		Script: "dGVzdA==",
+		Script: "dGVzdGFwaXVwZGF0ZQ==",
 	}
 
-	err := client.StartupScript.Update(ctx, "1234", script)
-
+	script, _, err := client.StartupScript.Create(context.Background(), req)
 	if err != nil {
-		t.Errorf("StartupScript.Update returned error: %+v", err)
+		t.Errorf("StartupScript.Create returned %+v, expected %+v", err, nil)
 	}
-}
 
-func TestStartupScriptServiceHandler_Delete(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/startup-scripts/1234", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
-
-	err := client.StartupScript.Delete(ctx, "1234")
+	expected := &StartupScript{
+		ID:           "14356",
+		DateCreated:  "2020-07-07 18:52:56",
+		DateModified: "2020-07-07 18:59:54",
+		Name:         "govultr",
+		Type:         "boot",
+		Script:       "dGVzdGFwaXVwZGF0ZQ==",
+	}
 
-	if err != nil {
-		t.Errorf("StartupScript.Delete returned %+v, expected %+v", err, nil)
+	if !reflect.DeepEqual(script, expected) {
+		t.Errorf("StartupScript.Create returned %+v, expected %+v", script, expected)
 	}
 }
 
-func TestStartupScriptServiceHandler_List(t *testing.T) {
+func TestStartupScriptServiceHandler_Update(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/startup-scripts", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"startup_scripts": [{"id": "14350","date_created": "2020-06-08 17:58:10","date_modified": "2020-06-08 17:59:54","name": "govultr","type": "pxe","script": "dGVzdA=="}],"meta": {"total": 1,"links": {"next": "","prev": ""}}}`
-		fmt.Fprint(writer, response)
-	})
-
-	scripts, meta, _, err := client.StartupScript.List(ctx, nil)
+	mux.HandleFunc("/v2/startup-scripts/1234", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
-	if err != nil {
-		t.Errorf("StartupScript.List returned error: %v", err)
+	script := &StartupScriptReq{
+		Name:   "foo",
+		Type:   "boot",
+		Script: "dGVzdA==",
 	}
 
-	expectedScript := []StartupScript{
-		{
-			ID:           "14350",
-			DateCreated:  "2020-06-08 17:58:10",
-			DateModified: "2020-06-08 17:59:54",
-			Name:         "govultr",
-			Type:         "pxe",
-			Script:       "dGVzdA==",
-		},
+	if err := client.StartupScript.Update(ctx, "1234", script); err != nil {
+		t.Errorf("StartupScript.Update returned error: %+v", err)
 	}
+}
 
-	expectedMeta := &Meta{
-		Total: 1,
-		Links: &Links{},
-	}
+func TestStartupScriptServiceHandler_Delete(t *testing.T) {
+	setup()
+	defer teardown()
 
-	if !reflect.DeepEqual(scripts, expectedScript) {
-		t.Errorf("StartupScript.List scripts returned %+v, expected %+v", scripts, expectedScript)
-	}
+	mux.HandleFunc("/v2/startup-scripts/1234", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
-	if !reflect.DeepEqual(meta, expectedMeta) {
-		t.Errorf("StartupScript.List meta returned %+v, expected %+v", meta, expectedMeta)
+	if err := client.StartupScript.Delete(ctx, "1234"); err != nil {
+		t.Errorf("StartupScript.Delete returned %+v, expected %+v", err, nil)
 	}
 }
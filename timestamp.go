@@ -0,0 +1,66 @@
+package govultr
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timestampLayouts are the formats the API returns dates in
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05 -0700 MST",
+	time.DateOnly,
+}
+
+// Timestamp is a date returned by the API. It holds the string exactly as the
+// API sent it, so it marshals back unchanged, and Time parses it in any of
+// the formats the API uses: RFC 3339, "2006-01-02 15:04:05", a plain date or
+// seconds since the Unix epoch. Dates without a zone are in UTC.
+type Timestamp string
+
+// NewTimestamp returns the RFC 3339 Timestamp of t
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp(t.UTC().Format(time.RFC3339))
+}
+
+// Parse returns the time of the timestamp, or an error when it isn't in a
+// known format. An empty timestamp is the zero time.
+func (t Timestamp) Parse() (time.Time, error) {
+	if t == "" {
+		return time.Time{}, nil
+	}
+
+	s := string(t)
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			return parsed, nil
+		}
+	}
+
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unknown timestamp format %q", s)
+}
+
+// Time returns the time of the timestamp, the zero time when it is empty or
+// in an unknown format
+func (t Timestamp) Time() time.Time {
+	parsed, _ := t.Parse()
+	return parsed
+}
+
+// IsZero reports whether the timestamp is empty or isn't a known format
+func (t Timestamp) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// String returns the timestamp as the API sent it
+func (t Timestamp) String() string {
+	return string(t)
+}
@@ -0,0 +1,95 @@
+package govultr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_Parse(t *testing.T) {
+	expected := time.Date(2025, 8, 26, 14, 45, 41, 0, time.UTC)
+
+	tests := map[Timestamp]time.Time{
+		"2025-08-26T14:45:41+00:00":     expected,
+		"2025-08-26T16:45:41+02:00":     expected,
+		"2025-08-26T14:45:41Z":          expected,
+		"2025-08-26T14:45:41.000000Z":   expected,
+		"2025-08-26T14:45:41":           expected,
+		"2025-08-26 14:45:41":           expected,
+		"2025-08-26 14:45:41+00:00":     expected,
+		"2025-08-26 14:45:41 +0000 UTC": expected,
+		"1756219541":                    expected,
+		"2025-08-26":                    time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC),
+		"2025-08-26 14:45:41.5":         expected.Add(500 * time.Millisecond),
+		"":                              {},
+	}
+
+	for ts, want := range tests {
+		got, err := ts.Parse()
+		if err != nil {
+			t.Errorf("Timestamp(%q).Parse returned %+v", ts, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("Timestamp(%q).Parse returned %v, expected %v", ts, got, want)
+		}
+	}
+
+	if _, err := Timestamp("yesterday").Parse(); err == nil {
+		t.Error("Timestamp.Parse returned nil, expected an error for an unknown format")
+	}
+	if !Timestamp("yesterday").IsZero() || !Timestamp("").Time().IsZero() {
+		t.Error("Timestamp.Time returned a time, expected the zero time")
+	}
+}
+
+func TestTimestamp_JSON(t *testing.T) {
+	body := `{"id":"abc","date_created":"2013-12-19 14:45:41"}`
+
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal([]byte(body), snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2013, 12, 19, 14, 45, 41, 0, time.UTC)
+	if !snapshot.DateCreated.Time().Equal(expected) {
+		t.Errorf("DateCreated.Time returned %v, expected %v", snapshot.DateCreated.Time(), expected)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["date_created"] != "2013-12-19 14:45:41" {
+		t.Errorf("json.Marshal returned %s, expected the original date", data)
+	}
+}
+
+func TestNewTimestamp(t *testing.T) {
+	local := time.Date(2025, 8, 26, 16, 45, 41, 0, time.FixedZone("CEST", 2*60*60))
+
+	if ts := NewTimestamp(local); ts != "2025-08-26T14:45:41Z" {
+		t.Errorf("NewTimestamp returned %s, expected 2025-08-26T14:45:41Z", ts)
+	}
+}
+
+func TestTimestamp_Request(t *testing.T) {
+	expires := NewTimestamp(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC))
+
+	data, err := json.Marshal(&OrganizationRoleTrustUpdateReq{DateExpires: &expires})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"valid_until":"2025-09-01T00:00:00Z"}` {
+		t.Errorf("json.Marshal returned %s, expected valid_until in RFC 3339", data)
+	}
+
+	if data, _ := json.Marshal(&OrganizationRoleTrustUpdateReq{}); string(data) != `{}` {
+		t.Errorf("json.Marshal returned %s, expected valid_until to be omitted", data)
+	}
+}
@@ -1,71 +1,17 @@
 package govultr
 
 import (
-	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
 )
 
-func TestUserServiceHandler_Create(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/users", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"user": {"id": "564a1a88947b4","name": "Example User","email": "example@vultr.com","api_key": "aaavvvvvvbbbbbb","api_enabled": true,"acls": []}}`
-
-		fmt.Fprint(writer, response)
-	})
-	api := true
-	userReq := &UserReq{
-		Email:      "example@vultr.com",
-		Name:       "Example User",
-		APIEnabled: &api,
-		Password:   "password",
-	}
-
-	user, _, err := client.User.Create(ctx, userReq)
-
-	if err != nil {
-		t.Errorf("User.Create returned %+v, expected %+v", err, nil)
-	}
-
-	expected := &User{
-		ID:         "564a1a88947b4",
-		Name:       "Example User",
-		Email:      "example@vultr.com",
-		APIEnabled: BoolToBoolPtr(true),
-		APIKey:     "aaavvvvvvbbbbbb",
-		ACL:        []string{},
-	}
-
-	if !reflect.DeepEqual(user, expected) {
-		t.Errorf("User.Create returned %+v, expected %+v", user, expected)
-	}
-}
-
-func TestUserServiceHandler_Delete(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/users/123abc", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
-
-	err := client.User.Delete(ctx, "123abc")
-
-	if err != nil {
-		t.Errorf("User.Delete returned %+v, expected %+v", err, nil)
-	}
-}
-
 func TestUserServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/users", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-	{
+	mux.HandleFunc("/v2/users", testJSONResponseHandlerFunc(http.StatusOK, `
+{
     "users": [
         {
             "id": "f255efc9700d9",
@@ -82,16 +28,13 @@ func TestUserServiceHandler_List(t *testing.T) {
             "prev": ""
         }
     }
-}
-		`
-		fmt.Fprint(writer, response)
-	})
+}`))
 
 	options := &ListOptions{
 		PerPage: 1,
 	}
-	users, meta, _, err := client.User.List(ctx, options)
 
+	users, meta, _, err := client.User.List(ctx, options)
 	if err != nil {
 		t.Errorf("User.List returned error: %v", err)
 	}
@@ -123,52 +66,107 @@ func TestUserServiceHandler_List(t *testing.T) {
 	}
 }
 
-func TestUserServiceHandler_Update(t *testing.T) {
+func TestUserServiceHandler_Get(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/users/abc123", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc("/v2/users/abc123", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"user": {
+		"id": "f255efc9c69ac",
+		"name": "Unit Test",
+		"email": "test@vultr.com",
+		"api_enabled": true,
+		"acls": []
+	}
+}`))
 
-	api := true
-	user := &UserReq{
-		Name:       "Example User",
-		Password:   "w1a4dcnst0n!",
-		Email:      "example@vultr.com",
-		APIEnabled: &api,
-		ACL:        []string{"support"},
+	user, _, err := client.User.Get(ctx, "abc123")
+	if err != nil {
+		t.Errorf("User.Get returned error: %v", err)
 	}
 
-	err := client.User.Update(ctx, "abc123", user)
+	expected := &User{
+		ID:         "f255efc9c69ac",
+		Name:       "Unit Test",
+		Email:      "test@vultr.com",
+		APIEnabled: BoolToBoolPtr(true),
+		ACL:        []string{},
+	}
 
-	if err != nil {
-		t.Errorf("User.Update returned error: %+v", err)
+	if !reflect.DeepEqual(user, expected) {
+		t.Errorf("User.List users returned %+v, expected %+v", user, expected)
 	}
 }
 
-func TestUserServiceHandler_Get(t *testing.T) {
+func TestUserServiceHandler_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/users/abc123", func(writer http.ResponseWriter, request *http.Request) {
-		response := `{"user": {"id": "f255efc9c69ac","name": "Unit Test","email": "test@vultr.com","api_enabled": true,"acls": []}}`
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc("/v2/users", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"user": {
+		"id": "564a1a88947b4",
+		"name": "Example User",
+		"email": "example@vultr.com",
+		"api_key": "aaavvvvvvbbbbbb",
+		"api_enabled": true,
+		"acls": []
+	}
+}`))
 
-	user, _, err := client.User.Get(ctx, "abc123")
+	userReq := &UserReq{
+		Email:      "example@vultr.com",
+		Name:       "Example User",
+		APIEnabled: BoolToBoolPtr(true),
+		Password:   "password",
+	}
+
+	user, _, err := client.User.Create(ctx, userReq)
 	if err != nil {
-		t.Errorf("User.Get returned error: %v", err)
+		t.Errorf("User.Create returned %+v, expected %+v", err, nil)
 	}
+
 	expected := &User{
-		ID:         "f255efc9c69ac",
-		Name:       "Unit Test",
-		Email:      "test@vultr.com",
+		ID:         "564a1a88947b4",
+		Name:       "Example User",
+		Email:      "example@vultr.com",
 		APIEnabled: BoolToBoolPtr(true),
+		APIKey:     "aaavvvvvvbbbbbb",
 		ACL:        []string{},
 	}
 
 	if !reflect.DeepEqual(user, expected) {
-		t.Errorf("User.List users returned %+v, expected %+v", user, expected)
+		t.Errorf("User.Create returned %+v, expected %+v", user, expected)
+	}
+}
+
+func TestUserServiceHandler_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/123abc", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
+
+	if err := client.User.Delete(ctx, "123abc"); err != nil {
+		t.Errorf("User.Delete returned %+v, expected %+v", err, nil)
+	}
+}
+
+func TestUserServiceHandler_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/abc123", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
+
+	user := &UserReq{
+		Name:       "Example User",
+		Password:   "w1a4dcnst0n!",
+		Email:      "example@vultr.com",
+		APIEnabled: BoolToBoolPtr(true),
+		ACL:        []string{"support"},
+	}
+
+	if err := client.User.Update(ctx, "abc123", user); err != nil {
+		t.Errorf("User.Update returned error: %+v", err)
 	}
 }
@@ -0,0 +1,262 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Limits of the fields checked by validation
+const (
+	maxPort           = 65535
+	maxPriority       = 65535
+	maxHour           = 23
+	maxDayOfWeek      = 7
+	maxDayOfMonth     = 28
+	ipv4Bits          = 32
+	ipv6Bits          = 128
+	srvRecordFields   = 3
+	caaRecordFields   = 3
+	sshfpRecordFields = 3
+)
+
+// Validator is implemented by request structs that can be checked before
+// they are sent. NewRequest validates such bodies unless validation is turned
+// off, see SetValidation.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is returned when a request fails client side validation.
+// Nothing is sent to the API.
+type ValidationError struct {
+	// Type of the request, e.g. "InstanceCreateReq"
+	Request string
+
+	// JSON names of the fields at fault
+	Field string
+
+	Message string
+}
+
+// Error returns the request, fields and problem
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s %s", e.Request, e.Field, e.Message)
+}
+
+// SetValidation turns client side validation of request bodies on or off.
+// It is on by default. Turning it off sends requests as they are and leaves
+// any checks to the API.
+func (c *Client) SetValidation(enabled bool) {
+	c.skipValidation = !enabled
+}
+
+// validate runs the body's Validate method when it has one
+func (c *Client) validate(body interface{}) error {
+	v, ok := body.(Validator)
+	if !ok || c.skipValidation {
+		return nil
+	}
+
+	if rv := reflect.ValueOf(body); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+
+	return v.Validate()
+}
+
+// validation collects the problems of a request
+type validation struct {
+	request string
+	errs    []error
+}
+
+// check records a problem with field unless ok
+func (v *validation) check(ok bool, field, format string, args ...interface{}) {
+	if !ok {
+		v.errs = append(v.errs, &ValidationError{Request: v.request, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+// err returns the problems joined, nil when there are none
+func (v *validation) err() error {
+	return errors.Join(v.errs...)
+}
+
+// Validate checks that exactly one source of the operating system is set
+func (i *InstanceCreateReq) Validate() error {
+	v := &validation{request: "InstanceCreateReq"}
+
+	sources := 0
+	for _, set := range []bool{i.OsID != 0, i.ISOID != "", i.AppID != 0, i.ImageID != "", i.SnapshotID != ""} {
+		if set {
+			sources++
+		}
+	}
+	v.check(sources == 1, "os_id, iso_id, app_id, image_id, snapshot_id", "must have exactly one set, got %d", sources)
+
+	return v.err()
+}
+
+// Validate checks the IP type, protocol, subnet and port of the rule. The
+// subnet isn't checked when the rule has a source, which replaces it.
+func (f *FirewallRuleReq) Validate() error {
+	v := &validation{request: "FirewallRuleReq"}
+
+	v.check(f.IPType.IsValid(), "ip_type", "%q is not v4 or v6", f.IPType)
+	v.check(f.Protocol.IsValid(), "protocol", "%q is unknown", f.Protocol)
+
+	if f.Source == "" && f.IPType.IsValid() {
+		bits := ipv4Bits
+		if f.IPType == IPTypeV6 {
+			bits = ipv6Bits
+		}
+
+		addr, err := netip.ParseAddr(f.Subnet)
+		v.check(err == nil && addr.Is4() == (f.IPType == IPTypeV4), "subnet", "%q is not an IP%s address", f.Subnet, f.IPType)
+		v.check(f.SubnetSize >= 0 && f.SubnetSize <= bits, "subnet_size", "%d is not between 0 and %d", f.SubnetSize, bits)
+	}
+
+	if f.Port != "" {
+		protocol := Protocol(strings.ToLower(string(f.Protocol)))
+		v.check(protocol == ProtocolTCP || protocol == ProtocolUDP, "port", "is only allowed for tcp and udp")
+		v.check(validPortRange(f.Port), "port", "%q is not a port or a range such as 8000:9000", f.Port)
+	}
+
+	return v.err()
+}
+
+// validPortRange reports whether s is a port or a range of ports "from:to"
+func validPortRange(s string) bool {
+	from, to, isRange := strings.Cut(s, ":")
+	if !isRange {
+		to = from
+	}
+
+	first, err := strconv.Atoi(from)
+	if err != nil {
+		return false
+	}
+	last, err := strconv.Atoi(to)
+	if err != nil {
+		return false
+	}
+
+	return first >= 1 && first <= last && last <= maxPort
+}
+
+// Validate checks that the day of the week is only set, and set, for weekly
+// backups and the day of the month for monthly ones
+func (b *BackupScheduleReq) Validate() error {
+	v := &validation{request: "BackupScheduleReq"}
+
+	v.check(b.Type.IsValid(), "type", "%q is unknown", b.Type)
+
+	if b.Hour != nil {
+		v.check(*b.Hour >= 0 && *b.Hour <= maxHour, "hour", "%d is not between 0 and %d", *b.Hour, maxHour)
+	}
+
+	if b.Type == BackupScheduleWeekly {
+		v.check(b.Dow != nil && *b.Dow >= 1 && *b.Dow <= maxDayOfWeek, "dow", "must be between 1 and %d for weekly backups", maxDayOfWeek)
+	} else {
+		v.check(b.Dow == nil, "dow", "is only allowed for weekly backups")
+	}
+
+	if b.Type == BackupScheduleMonthly {
+		v.check(b.Dom >= 1 && b.Dom <= maxDayOfMonth, "dom", "must be between 1 and %d for monthly backups", maxDayOfMonth)
+	} else {
+		v.check(b.Dom == 0, "dom", "is only allowed for monthly backups")
+	}
+
+	return v.err()
+}
+
+// Validate checks the data of the record against its type and that MX and
+// SRV records have a priority
+func (d *DomainRecordCreateReq) Validate() error {
+	v := &validation{request: "DomainRecordCreateReq"}
+
+	v.check(d.Type.IsValid(), "type", "%q is unknown", d.Type)
+	v.check(d.Data != "", "data", "is required")
+	v.check(d.TTL >= 0, "ttl", "%d is negative", d.TTL)
+
+	switch d.Type {
+	case RecordTypeMX, RecordTypeSRV:
+		v.check(d.Priority != nil, "priority", "is required for %s records", d.Type)
+	}
+	if d.Priority != nil {
+		v.check(*d.Priority >= 0 && *d.Priority <= maxPriority, "priority", "%d is not between 0 and %d", *d.Priority, maxPriority)
+	}
+
+	if d.Data == "" {
+		return v.err()
+	}
+
+	switch d.Type { //nolint:exhaustive
+	case RecordTypeA:
+		addr, err := netip.ParseAddr(d.Data)
+		v.check(err == nil && addr.Is4(), "data", "%q is not an IPv4 address", d.Data)
+	case RecordTypeAAAA:
+		addr, err := netip.ParseAddr(d.Data)
+		v.check(err == nil && addr.Is6() && !addr.Is4In6(), "data", "%q is not an IPv6 address", d.Data)
+	case RecordTypeSRV:
+		v.check(len(strings.Fields(d.Data)) == srvRecordFields, "data", "%q is not \"weight port target\"", d.Data)
+	case RecordTypeCAA:
+		v.check(len(strings.Fields(d.Data)) >= caaRecordFields, "data", "%q is not \"flags tag value\"", d.Data)
+	case RecordTypeSSHFP:
+		v.check(len(strings.Fields(d.Data)) == sshfpRecordFields, "data", "%q is not \"algorithm type fingerprint\"", d.Data)
+	}
+
+	return v.err()
+}
+
+// Validate checks the region and that the subnet and mask form a valid IPv4
+// network. Both may be left out to have the API pick the subnet.
+func (n *VPCReq) Validate() error {
+	v := &validation{request: "VPCReq"}
+
+	v.check(n.Region != "", "region", "is required")
+
+	if n.V4Subnet == "" && n.V4SubnetMask == 0 {
+		return v.err()
+	}
+
+	prefix, err := netip.ParsePrefix(fmt.Sprintf("%s/%d", n.V4Subnet, n.V4SubnetMask))
+	valid := err == nil && prefix.Addr().Is4()
+	v.check(valid, "v4_subnet, v4_subnet_mask", "%s/%d is not an IPv4 network", n.V4Subnet, n.V4SubnetMask)
+	if valid {
+		v.check(prefix.Masked() == prefix, "v4_subnet", "%s has host bits set, the network is %s", n.V4Subnet, prefix.Masked())
+	}
+
+	return v.err()
+}
+
+// Validate checks that the node quantity is between the minimum and maximum
+// when the auto scaler is enabled
+func (n *NodePoolReq) Validate() error {
+	v := &validation{request: "NodePoolReq"}
+
+	v.check(n.NodeQuantity >= 1, "node_quantity", "%d is less than 1", n.NodeQuantity)
+
+	if n.AutoScaler != nil && *n.AutoScaler {
+		v.check(n.MinNodes <= n.NodeQuantity && n.NodeQuantity <= n.MaxNodes, "min_nodes, node_quantity, max_nodes",
+			"%d <= %d <= %d does not hold with the auto scaler enabled", n.MinNodes, n.NodeQuantity, n.MaxNodes)
+	}
+
+	return v.err()
+}
+
+// Validate checks the node pools of the cluster
+func (c *ClusterReq) Validate() error {
+	var errs []error
+	for i := range c.NodePools {
+		if err := c.NodePools[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("node_pools[%d]: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
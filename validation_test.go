@@ -0,0 +1,143 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   Validator
+		field string
+	}{
+		{"instance os", &InstanceCreateReq{Region: "ewr", OsID: 2284}, ""},
+		{"instance snapshot", &InstanceCreateReq{SnapshotID: "abc"}, ""},
+		{"instance without os", &InstanceCreateReq{Region: "ewr"}, "os_id"},
+		{"instance with two sources", &InstanceCreateReq{OsID: 2284, ISOID: "abc"}, "os_id"},
+		{"firewall rule", &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolTCP, Subnet: "192.0.2.0", SubnetSize: 24, Port: "22"}, ""},
+		{"firewall rule v6", &FirewallRuleReq{IPType: IPTypeV6, Protocol: ProtocolUDP, Subnet: "2001:db8::", SubnetSize: 64, Port: "8000:9000"}, ""},
+		{"firewall rule source", &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolTCP, Source: "cloudflare", Port: "443"}, ""},
+		{"firewall rule ip type", &FirewallRuleReq{IPType: "ipv4", Protocol: ProtocolTCP, Subnet: "192.0.2.0"}, "ip_type"},
+		{"firewall rule subnet family", &FirewallRuleReq{IPType: IPTypeV6, Protocol: ProtocolTCP, Subnet: "192.0.2.0"}, "subnet"},
+		{"firewall rule subnet size", &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolTCP, Subnet: "192.0.2.0", SubnetSize: 33}, "subnet_size"},
+		{"firewall rule port range", &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolTCP, Subnet: "0.0.0.0", Port: "9000:8000"}, "port"},
+		{"firewall rule port too big", &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolTCP, Subnet: "0.0.0.0", Port: "70000"}, "port"},
+		{"firewall rule uppercase protocol", &FirewallRuleReq{IPType: IPTypeV4, Protocol: "TCP", Subnet: "192.0.2.0", Port: "22"}, ""},
+		{"firewall rule uppercase icmp port", &FirewallRuleReq{IPType: IPTypeV4, Protocol: "ICMP", Subnet: "0.0.0.0", Port: "22"}, "port"},
+		{"firewall rule icmp port", &FirewallRuleReq{IPType: IPTypeV4, Protocol: ProtocolICMP, Subnet: "0.0.0.0", Port: "22"}, "port"},
+		{"daily backups", &BackupScheduleReq{Type: BackupScheduleDaily, Hour: IntToIntPtr(3)}, ""},
+		{"weekly backups", &BackupScheduleReq{Type: BackupScheduleWeekly, Dow: IntToIntPtr(7)}, ""},
+		{"monthly backups", &BackupScheduleReq{Type: BackupScheduleMonthly, Dom: 28}, ""},
+		{"weekly backups without dow", &BackupScheduleReq{Type: BackupScheduleWeekly}, "dow"},
+		{"daily backups with dow", &BackupScheduleReq{Type: BackupScheduleDaily, Dow: IntToIntPtr(1)}, "dow"},
+		{"monthly backups on the 31st", &BackupScheduleReq{Type: BackupScheduleMonthly, Dom: 31}, "dom"},
+		{"backups at 24", &BackupScheduleReq{Type: BackupScheduleDaily, Hour: IntToIntPtr(24)}, "hour"},
+		{"A record", &DomainRecordCreateReq{Type: RecordTypeA, Name: "www", Data: "192.0.2.1"}, ""},
+		{"MX record", &DomainRecordCreateReq{Type: RecordTypeMX, Data: "mail.example.com", Priority: IntToIntPtr(10)}, ""},
+		{"SRV record", &DomainRecordCreateReq{Type: RecordTypeSRV, Name: "_sip._tcp", Data: "5 5060 sip.example.com", Priority: IntToIntPtr(0)}, ""},
+		{"CAA record", &DomainRecordCreateReq{Type: RecordTypeCAA, Data: `0 issue "letsencrypt.org"`}, ""},
+		{"A record with IPv6", &DomainRecordCreateReq{Type: RecordTypeA, Data: "2001:db8::1"}, "data"},
+		{"AAAA record with IPv4", &DomainRecordCreateReq{Type: RecordTypeAAAA, Data: "192.0.2.1"}, "data"},
+		{"MX record without priority", &DomainRecordCreateReq{Type: RecordTypeMX, Data: "mail.example.com"}, "priority"},
+		{"SRV record data", &DomainRecordCreateReq{Type: RecordTypeSRV, Data: "sip.example.com", Priority: IntToIntPtr(0)}, "data"},
+		{"unknown record type", &DomainRecordCreateReq{Type: "a", Data: "192.0.2.1"}, "type"},
+		{"empty record", &DomainRecordCreateReq{Type: RecordTypeTXT}, "data"},
+		{"vpc", &VPCReq{Region: "ewr", V4Subnet: "10.99.0.0", V4SubnetMask: 24}, ""},
+		{"vpc without subnet", &VPCReq{Region: "ewr"}, ""},
+		{"vpc without region", &VPCReq{V4Subnet: "10.99.0.0", V4SubnetMask: 24}, "region"},
+		{"vpc host bits", &VPCReq{Region: "ewr", V4Subnet: "10.99.0.1", V4SubnetMask: 24}, "v4_subnet"},
+		{"vpc mask", &VPCReq{Region: "ewr", V4Subnet: "10.99.0.0", V4SubnetMask: 33}, "v4_subnet"},
+		{"vpc IPv6", &VPCReq{Region: "ewr", V4Subnet: "2001:db8::", V4SubnetMask: 64}, "v4_subnet"},
+		{"node pool", &NodePoolReq{NodeQuantity: 1}, ""},
+		{"auto scaled node pool", &NodePoolReq{NodeQuantity: 2, MinNodes: 1, MaxNodes: 3, AutoScaler: BoolToBoolPtr(true)}, ""},
+		{"auto scaled node pool above max", &NodePoolReq{NodeQuantity: 4, MinNodes: 1, MaxNodes: 3, AutoScaler: BoolToBoolPtr(true)}, "min_nodes"},
+		{"node pool above max without auto scaler", &NodePoolReq{NodeQuantity: 4, MinNodes: 1, MaxNodes: 3}, ""},
+		{"cluster", &ClusterReq{NodePools: []NodePoolReq{{NodeQuantity: 1}, {NodeQuantity: 0}}}, "node_quantity"},
+	}
+
+	for _, tt := range tests {
+		err := tt.req.Validate()
+		if tt.field == "" {
+			if err != nil {
+				t.Errorf("%s: Validate returned %+v, expected nil", tt.name, err)
+			}
+			continue
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || !strings.HasPrefix(validationErr.Field, tt.field) {
+			t.Errorf("%s: Validate returned %+v, expected an error for %s", tt.name, err, tt.field)
+		}
+	}
+}
+
+func TestValidate_AllProblems(t *testing.T) {
+	err := (&FirewallRuleReq{IPType: "v5", Protocol: "tpc", Port: "x"}).Validate()
+
+	expected := `invalid FirewallRuleReq: ip_type "v5" is not v4 or v6
+invalid FirewallRuleReq: protocol "tpc" is unknown
+invalid FirewallRuleReq: port is only allowed for tcp and udp
+invalid FirewallRuleReq: port "x" is not a port or a range such as 8000:9000`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Validate returned %v, expected %s", err, expected)
+	}
+}
+
+func TestClient_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/v2/domains/vultr.com/records", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"record":{"id":"dev-preview-abc123","type":"MX"}}`)
+	})
+
+	invalid := &DomainRecordCreateReq{Type: RecordTypeMX, Name: "", Data: "mail.vultr.com"}
+
+	_, _, err := client.DomainRecord.Create(ctx, "vultr.com", invalid)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Request != "DomainRecordCreateReq" {
+		t.Errorf("DomainRecord.Create returned %+v, expected a validation error", err)
+	}
+	if requests != 0 {
+		t.Errorf("DomainRecord.Create made %d requests, expected none", requests)
+	}
+
+	client.SetValidation(false)
+	if _, _, err := client.DomainRecord.Create(ctx, "vultr.com", invalid); err != nil {
+		t.Errorf("DomainRecord.Create returned %+v with validation off", err)
+	}
+	if requests != 1 {
+		t.Errorf("DomainRecord.Create made %d requests, expected 1 with validation off", requests)
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	c, err := NewClientWithOptions(WithValidation(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.NewRequest(ctx, http.MethodPost, "/v2/instances", &InstanceCreateReq{}); err != nil {
+		t.Errorf("NewRequest returned %+v, expected validation to be off", err)
+	}
+
+	c, err = NewClientWithOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.NewRequest(ctx, http.MethodPost, "/v2/instances", &InstanceCreateReq{}); err == nil {
+		t.Error("NewRequest returned nil, expected a validation error")
+	}
+
+	var nilReq *InstanceCreateReq
+	if _, err := c.NewRequest(ctx, http.MethodPost, "/v2/instances", nilReq); err != nil {
+		t.Errorf("NewRequest returned %+v for a nil request, expected nil", err)
+	}
+}
@@ -36,7 +36,7 @@ type VirtualFileSystemStorageServiceHandler struct {
 type VirtualFileSystemStorage struct {
 	ID          string                          `json:"id"`
 	Region      string                          `json:"region"`
-	DateCreated string                          `json:"date_created"`
+	DateCreated Timestamp                       `json:"date_created"`
 	Status      string                          `json:"status"`
 	Label       string                          `json:"label"`
 	Tags        []string                        `json:"tags"`
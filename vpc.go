@@ -43,12 +43,12 @@ type VPCServiceHandler struct {
 
 // VPC represents a Vultr VPC
 type VPC struct {
-	ID           string `json:"id"`
-	Region       string `json:"region"`
-	Description  string `json:"description"`
-	V4Subnet     string `json:"v4_subnet"`
-	V4SubnetMask int    `json:"v4_subnet_mask"`
-	DateCreated  string `json:"date_created"`
+	ID           string    `json:"id"`
+	Region       string    `json:"region"`
+	Description  string    `json:"description"`
+	V4Subnet     string    `json:"v4_subnet"`
+	V4SubnetMask int       `json:"v4_subnet_mask"`
+	DateCreated  Timestamp `json:"date_created"`
 }
 
 // VPCReq represents parameters to create or update a VPC resource
@@ -81,7 +81,7 @@ type VPCAttachment struct {
 	ID                 string                          `json:"id"`
 	Type               string                          `json:"type"`
 	MACAddress         string                          `json:"mac_address"`
-	DateAdded          string                          `json:"date_added"`
+	DateAdded          Timestamp                       `json:"date_added"`
 	IP                 VPCAttachmentIP                 `json:"ip"`
 	LinkedSubscription VPCAttachmentLinkedSubscription `json:"linked_subscription"`
 }
@@ -95,7 +95,7 @@ type vpcAttachmentsBase struct {
 type NATGateway struct {
 	ID          string            `json:"id"`
 	VPCID       string            `json:"vpc_id"`
-	DateCreated string            `json:"date_created"`
+	DateCreated Timestamp         `json:"date_created"`
 	Status      string            `json:"status"`
 	Label       string            `json:"label"`
 	Tag         string            `json:"tag"`
@@ -162,16 +162,16 @@ type natGatewayFirewallRuleBase struct {
 
 // NATGatewayPortForwardingRule represents a port forwarding rule for a Vultr NAT Gateway
 type NATGatewayPortForwardingRule struct {
-	ID           string `json:"id"`
-	Name         string `json:"name"`
-	Protocol     string `json:"protocol"`
-	ExternalPort int    `json:"external_port"`
-	InternalIP   string `json:"internal_ip"`
-	InternalPort int    `json:"internal_port"`
-	Enabled      *bool  `json:"enabled"`
-	Description  string `json:"description"`
-	DateCreated  string `json:"created_at"`
-	DateUpdated  string `json:"updated_at"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Protocol     string    `json:"protocol"`
+	ExternalPort int       `json:"external_port"`
+	InternalIP   string    `json:"internal_ip"`
+	InternalPort int       `json:"internal_port"`
+	Enabled      *bool     `json:"enabled"`
+	Description  string    `json:"description"`
+	DateCreated  Timestamp `json:"created_at"`
+	DateUpdated  Timestamp `json:"updated_at"`
 }
 
 // NATGatewayPortForwardingRuleReq represents parameters to create or update a NAT Gateway port forwarding rule resource
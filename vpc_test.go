@@ -7,26 +7,93 @@ import (
 	"testing"
 )
 
-func TestVPCServiceHandler_Create(t *testing.T) {
+func TestVPCServiceHandler_List(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
+	mux.HandleFunc("/v2/vpcs", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"vpcs": [{
+		"id": "net539626f0798d7",
+		"date_created": "2017-08-25 12:23:45",
+		"region": "ewr",
+		"description": "test1",
+		"v4_subnet": "10.99.0.0",
+		"v4_subnet_mask": 24
+	}]
+}`))
+
+	vpcs, _, _, err := client.VPC.List(ctx, nil)
+	if err != nil {
+		t.Errorf("VPC.List returned error: %v", err)
+	}
+
+	expected := []VPC{
 		{
-			"vpc": {
-				"id": "net539626f0798d7",
-				"date_created": "2017-08-25 12:23:45",
-				"region": "ewr",
-				"description": "test1",
-				"v4_subnet": "10.99.0.0",
-				"v4_subnet_mask": 24
-			}
-		}
-		`
+			ID:           "net539626f0798d7",
+			Region:       "ewr",
+			Description:  "test1",
+			V4Subnet:     "10.99.0.0",
+			V4SubnetMask: 24,
+			DateCreated:  "2017-08-25 12:23:45",
+		},
+	}
 
-		fmt.Fprint(writer, response)
-	})
+	if !reflect.DeepEqual(vpcs, expected) {
+		t.Errorf("VPC.List returned %+v, expected %+v", vpcs, expected)
+	}
+}
+
+func TestVPCServiceHandler_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/vpcs/net539626f0798d7", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"vpc": {
+		"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+		"date_created": "2020-10-10T01:56:20+00:00",
+		"region": "ewr",
+		"description": "sample desc",
+		"v4_subnet": "10.99.0.0",
+		"v4_subnet_mask": 24
+	}
+}`))
+
+	vpc, _, err := client.VPC.Get(ctx, "net539626f0798d7")
+	if err != nil {
+		t.Errorf("VPC.Get returned %+v, expected %+v", err, nil)
+	}
+
+	expected := &VPC{
+		ID:           "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+		Region:       "ewr",
+		Description:  "sample desc",
+		V4Subnet:     "10.99.0.0",
+		V4SubnetMask: 24,
+		DateCreated:  "2020-10-10T01:56:20+00:00",
+	}
+
+	if !reflect.DeepEqual(vpc, expected) {
+		t.Errorf("VPC.Get returned %+v, expected %+v", vpc, expected)
+	}
+}
+
+func TestVPCServiceHandler_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/vpcs", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"vpc": {
+		"id": "net539626f0798d7",
+		"date_created": "2017-08-25 12:23:45",
+		"region": "ewr",
+		"description": "test1",
+		"v4_subnet": "10.99.0.0",
+		"v4_subnet_mask": 24
+	}
+}`))
 
 	options := &VPCReq{
 		Region:      "ewr",
@@ -34,7 +101,6 @@ func TestVPCServiceHandler_Create(t *testing.T) {
 	}
 
 	net, _, err := client.VPC.Create(ctx, options)
-
 	if err != nil {
 		t.Errorf("VPC.Create returned %+v, expected %+v", err, nil)
 	}
@@ -53,7 +119,7 @@ func TestVPCServiceHandler_Create(t *testing.T) {
 	}
 }
 
-func TestVPCServiceHandler_Delete(t *testing.T) {
+func TestVPCServiceHandler_Update(t *testing.T) {
 	setup()
 	defer teardown()
 
@@ -61,52 +127,21 @@ func TestVPCServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.VPC.Delete(ctx, "net539626f0798d7")
+	err := client.VPC.Update(ctx, "net539626f0798d7", "update")
 
 	if err != nil {
-		t.Errorf("VPC.Delete returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.Update returned %+v, expected %+v", err, nil)
 	}
 }
 
-func TestVPCServiceHandler_List(t *testing.T) {
+func TestVPCServiceHandler_Delete(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"vpcs": [{
-				"id": "net539626f0798d7",
-				"date_created": "2017-08-25 12:23:45",
-				"region": "ewr",
-				"description": "test1",
-				"v4_subnet": "10.99.0.0",
-				"v4_subnet_mask": 24
-			}]
-		}
-		`
-		fmt.Fprint(writer, response)
-	})
-
-	vpcs, _, _, err := client.VPC.List(ctx, nil)
-
-	if err != nil {
-		t.Errorf("VPC.List returned error: %v", err)
-	}
-
-	expected := []VPC{
-		{
-			ID:           "net539626f0798d7",
-			Region:       "ewr",
-			Description:  "test1",
-			V4Subnet:     "10.99.0.0",
-			V4SubnetMask: 24,
-			DateCreated:  "2017-08-25 12:23:45",
-		},
-	}
+	mux.HandleFunc("/v2/vpcs/net539626f0798d7", testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
-	if !reflect.DeepEqual(vpcs, expected) {
-		t.Errorf("VPC.List returned %+v, expected %+v", vpcs, expected)
+	if err := client.VPC.Delete(ctx, "net539626f0798d7"); err != nil {
+		t.Errorf("VPC.Delete returned %+v, expected %+v", err, nil)
 	}
 }
 
@@ -114,38 +149,33 @@ func TestVPCServiceHandler_ListAttachments(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/attachments", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
+	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/attachments", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "",
+			"prev": ""
+		}
+	},
+	"attachments": [
 		{
-			"meta": {
-				"total": 1,
-				"links": {
-					"next": "",
-					"prev": ""
-				}
+			"id": "f45f4b9b-24c3-4a49-b288-e2a15f2abcd",
+			"type": "vps",
+			"mac_address": "52:e2:4b:90:0e:ab",
+			"date_added": "2026-05-14T11:37:04-04:00",
+			"ip": {
+				"v4": "10.99.0.1"
 			},
-			"attachments": [
-				{
-					"id": "f45f4b9b-24c3-4a49-b288-e2a15f2abcd",
-					"type": "vps",
-					"mac_address": "52:e2:4b:90:0e:ab",
-					"date_added": "2026-05-14T11:37:04-04:00",
-					"ip": {
-						"v4": "10.99.0.1"
-					},
-					"linked_subscription": {
-						"type": "load_balancer",
-						"id": "b17f6195-f2d4-47c6-811c-75a6f2abcd"
-					}
-				}
-			]
+			"linked_subscription": {
+				"type": "load_balancer",
+				"id": "b17f6195-f2d4-47c6-811c-75a6f2abcd"
+			}
 		}
-		`
-		fmt.Fprint(writer, response)
-	})
+	]
+}`))
 
 	attachments, _, _, err := client.VPC.ListAttachments(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", nil)
-
 	if err != nil {
 		t.Errorf("VPC.ListAttachments returned error: %v", err)
 	}
@@ -166,93 +196,45 @@ func TestVPCServiceHandler_ListAttachments(t *testing.T) {
 	}
 }
 
-func TestVPCServiceHandler_Update(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/vpcs/net539626f0798d7", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
-
-	err := client.VPC.Update(ctx, "net539626f0798d7", "update")
-
-	if err != nil {
-		t.Errorf("VPC.Update returned %+v, expected %+v", err, nil)
-	}
-}
-
-func TestVPCServiceHandler_Get(t *testing.T) {
-	setup()
-	defer teardown()
-
-	mux.HandleFunc("/v2/vpcs/net539626f0798d7", func(writer http.ResponseWriter, request *http.Request) {
-		req := `{"vpc": {"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60","date_created": "2020-10-10T01:56:20+00:00","region": "ewr","description": "sample desc","v4_subnet": "10.99.0.0","v4_subnet_mask": 24}}`
-		fmt.Fprint(writer, req)
-	})
-
-	vpc, _, err := client.VPC.Get(ctx, "net539626f0798d7")
-	if err != nil {
-		t.Errorf("VPC.Get returned %+v, expected %+v", err, nil)
-	}
-
-	expected := &VPC{
-		ID:           "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
-		Region:       "ewr",
-		Description:  "sample desc",
-		V4Subnet:     "10.99.0.0",
-		V4SubnetMask: 24,
-		DateCreated:  "2020-10-10T01:56:20+00:00",
-	}
-
-	if !reflect.DeepEqual(vpc, expected) {
-		t.Errorf("VPC.Get returned %+v, expected %+v", vpc, expected)
-	}
-}
-
 func TestVPCServiceHandler_NATGatewayList(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
+	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway", testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "",
+			"prev": ""
+		}
+	},
+	"nat_gateways": [
 		{
-			"meta": {
-				"total": 1,
-				"links": {
-					"next": "",
-					"prev": ""
-				}
-			},
-			"nat_gateways": [
-				{
-					"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
-					"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
-					"date_created": "2025-09-29 10:38:57",
-					"status": "active",
-					"label": "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
-					"tag": "test tag",
-					"public_ips": [
-						"149.1.2.3"
-					],
-					"public_ips_v6": [
-						"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
-					],
-					"private_ips": [
-						"10.1.128.1"
-					],
-					"billing": {
-						"charges": 20.16,
-						"monthly": 20.16
-					}
-				}
-			]
+			"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
+			"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
+			"date_created": "2025-09-29 10:38:57",
+			"status": "active",
+			"label": "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
+			"tag": "test tag",
+			"public_ips": [
+				"149.1.2.3"
+			],
+			"public_ips_v6": [
+				"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
+			],
+			"private_ips": [
+				"10.1.128.1"
+			],
+			"billing": {
+				"charges": 20.16,
+				"monthly": 20.16
+			}
 		}
-		`
-		fmt.Fprint(writer, response)
-	})
+	]
+}`))
 
 	natGateways, _, _, err := client.VPC.ListNATGateways(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", nil)
-
 	if err != nil {
 		t.Errorf("VPC.List returned error: %v", err)
 	}
@@ -294,49 +276,40 @@ func TestVPCServiceHandler_NATGatewayList(t *testing.T) {
 	}
 }
 
-func TestVPCServiceHandler_CreateNATGateway(t *testing.T) {
+func TestVPCServiceHandler_GetNATGateway(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"nat_gateway": {
-				"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
-				"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
-				"date_created": "2025-09-29 10:38:57",
-				"status": "active",
-				"label": "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
-				"tag": "test tag",
-				"public_ips": [
-					"149.1.2.3"
-				],
-				"public_ips_v6": [
-					"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
-				],
-				"private_ips": [
-					"10.1.128.1"
-				],
-				"billing": {
-					"charges": 20.16,
-					"monthly": 20.16
-				}
-			}
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd",
+		testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"nat_gateway": {
+		"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
+		"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
+		"date_created": "2025-09-29 10:38:57",
+		"status": "active",
+		"label": "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
+		"tag": "test tag",
+		"public_ips": [
+			"149.1.2.3"
+		],
+		"public_ips_v6": [
+			"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
+		],
+		"private_ips": [
+			"10.1.128.1"
+		],
+		"billing": {
+			"charges": 20.16,
+			"monthly": 20.16
 		}
-		`
-
-		fmt.Fprint(writer, response)
-	})
-
-	options := &NATGatewayReq{
-		Label: "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
-		Tag:   "test tag",
 	}
+}`))
 
-	natGateway, _, err := client.VPC.CreateNATGateway(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", options)
-
+	natGateway, _, err := client.VPC.GetNATGateway(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd")
 	if err != nil {
-		t.Errorf("VPC.CreateNATGateway returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.GetNATGateway returned %+v, expected %+v", err, nil)
 	}
 
 	publicIPs := []string{
@@ -370,47 +343,48 @@ func TestVPCServiceHandler_CreateNATGateway(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(natGateway, expected) {
-		t.Errorf("VPC.CreateNATGateway returned %+v, expected %+v", natGateway, expected)
+		t.Errorf("VPC.GetNATGateway returned %+v, expected %+v", natGateway, expected)
 	}
 }
 
-func TestVPCServiceHandler_GetNATGateway(t *testing.T) {
+func TestVPCServiceHandler_CreateNATGateway(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"nat_gateway": {
-				"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
-				"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
-				"date_created": "2025-09-29 10:38:57",
-				"status": "active",
-				"label": "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
-				"tag": "test tag",
-				"public_ips": [
-					"149.1.2.3"
-				],
-				"public_ips_v6": [
-					"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
-				],
-				"private_ips": [
-					"10.1.128.1"
-				],
-				"billing": {
-					"charges": 20.16,
-					"monthly": 20.16
-				}
-			}
+	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway", testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"nat_gateway": {
+		"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
+		"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
+		"date_created": "2025-09-29 10:38:57",
+		"status": "active",
+		"label": "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
+		"tag": "test tag",
+		"public_ips": [
+			"149.1.2.3"
+		],
+		"public_ips_v6": [
+			"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
+		],
+		"private_ips": [
+			"10.1.128.1"
+		],
+		"billing": {
+			"charges": 20.16,
+			"monthly": 20.16
 		}
-		`
+	}
+}`))
 
-		fmt.Fprint(writer, response)
-	})
+	options := &NATGatewayReq{
+		Label: "nat-gateway-59d6c282-00a7-4a92-9a41-3adad396abcd",
+		Tag:   "test tag",
+	}
+
+	natGateway, _, err := client.VPC.CreateNATGateway(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", options)
 
-	natGateway, _, err := client.VPC.GetNATGateway(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd")
 	if err != nil {
-		t.Errorf("VPC.GetNATGateway returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.CreateNATGateway returned %+v, expected %+v", err, nil)
 	}
 
 	publicIPs := []string{
@@ -444,7 +418,7 @@ func TestVPCServiceHandler_GetNATGateway(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(natGateway, expected) {
-		t.Errorf("VPC.GetNATGateway returned %+v, expected %+v", natGateway, expected)
+		t.Errorf("VPC.CreateNATGateway returned %+v, expected %+v", natGateway, expected)
 	}
 }
 
@@ -452,35 +426,32 @@ func TestVPCServiceHandler_UpdateNATGateway(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"nat_gateway": {
-				"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
-				"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
-				"date_created": "2025-09-29 10:38:57",
-				"status": "active",
-				"label": "label updated",
-				"tag": "test tag updated",
-				"public_ips": [
-					"149.1.2.3"
-				],
-				"public_ips_v6": [
-					"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
-				],
-				"private_ips": [
-					"10.1.128.1"
-				],
-				"billing": {
-					"charges": 20.16,
-					"monthly": 20.16
-				}
-			}
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd",
+		testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"nat_gateway": {
+		"id": "7af46919-f6b0-4f34-8523-1d03911eabcd",
+		"vpc_id": "59d6c282-00a7-4a92-9a41-3adad396abcd",
+		"date_created": "2025-09-29 10:38:57",
+		"status": "active",
+		"label": "label updated",
+		"tag": "test tag updated",
+		"public_ips": [
+			"149.1.2.3"
+		],
+		"public_ips_v6": [
+			"2001:19f0:0006:01d3:5400:05ff:fec2:abcd"
+		],
+		"private_ips": [
+			"10.1.128.1"
+		],
+		"billing": {
+			"charges": 20.16,
+			"monthly": 20.16
 		}
-		`
-
-		fmt.Fprint(writer, response)
-	})
+	}
+}`))
 
 	options := &NATGatewayReq{
 		Label: "label updated",
@@ -547,34 +518,31 @@ func TestVPCServiceHandler_NATGatewayListFirewallRules(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules",
+		testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "",
+			"prev": ""
+		}
+	},
+	"firewall_rules": [
 		{
-			"meta": {
-				"total": 1,
-				"links": {
-					"next": "",
-					"prev": ""
-				}
-			},
-			"firewall_rules": [
-				{
-					"id": "822043f4-b135-470d-89d0-58476498abcd",
-					"action": "accept",
-					"protocol": "tcp",
-					"port": "655",
-					"subnet": "1.2.3.4",
-					"subnet_size": 24,
-					"notes": "test rule"
-				}
-			]
+			"id": "822043f4-b135-470d-89d0-58476498abcd",
+			"action": "accept",
+			"protocol": "tcp",
+			"port": "655",
+			"subnet": "1.2.3.4",
+			"subnet_size": 24,
+			"notes": "test rule"
 		}
-		`
-		fmt.Fprint(writer, response)
-	})
+	]
+}`))
 
 	firewallRules, _, _, err := client.VPC.ListNATGatewayFirewallRules(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", nil)
-
 	if err != nil {
 		t.Errorf("VPC.ListNATGatewayFirewallRules returned error: %v", err)
 	}
@@ -596,40 +564,28 @@ func TestVPCServiceHandler_NATGatewayListFirewallRules(t *testing.T) {
 	}
 }
 
-func TestVPCServiceHandler_CreateNATGatewayFirewallRule(t *testing.T) {
+func TestVPCServiceHandler_GetNATGatewayFirewallRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"firewall_rule": {
-				"id": "822043f4-b135-470d-89d0-58476498abcd",
-				"action": "accept",
-				"protocol": "tcp",
-				"port": "655",
-				"subnet": "1.2.3.4",
-				"subnet_size": 24,
-				"notes": "test rule"
-			}
-		}
-		`
-
-		fmt.Fprint(writer, response)
-	})
-
-	options := &NATGatewayFirewallRuleCreateReq{
-		Protocol:   "tcp",
-		Port:       "655",
-		Subnet:     "1.2.3.4",
-		SubnetSize: 24,
-		Notes:      "test rule",
-	}
-
-	firewallRule, _, err := client.VPC.CreateNATGatewayFirewallRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", options)
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules/822043f4-b135-470d-89d0-58476498abcd",
+		testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"firewall_rule": {
+		"id": "822043f4-b135-470d-89d0-58476498abcd",
+		"action": "accept",
+		"protocol": "tcp",
+		"port": "655",
+		"subnet": "1.2.3.4",
+		"subnet_size": 24,
+		"notes": "test rule"
+	}
+}`))
 
+	firewallRule, _, err := client.VPC.GetNATGatewayFirewallRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "822043f4-b135-470d-89d0-58476498abcd")
 	if err != nil {
-		t.Errorf("VPC.CreateNATGatewayFirewallRule returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.GetNATGatewayFirewallRule returned %+v, expected %+v", err, nil)
 	}
 
 	expected := &NATGatewayFirewallRule{
@@ -643,35 +599,40 @@ func TestVPCServiceHandler_CreateNATGatewayFirewallRule(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(firewallRule, expected) {
-		t.Errorf("VPC.CreateNATGatewayFirewallRule returned %+v, expected %+v", firewallRule, expected)
+		t.Errorf("VPC.GetNATGatewayFirewallRule returned %+v, expected %+v", firewallRule, expected)
 	}
 }
 
-func TestVPCServiceHandler_GetNATGatewayFirewallRule(t *testing.T) {
+func TestVPCServiceHandler_CreateNATGatewayFirewallRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules/822043f4-b135-470d-89d0-58476498abcd", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"firewall_rule": {
-				"id": "822043f4-b135-470d-89d0-58476498abcd",
-				"action": "accept",
-				"protocol": "tcp",
-				"port": "655",
-				"subnet": "1.2.3.4",
-				"subnet_size": 24,
-				"notes": "test rule"
-			}
-		}
-		`
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules",
+		testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"firewall_rule": {
+		"id": "822043f4-b135-470d-89d0-58476498abcd",
+		"action": "accept",
+		"protocol": "tcp",
+		"port": "655",
+		"subnet": "1.2.3.4",
+		"subnet_size": 24,
+		"notes": "test rule"
+	}
+}`))
 
-		fmt.Fprint(writer, response)
-	})
+	options := &NATGatewayFirewallRuleCreateReq{
+		Protocol:   "tcp",
+		Port:       "655",
+		Subnet:     "1.2.3.4",
+		SubnetSize: 24,
+		Notes:      "test rule",
+	}
 
-	firewallRule, _, err := client.VPC.GetNATGatewayFirewallRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "822043f4-b135-470d-89d0-58476498abcd")
+	firewallRule, _, err := client.VPC.CreateNATGatewayFirewallRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", options)
 	if err != nil {
-		t.Errorf("VPC.GetNATGatewayFirewallRule returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.CreateNATGatewayFirewallRule returned %+v, expected %+v", err, nil)
 	}
 
 	expected := &NATGatewayFirewallRule{
@@ -685,7 +646,7 @@ func TestVPCServiceHandler_GetNATGatewayFirewallRule(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(firewallRule, expected) {
-		t.Errorf("VPC.GetNATGatewayFirewallRule returned %+v, expected %+v", firewallRule, expected)
+		t.Errorf("VPC.CreateNATGatewayFirewallRule returned %+v, expected %+v", firewallRule, expected)
 	}
 }
 
@@ -693,23 +654,20 @@ func TestVPCServiceHandler_UpdateNATGatewayFirewallRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules/822043f4-b135-470d-89d0-58476498abcd", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"firewall_rule": {
-				"id": "822043f4-b135-470d-89d0-58476498abcd",
-				"action": "accept",
-				"protocol": "tcp",
-				"port": "655",
-				"subnet": "1.2.3.4",
-				"subnet_size": 24,
-				"notes": "test rule updated"
-			}
-		}
-		`
-
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules/822043f4-b135-470d-89d0-58476498abcd",
+		testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"firewall_rule": {
+		"id": "822043f4-b135-470d-89d0-58476498abcd",
+		"action": "accept",
+		"protocol": "tcp",
+		"port": "655",
+		"subnet": "1.2.3.4",
+		"subnet_size": 24,
+		"notes": "test rule updated"
+	}
+}`))
 
 	options := &NATGatewayFirewallRuleUpdateReq{
 		Notes: "test rule updated",
@@ -740,9 +698,10 @@ func TestVPCServiceHandler_DeleteNATGatewayFirewallRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules/822043f4-b135-470d-89d0-58476498abcd", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/firewall-rules/822043f4-b135-470d-89d0-58476498abcd",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""),
+	)
 
 	err := client.VPC.DeleteNATGatewayFirewallRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "822043f4-b135-470d-89d0-58476498abcd")
 
@@ -755,37 +714,34 @@ func TestVPCServiceHandler_NATGatewayListPortForwardingRules(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules",
+		testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"meta": {
+		"total": 1,
+		"links": {
+			"next": "",
+			"prev": ""
+		}
+	},
+	"port_forwarding_rules": [
 		{
-			"meta": {
-				"total": 1,
-				"links": {
-					"next": "",
-					"prev": ""
-				}
-			},
-			"port_forwarding_rules": [
-				{
-					"id": "e0116495-7657-4790-9801-e93157fcabcd",
-					"name": "test rule",
-					"protocol": "tcp",
-					"external_port": 655,
-					"internal_ip": "10.1.2.3",
-					"internal_port": 123,
-					"enabled": true,
-					"description": "test",
-					"created_at": "2025-10-16 15:09:26",
-					"updated_at": "2025-10-16 15:09:26"
-				}
-			]
+			"id": "e0116495-7657-4790-9801-e93157fcabcd",
+			"name": "test rule",
+			"protocol": "tcp",
+			"external_port": 655,
+			"internal_ip": "10.1.2.3",
+			"internal_port": 123,
+			"enabled": true,
+			"description": "test",
+			"created_at": "2025-10-16 15:09:26",
+			"updated_at": "2025-10-16 15:09:26"
 		}
-		`
-		fmt.Fprint(writer, response)
-	})
+	]
+}`))
 
 	portForwardingRules, _, _, err := client.VPC.ListNATGatewayPortForwardingRules(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", nil)
-
 	if err != nil {
 		t.Errorf("VPC.ListNATGatewayPortForwardingRules returned error: %v", err)
 	}
@@ -810,45 +766,31 @@ func TestVPCServiceHandler_NATGatewayListPortForwardingRules(t *testing.T) {
 	}
 }
 
-func TestVPCServiceHandler_CreateNATGatewayPortForwardingRule(t *testing.T) {
+func TestVPCServiceHandler_GetNATGatewayPortForwardingRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"port_forwarding_rule": {
-				"id": "e0116495-7657-4790-9801-e93157fcabcd",
-				"name": "test rule",
-				"protocol": "tcp",
-				"external_port": 655,
-				"internal_ip": "10.1.2.3",
-				"internal_port": 123,
-				"enabled": true,
-				"description": "test",
-				"created_at": "2025-10-16 15:09:26",
-				"updated_at": "2025-10-16 15:09:26"
-			}
-		}
-		`
-
-		fmt.Fprint(writer, response)
-	})
-
-	options := &NATGatewayPortForwardingRuleReq{
-		Name:         "test rule",
-		Protocol:     "tcp",
-		ExternalPort: 655,
-		InternalIP:   "10.1.2.3",
-		InternalPort: 123,
-		Enabled:      BoolToBoolPtr(true),
-		Description:  "test",
-	}
-
-	portForwardingRule, _, err := client.VPC.CreateNATGatewayPortForwardingRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", options)
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules/e0116495-7657-4790-9801-e93157fcabcd",
+		testJSONResponseHandlerFunc(http.StatusOK, `
+{
+	"port_forwarding_rule": {
+		"id": "e0116495-7657-4790-9801-e93157fcabcd",
+		"name": "test rule",
+		"protocol": "tcp",
+		"external_port": 655,
+		"internal_ip": "10.1.2.3",
+		"internal_port": 123,
+		"enabled": true,
+		"description": "test",
+		"created_at": "2025-10-16 15:09:26",
+		"updated_at": "2025-10-16 15:09:26"
+	}
+}`))
 
+	portForwardingRule, _, err := client.VPC.GetNATGatewayPortForwardingRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "e0116495-7657-4790-9801-e93157fcabcd")
 	if err != nil {
-		t.Errorf("VPC.CreateNATGatewayPortForwardingRule returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.GetNATGatewayPortForwardingRule returned %+v, expected %+v", err, nil)
 	}
 
 	expected := &NATGatewayPortForwardingRule{
@@ -865,38 +807,45 @@ func TestVPCServiceHandler_CreateNATGatewayPortForwardingRule(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(portForwardingRule, expected) {
-		t.Errorf("VPC.CreateNATGatewayPortForwardingRule returned %+v, expected %+v", portForwardingRule, expected)
+		t.Errorf("VPC.GetNATGatewayPortForwardingRule returned %+v, expected %+v", portForwardingRule, expected)
 	}
 }
 
-func TestVPCServiceHandler_GetNATGatewayPortForwardingRule(t *testing.T) {
+func TestVPCServiceHandler_CreateNATGatewayPortForwardingRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules/e0116495-7657-4790-9801-e93157fcabcd", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"port_forwarding_rule": {
-				"id": "e0116495-7657-4790-9801-e93157fcabcd",
-				"name": "test rule",
-				"protocol": "tcp",
-				"external_port": 655,
-				"internal_ip": "10.1.2.3",
-				"internal_port": 123,
-				"enabled": true,
-				"description": "test",
-				"created_at": "2025-10-16 15:09:26",
-				"updated_at": "2025-10-16 15:09:26"
-			}
-		}
-		`
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules",
+		testJSONResponseHandlerFunc(http.StatusCreated, `
+{
+	"port_forwarding_rule": {
+		"id": "e0116495-7657-4790-9801-e93157fcabcd",
+		"name": "test rule",
+		"protocol": "tcp",
+		"external_port": 655,
+		"internal_ip": "10.1.2.3",
+		"internal_port": 123,
+		"enabled": true,
+		"description": "test",
+		"created_at": "2025-10-16 15:09:26",
+		"updated_at": "2025-10-16 15:09:26"
+	}
+}`))
 
-		fmt.Fprint(writer, response)
-	})
+	options := &NATGatewayPortForwardingRuleReq{
+		Name:         "test rule",
+		Protocol:     "tcp",
+		ExternalPort: 655,
+		InternalIP:   "10.1.2.3",
+		InternalPort: 123,
+		Enabled:      BoolToBoolPtr(true),
+		Description:  "test",
+	}
 
-	portForwardingRule, _, err := client.VPC.GetNATGatewayPortForwardingRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "e0116495-7657-4790-9801-e93157fcabcd")
+	portForwardingRule, _, err := client.VPC.CreateNATGatewayPortForwardingRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", options)
 	if err != nil {
-		t.Errorf("VPC.GetNATGatewayPortForwardingRule returned %+v, expected %+v", err, nil)
+		t.Errorf("VPC.CreateNATGatewayPortForwardingRule returned %+v, expected %+v", err, nil)
 	}
 
 	expected := &NATGatewayPortForwardingRule{
@@ -913,7 +862,7 @@ func TestVPCServiceHandler_GetNATGatewayPortForwardingRule(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(portForwardingRule, expected) {
-		t.Errorf("VPC.GetNATGatewayPortForwardingRule returned %+v, expected %+v", portForwardingRule, expected)
+		t.Errorf("VPC.CreateNATGatewayPortForwardingRule returned %+v, expected %+v", portForwardingRule, expected)
 	}
 }
 
@@ -921,26 +870,23 @@ func TestVPCServiceHandler_UpdateNATGatewayPortForwardingRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules/e0116495-7657-4790-9801-e93157fcabcd", func(writer http.ResponseWriter, request *http.Request) {
-		response := `
-		{
-			"port_forwarding_rule": {
-				"id": "e0116495-7657-4790-9801-e93157fcabcd",
-				"name": "test rule updated",
-				"protocol": "tcp",
-				"external_port": 655,
-				"internal_ip": "10.1.2.3",
-				"internal_port": 123,
-				"enabled": true,
-				"description": "test updated",
-				"created_at": "2025-10-16 15:09:26",
-				"updated_at": "2025-10-16 15:09:26"
-			}
-		}
-		`
-
-		fmt.Fprint(writer, response)
-	})
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules/e0116495-7657-4790-9801-e93157fcabcd",
+		testJSONResponseHandlerFunc(http.StatusAccepted, `
+{
+	"port_forwarding_rule": {
+		"id": "e0116495-7657-4790-9801-e93157fcabcd",
+		"name": "test rule updated",
+		"protocol": "tcp",
+		"external_port": 655,
+		"internal_ip": "10.1.2.3",
+		"internal_port": 123,
+		"enabled": true,
+		"description": "test updated",
+		"created_at": "2025-10-16 15:09:26",
+		"updated_at": "2025-10-16 15:09:26"
+	}
+}`))
 
 	options := &NATGatewayPortForwardingRuleReq{
 		Name:        "test rule updated",
@@ -948,7 +894,6 @@ func TestVPCServiceHandler_UpdateNATGatewayPortForwardingRule(t *testing.T) {
 	}
 
 	portForwardingRule, _, err := client.VPC.UpdateNATGatewayPortForwardingRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "e0116495-7657-4790-9801-e93157fcabcd", options)
-
 	if err != nil {
 		t.Errorf("VPC.UpdateNATGatewayPortForwardingRule returned %+v, expected %+v", err, nil)
 	}
@@ -975,13 +920,16 @@ func TestVPCServiceHandler_DeleteNATGatewayPortForwardingRule(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules/e0116495-7657-4790-9801-e93157fcabcd", func(writer http.ResponseWriter, request *http.Request) {
-		fmt.Fprint(writer)
-	})
+	mux.HandleFunc(
+		"/v2/vpcs/59d6c282-00a7-4a92-9a41-3adad396abcd/nat-gateway/7af46919-f6b0-4f34-8523-1d03911eabcd/global/port-forwarding-rules/e0116495-7657-4790-9801-e93157fcabcd",
+		testJSONResponseHandlerFunc(http.StatusNoContent, ""))
 
-	err := client.VPC.DeleteNATGatewayPortForwardingRule(ctx, "59d6c282-00a7-4a92-9a41-3adad396abcd", "7af46919-f6b0-4f34-8523-1d03911eabcd", "e0116495-7657-4790-9801-e93157fcabcd")
-
-	if err != nil {
+	if err := client.VPC.DeleteNATGatewayPortForwardingRule(
+		ctx,
+		"59d6c282-00a7-4a92-9a41-3adad396abcd",
+		"7af46919-f6b0-4f34-8523-1d03911eabcd",
+		"e0116495-7657-4790-9801-e93157fcabcd",
+	); err != nil {
 		t.Errorf("VPC.DeleteNATGatewayPortForwardingRule returned %+v, expected %+v", err, nil)
 	}
 }
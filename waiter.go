@@ -0,0 +1,329 @@
+package govultr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 5 * time.Second
+	defaultMaxPollInterval = time.Minute
+)
+
+var (
+	// States that Vultr uses for resources that failed to provision
+	defaultFailureStates = []string{"error", "failed"}
+
+	// Suspended servers will not become active without intervention
+	serverFailureStates = []string{"suspended"}
+)
+
+// WaitOptions configures how a waiter polls a resource
+type WaitOptions struct {
+	// Time between polls, defaults to 5 seconds
+	PollInterval time.Duration
+
+	// Multiplier applied to the poll interval after every poll. Values of 1
+	// or lower keep the interval fixed.
+	Backoff float64
+
+	// Upper bound for the poll interval once backoff is applied, defaults to 1 minute
+	MaxPollInterval time.Duration
+
+	// Maximum time to wait. Zero waits until the context is done.
+	Timeout time.Duration
+
+	// Replaces the waiter's default terminal failure states when set
+	FailureStates []string
+
+	// Called after every poll with the state that was observed
+	OnProgress func(state string, attempt int)
+}
+
+// StateRefreshFunc fetches a resource and reports its current state
+type StateRefreshFunc[T any] func(ctx context.Context) (T, string, error)
+
+// WaitError is returned when a waiter stops before the resource reached one
+// of its target states
+type WaitError struct {
+	// Last state observed, empty if the resource was never read
+	State string
+
+	// States the waiter was waiting for
+	Target []string
+
+	// Set when the waiter stopped on a timeout, cancellation or request
+	// error. A nil Err means the resource entered a terminal failure state.
+	Err error
+}
+
+// Error describes why the waiter stopped
+func (e *WaitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("resource entered terminal state %q while waiting for %s", e.State, strings.Join(e.Target, ", "))
+	}
+
+	return fmt.Sprintf("stopped waiting for %s, last state %q: %s", strings.Join(e.Target, ", "), e.State, e.Err.Error())
+}
+
+// Unwrap returns the error that stopped the waiter
+func (e *WaitError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForState polls refresh until the reported state is one of target. A
+// *WaitError is returned when a failure state is reached, refresh returns an
+// error, or the timeout or context expires first.
+func WaitForState[T any](ctx context.Context, refresh StateRefreshFunc[T], target, failure []string, opts *WaitOptions) (T, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	if opts.FailureStates != nil {
+		failure = opts.FailureStates
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	maxInterval := opts.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var state string
+	for attempt := 1; ; attempt++ {
+		resource, current, err := refresh(ctx)
+		if err != nil {
+			return resource, &WaitError{State: state, Target: target, Err: err}
+		}
+		state = current
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(state, attempt)
+		}
+
+		if slices.Contains(target, state) {
+			return resource, nil
+		}
+
+		if slices.Contains(failure, state) {
+			return resource, &WaitError{State: state, Target: target}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resource, &WaitError{State: state, Target: target, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		if opts.Backoff > 1 {
+			interval = min(time.Duration(float64(interval)*opts.Backoff), maxInterval)
+		}
+	}
+}
+
+// instanceState folds the status, power status and server status of an
+// instance or bare metal server into the first one that isn't ready yet
+func instanceState(status InstanceStatus, powerStatus PowerStatus, serverStatus ServerStatus) string {
+	switch {
+	case status != InstanceStatusActive:
+		return string(status)
+	case powerStatus != "" && powerStatus != PowerStatusRunning:
+		return string(powerStatus)
+	case serverStatus != "" && serverStatus != ServerStatusOK:
+		return string(serverStatus)
+	default:
+		return string(InstanceStatusActive)
+	}
+}
+
+// WaitForInstanceActive waits until an instance is active, running and its
+// server status is ok
+func (c *Client) WaitForInstanceActive(ctx context.Context, instanceID string, opts *WaitOptions) (*Instance, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*Instance, string, error) {
+		instance, _, err := c.Instance.Get(ctx, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		return instance, instanceState(instance.Status, instance.PowerStatus, instance.ServerStatus), nil
+	}, []string{string(InstanceStatusActive)}, serverFailureStates, opts)
+}
+
+// WaitForBareMetalServerActive waits until a bare metal server is active
+func (c *Client) WaitForBareMetalServerActive(ctx context.Context, serverID string, opts *WaitOptions) (*BareMetalServer, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*BareMetalServer, string, error) {
+		server, _, err := c.BareMetalServer.Get(ctx, serverID)
+		if err != nil {
+			return nil, "", err
+		}
+		return server, instanceState(InstanceStatus(server.Status), "", ""), nil
+	}, []string{string(InstanceStatusActive)}, serverFailureStates, opts)
+}
+
+// WaitForKubernetesClusterActive waits until a kubernetes cluster is active
+func (c *Client) WaitForKubernetesClusterActive(ctx context.Context, vkeID string, opts *WaitOptions) (*Cluster, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*Cluster, string, error) {
+		cluster, _, err := c.Kubernetes.GetCluster(ctx, vkeID)
+		if err != nil {
+			return nil, "", err
+		}
+		return cluster, cluster.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForNodePoolReady waits until a node pool and every node within it are active
+func (c *Client) WaitForNodePoolReady(ctx context.Context, vkeID, nodePoolID string, opts *WaitOptions) (*NodePool, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*NodePool, string, error) {
+		nodePool, _, err := c.Kubernetes.GetNodePool(ctx, vkeID, nodePoolID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if nodePool.Status != "active" {
+			return nodePool, nodePool.Status, nil
+		}
+
+		for i := range nodePool.Nodes {
+			if nodePool.Nodes[i].Status != "active" {
+				return nodePool, nodePool.Nodes[i].Status, nil
+			}
+		}
+
+		return nodePool, nodePool.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForDatabaseRunning waits until a managed database is running
+func (c *Client) WaitForDatabaseRunning(ctx context.Context, databaseID string, opts *WaitOptions) (*Database, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*Database, string, error) {
+		database, _, err := c.Database.Get(ctx, databaseID)
+		if err != nil {
+			return nil, "", err
+		}
+		return database, database.Status, nil
+	}, []string{"Running"}, []string{"Error", "Failed"}, opts)
+}
+
+// WaitForSnapshotComplete waits until a snapshot is complete
+func (c *Client) WaitForSnapshotComplete(ctx context.Context, snapshotID string, opts *WaitOptions) (*Snapshot, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*Snapshot, string, error) {
+		snapshot, _, err := c.Snapshot.Get(ctx, snapshotID)
+		if err != nil {
+			return nil, "", err
+		}
+		return snapshot, snapshot.Status, nil
+	}, []string{"complete"}, defaultFailureStates, opts)
+}
+
+// WaitForBackupComplete waits until a backup is complete
+func (c *Client) WaitForBackupComplete(ctx context.Context, backupID string, opts *WaitOptions) (*Backup, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*Backup, string, error) {
+		backup, _, err := c.Backup.Get(ctx, backupID)
+		if err != nil {
+			return nil, "", err
+		}
+		return backup, backup.Status, nil
+	}, []string{"complete"}, defaultFailureStates, opts)
+}
+
+// WaitForISOComplete waits until an ISO has finished downloading
+func (c *Client) WaitForISOComplete(ctx context.Context, isoID string, opts *WaitOptions) (*ISO, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*ISO, string, error) {
+		iso, _, err := c.ISO.Get(ctx, isoID)
+		if err != nil {
+			return nil, "", err
+		}
+		return iso, iso.Status, nil
+	}, []string{"complete"}, defaultFailureStates, opts)
+}
+
+// WaitForBlockStorageActive waits until a block storage is active
+func (c *Client) WaitForBlockStorageActive(ctx context.Context, blockID string, opts *WaitOptions) (*BlockStorage, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*BlockStorage, string, error) {
+		blockStorage, _, err := c.BlockStorage.Get(ctx, blockID)
+		if err != nil {
+			return nil, "", err
+		}
+		return blockStorage, blockStorage.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForLoadBalancerActive waits until a load balancer is active
+func (c *Client) WaitForLoadBalancerActive(ctx context.Context, lbID string, opts *WaitOptions) (*LoadBalancer, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*LoadBalancer, string, error) {
+		lb, _, err := c.LoadBalancer.Get(ctx, lbID)
+		if err != nil {
+			return nil, "", err
+		}
+		return lb, lb.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForObjectStorageActive waits until an object storage subscription is active
+func (c *Client) WaitForObjectStorageActive(ctx context.Context, objectStorageID string, opts *WaitOptions) (*ObjectStorage, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*ObjectStorage, string, error) {
+		objectStorage, _, err := c.ObjectStorage.Get(ctx, objectStorageID)
+		if err != nil {
+			return nil, "", err
+		}
+		return objectStorage, objectStorage.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForVirtualFileSystemStorageActive waits until a virtual file system storage is active
+func (c *Client) WaitForVirtualFileSystemStorageActive(ctx context.Context, vfsID string, opts *WaitOptions) (*VirtualFileSystemStorage, error) { //nolint:lll
+	return WaitForState(ctx, func(ctx context.Context) (*VirtualFileSystemStorage, string, error) {
+		storage, _, err := c.VirtualFileSystemStorage.Get(ctx, vfsID)
+		if err != nil {
+			return nil, "", err
+		}
+		return storage, storage.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForNATGatewayActive waits until a VPC NAT gateway is active
+func (c *Client) WaitForNATGatewayActive(ctx context.Context, vpcID, gatewayID string, opts *WaitOptions) (*NATGateway, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*NATGateway, string, error) {
+		gateway, _, err := c.VPC.GetNATGateway(ctx, vpcID, gatewayID)
+		if err != nil {
+			return nil, "", err
+		}
+		return gateway, gateway.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForCDNPullZoneActive waits until a CDN pull zone is active
+func (c *Client) WaitForCDNPullZoneActive(ctx context.Context, zoneID string, opts *WaitOptions) (*CDNZone, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*CDNZone, string, error) {
+		zone, _, err := c.CDN.GetPullZone(ctx, zoneID)
+		if err != nil {
+			return nil, "", err
+		}
+		return zone, zone.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
+
+// WaitForCDNPushZoneActive waits until a CDN push zone is active
+func (c *Client) WaitForCDNPushZoneActive(ctx context.Context, zoneID string, opts *WaitOptions) (*CDNZone, error) {
+	return WaitForState(ctx, func(ctx context.Context) (*CDNZone, string, error) {
+		zone, _, err := c.CDN.GetPushZone(ctx, zoneID)
+		if err != nil {
+			return nil, "", err
+		}
+		return zone, zone.Status, nil
+	}, []string{"active"}, defaultFailureStates, opts)
+}
@@ -0,0 +1,143 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testWaitOptions = &WaitOptions{PollInterval: time.Millisecond}
+
+func TestWaitForInstanceActive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	responses := []string{
+		`{"instance":{"id":"abc","status":"pending","power_status":"stopped","server_status":"none"}}`,
+		`{"instance":{"id":"abc","status":"active","power_status":"running","server_status":"installingbooting"}}`,
+		`{"instance":{"id":"abc","status":"active","power_status":"running","server_status":"ok"}}`,
+	}
+
+	polls := 0
+	mux.HandleFunc("/v2/instances/abc", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, responses[min(polls, len(responses)-1)])
+		polls++
+	})
+
+	var states []string
+	opts := &WaitOptions{
+		PollInterval: time.Millisecond,
+		Backoff:      2,
+		OnProgress: func(state string, attempt int) {
+			states = append(states, state)
+		},
+	}
+
+	instance, err := client.WaitForInstanceActive(ctx, "abc", opts)
+	if err != nil {
+		t.Fatalf("WaitForInstanceActive returned %+v", err)
+	}
+
+	if instance.ServerStatus != "ok" {
+		t.Errorf("WaitForInstanceActive returned %+v, expected server status ok", instance)
+	}
+
+	expected := []string{"pending", "installingbooting", "active"}
+	if !reflect.DeepEqual(states, expected) {
+		t.Errorf("WaitForInstanceActive reported %+v, expected %+v", states, expected)
+	}
+}
+
+func TestWaitForSnapshotComplete_Failure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/snapshots/abc", testJSONResponseHandlerFunc(http.StatusOK, `{"snapshot":{"id":"abc","status":"failed"}}`))
+
+	_, err := client.WaitForSnapshotComplete(ctx, "abc", testWaitOptions)
+
+	var waitErr *WaitError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("WaitForSnapshotComplete returned %+v, expected a *WaitError", err)
+	}
+
+	if waitErr.State != "failed" || waitErr.Err != nil {
+		t.Errorf("WaitForSnapshotComplete returned %+v, expected terminal state failed", waitErr)
+	}
+}
+
+func TestWaitForSnapshotComplete_CustomFailureStates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/snapshots/abc", testJSONResponseHandlerFunc(http.StatusOK, `{"snapshot":{"id":"abc","status":"deleted"}}`))
+
+	opts := &WaitOptions{PollInterval: time.Millisecond, FailureStates: []string{"deleted"}}
+	if _, err := client.WaitForSnapshotComplete(ctx, "abc", opts); err == nil {
+		t.Error("WaitForSnapshotComplete expected the custom failure state to stop the waiter")
+	}
+}
+
+func TestWaitForNodePoolReady(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/v2/kubernetes/clusters/vke/node-pools/np", func(writer http.ResponseWriter, request *http.Request) {
+		polls++
+		nodeStatus := "pending"
+		if polls > 1 {
+			nodeStatus = "active"
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"node_pool":{"id":"np","status":"active","nodes":[{"id":"n1","status":"active"},{"id":"n2","status":%q}]}}`, nodeStatus)
+	})
+
+	nodePool, err := client.WaitForNodePoolReady(ctx, "vke", "np", testWaitOptions)
+	if err != nil {
+		t.Fatalf("WaitForNodePoolReady returned %+v", err)
+	}
+
+	if polls != 2 || nodePool.Nodes[1].Status != "active" {
+		t.Errorf("WaitForNodePoolReady returned %+v after %d polls, expected every node active after 2", nodePool, polls)
+	}
+}
+
+func TestWaitForDatabaseRunning_Timeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/databases/abc", testJSONResponseHandlerFunc(http.StatusOK, `{"database":{"id":"abc","status":"Rebuilding"}}`))
+
+	opts := &WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	_, err := client.WaitForDatabaseRunning(ctx, "abc", opts)
+
+	var waitErr *WaitError
+	if !errors.As(err, &waitErr) || waitErr.State != "Rebuilding" {
+		t.Fatalf("WaitForDatabaseRunning returned %+v, expected a *WaitError in state Rebuilding", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForDatabaseRunning returned %+v, expected %+v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWaitForBlockStorageActive_RequestError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/blocks/abc", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"Invalid block storage","status":404}`)
+	})
+
+	_, err := client.WaitForBlockStorageActive(ctx, "abc", testWaitOptions)
+	if !IsNotFound(err) {
+		t.Errorf("WaitForBlockStorageActive returned %+v, expected a not found error", err)
+	}
+}